@@ -38,15 +38,17 @@ import (
 
 // EthAccountVerificationDecorator validates an account balance checks
 type EthAccountVerificationDecorator struct {
-	ak        evmtypes.AccountKeeper
-	evmKeeper EVMKeeper
+	ak             evmtypes.AccountKeeper
+	evmKeeper      EVMKeeper
+	feegrantKeeper FeegrantKeeper
 }
 
 // NewEthAccountVerificationDecorator creates a new EthAccountVerificationDecorator
-func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper) EthAccountVerificationDecorator {
+func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper, fk FeegrantKeeper) EthAccountVerificationDecorator {
 	return EthAccountVerificationDecorator{
-		ak:        ak,
-		evmKeeper: ek,
+		ak:             ak,
+		evmKeeper:      ek,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -97,7 +99,13 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		}
 
 		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
-			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			// the fees can be paid by the fee allowance or with the fee denom chosen by
+			// the tx, whose balances are checked when the fees are deducted
+			_, payInFeeDenom, _ := evmtypes.FeeDenomChoiceFromAccessList(txData.GetAccessList(), avd.evmKeeper.GetParams(ctx).FeeDenoms)
+			canPayFees := payInFeeDenom || hasFeeGrant(ctx, avd.feegrantKeeper, msgEthTx, txData)
+			if !canPayFees || acct.Balance.Cmp(txData.GetValue()) < 0 {
+				return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -110,6 +118,7 @@ type EthGasConsumeDecorator struct {
 	distributionKeeper anteutils.DistributionKeeper
 	evmKeeper          EVMKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feegrantKeeper     FeegrantKeeper
 	maxGasWanted       uint64
 }
 
@@ -119,6 +128,7 @@ func NewEthGasConsumeDecorator(
 	distributionKeeper anteutils.DistributionKeeper,
 	evmKeeper EVMKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	feegrantKeeper FeegrantKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
@@ -126,6 +136,7 @@ func NewEthGasConsumeDecorator(
 		distributionKeeper,
 		evmKeeper,
 		stakingKeeper,
+		feegrantKeeper,
 		maxGasWanted,
 	}
 }
@@ -203,7 +214,37 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		from := common.HexToAddress(msgEthTx.From)
+		feeGrantChoice, payWithFeeGrant, err := evmtypes.FeeGrantChoiceFromAccessList(txData.GetAccessList())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid fee granter choice: %s", err)
+		}
+
+		feeDenomChoice, payInFeeDenom, err := evmtypes.FeeDenomChoiceFromAccessList(txData.GetAccessList(), evmParams.FeeDenoms)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid fee denom choice: %s", err)
+		}
+
+		if payWithFeeGrant && payInFeeDenom {
+			return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "both a fee granter and a fee denom are chosen")
+		}
+
+		var granter sdk.AccAddress
+		if payWithFeeGrant {
+			granter, err = egcd.useFeeGrant(ctx, fees, msgEthTx, txData, feeGrantChoice)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to use the fee allowance of %s", feeGrantChoice.Granter)
+			}
+		}
+
+		switch {
+		case granter != nil:
+			err = egcd.deductGrantedFees(ctx, granter, fees, msgEthTx)
+		case payInFeeDenom && !fees.IsZero():
+			fees, err = egcd.evmKeeper.DeductTxCostsInFeeDenom(ctx, fees, from, common.HexToHash(msgEthTx.Hash), feeDenomChoice)
+		default:
+			err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, from)
+		}
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
@@ -254,6 +295,77 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
+// useFeeGrant uses the fee allowance chosen by the access list of an Ethereum tx
+// to pay its fees. As the Ethereum tx doesn't sign the fee granter of the Cosmos
+// tx wrapping it, the granter is selected by the access list, which is signed.
+// It returns the granter of the allowance, if the fees aren't zero.
+func (egcd EthGasConsumeDecorator) useFeeGrant(
+	ctx sdk.Context,
+	fees sdk.Coins,
+	msgEthTx *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	choice evmtypes.FeeGrantChoice,
+) (sdk.AccAddress, error) {
+	if egcd.feegrantKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee allowances are not supported")
+	}
+	if fees.IsZero() {
+		return nil, nil
+	}
+
+	grantee, err := choice.Grantee(msgEthTx.GetFrom(), txData.GetTo())
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if err := egcd.feegrantKeeper.UseGrantedFees(ctx, choice.Granter, grantee, fees, []sdk.Msg{msgEthTx}); err != nil {
+		return nil, err
+	}
+	return choice.Granter, nil
+}
+
+// deductGrantedFees deducts the fees of an Ethereum tx from the balance of the
+// fee granter whose allowance accepted them. The fees paid are recorded to
+// refund the leftover gas to the granter.
+func (egcd EthGasConsumeDecorator) deductGrantedFees(
+	ctx sdk.Context,
+	granter sdk.AccAddress,
+	fees sdk.Coins,
+	msgEthTx *evmtypes.MsgEthereumTx,
+) error {
+	payer := common.BytesToAddress(granter)
+	if err := egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, payer); err != nil {
+		return errorsmod.Wrapf(err, "fee granter %s failed to pay the fees of %s", granter, msgEthTx.From)
+	}
+
+	egcd.evmKeeper.SetFeePaymentTransient(ctx, common.HexToHash(msgEthTx.Hash), evmtypes.FeePayment{
+		Payer: payer.Hex(),
+		Fee:   fees[0],
+	})
+	return nil
+}
+
+// hasFeeGrant returns true if the fee allowance chosen by the access list of an
+// Ethereum tx exists.
+func hasFeeGrant(ctx sdk.Context, feegrantKeeper FeegrantKeeper, msgEthTx *evmtypes.MsgEthereumTx, txData evmtypes.TxData) bool {
+	if feegrantKeeper == nil {
+		return false
+	}
+
+	choice, found, err := evmtypes.FeeGrantChoiceFromAccessList(txData.GetAccessList())
+	if err != nil || !found {
+		return false
+	}
+
+	grantee, err := choice.Grantee(msgEthTx.GetFrom(), txData.GetTo())
+	if err != nil {
+		return false
+	}
+
+	allowance, err := feegrantKeeper.GetAllowance(ctx, choice.Granter, grantee)
+	return err == nil && allowance != nil
+}
+
 // CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
 // context rules.
 type CanTransferDecorator struct {
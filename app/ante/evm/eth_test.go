@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	ethante "github.com/evmos/evmos/v12/app/ante/evm"
 	"github.com/evmos/evmos/v12/server/config"
@@ -15,12 +16,13 @@ import (
 	"github.com/evmos/evmos/v12/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	dec := ethante.NewEthAccountVerificationDecorator(
-		suite.app.AccountKeeper, suite.app.EvmKeeper,
+		suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.FeeGrantKeeper,
 	)
 
 	addr := testutiltx.GenerateAddress()
@@ -36,6 +38,16 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	tx := evmtypes.NewTx(ethContractCreationTxParams)
 	tx.From = addr.Hex()
 
+	sponsoredAddr := testutiltx.GenerateAddress()
+	unselectedTx := evmtypes.NewTx(ethContractCreationTxParams)
+	unselectedTx.From = sponsoredAddr.Hex()
+
+	granter := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	sponsoredTxParams := *ethContractCreationTxParams
+	sponsoredTxParams.Accesses = &ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, false)}
+	sponsoredTx := evmtypes.NewTx(&sponsoredTxParams)
+	sponsoredTx.From = sponsoredAddr.Hex()
+
 	var vmdb *statedb.StateDB
 
 	testCases := []struct {
@@ -95,6 +107,32 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			true,
 			true,
 		},
+		{
+			"not enough balance to cover tx value with a fee allowance",
+			sponsoredTx,
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, sponsoredAddr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"not enough balance to cover tx cost with a fee allowance not selected",
+			unselectedTx,
+			func() {
+				vmdb.AddBalance(sponsoredAddr, big.NewInt(10))
+			},
+			true,
+			false,
+		},
+		{
+			"success tx fees paid by the selected fee allowance",
+			sponsoredTx,
+			func() {},
+			true,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -180,7 +218,7 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	chainID := suite.app.EvmKeeper.ChainID()
-	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := testutiltx.GenerateAddress()
 
@@ -217,6 +255,11 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	tx2.From = addr.Hex()
 	tx2Priority := int64(1)
 
+	feeDenomTxParams := *eth2TxContractParams
+	feeDenomTxParams.Accesses = &ethtypes.AccessList{evmtypes.FeeDenomAccessTuple("uusdc", false)}
+	feeDenomTx := evmtypes.NewTx(&feeDenomTxParams)
+	feeDenomTx.From = addr.Hex()
+
 	tx3GasLimit := types.BlockGasLimit(suite.ctx) + uint64(1)
 	eth3TxContractParams := &evmtypes.EvmTxArgs{
 		ChainID:  chainID,
@@ -353,6 +396,57 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 			dynamicFeeTxPriority,
 			func(ctx sdk.Context) {},
 		},
+		{
+			"fail - fee denom not selected by the tx",
+			tx2,
+			math.MaxUint64,
+			func(ctx sdk.Context) sdk.Context {
+				// the balance only covers the value
+				vmdb.AddBalance(addr, big.NewInt(10))
+
+				params := suite.app.EvmKeeper.GetParams(ctx)
+				params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uusdc", Rate: sdk.NewDec(1e6)}}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+
+				err := testutil.FundAccount(ctx, suite.app.BankKeeper, addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1e10)))
+				suite.Require().NoError(err)
+				return ctx.WithBlockGasMeter(sdk.NewGasMeter(1e19))
+			},
+			false, false,
+			0,
+			func(ctx sdk.Context) {},
+		},
+		{
+			"success - access list tx - fees paid in the selected fee denom",
+			feeDenomTx,
+			tx2GasLimit, // it's capped
+			func(ctx sdk.Context) sdk.Context {
+				// the balance only covers the value
+				vmdb.AddBalance(addr, big.NewInt(10))
+
+				params := suite.app.EvmKeeper.GetParams(ctx)
+				params.FeeDenoms = []evmtypes.FeeDenom{{Denom: "uusdc", Rate: sdk.NewDec(1e6)}}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+
+				err := testutil.FundAccount(ctx, suite.app.BankKeeper, addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1e10)))
+				suite.Require().NoError(err)
+				return ctx.WithBlockGasMeter(sdk.NewGasMeter(1e19))
+			},
+			true, false,
+			tx2Priority,
+			func(ctx sdk.Context) {
+				balance := suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), utils.BaseDenom)
+				suite.Require().Equal(int64(10), balance.Amount.Int64())
+
+				fee := sdk.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(tx2GasLimit))).QuoRaw(1e6)
+				balance = suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), "uusdc")
+				suite.Require().Equal(sdk.NewInt(1e10).Sub(fee), balance.Amount)
+
+				payment, found := suite.app.EvmKeeper.GetFeePaymentTransient(ctx, common.HexToHash(feeDenomTx.Hash))
+				suite.Require().True(found)
+				suite.Require().Equal(evmtypes.FeePayment{Payer: addr.Hex(), Fee: sdk.NewCoin("uusdc", fee)}, payment)
+			},
+		},
 		{
 			"success - gas limit on gasMeter is set on ReCheckTx mode",
 			dynamicFeeTx,
@@ -431,6 +525,142 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite *AnteTestSuite) TestEthGasConsumeDecoratorFeeGrant() {
+	chainID := suite.app.EvmKeeper.ChainID()
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
+
+	addr, priv := testutiltx.NewAddrKey()
+	granter := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	otherGranter := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	contract := testutiltx.GenerateAddress()
+
+	ethCfg := suite.app.EvmKeeper.GetParams(suite.ctx).
+		ChainConfig.EthereumConfig(chainID)
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+
+	gasLimit := uint64(100000)
+	fee := sdk.NewIntFromBigInt(new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasLimit)))
+	granterBalance := sdk.NewInt(1e18)
+	spendLimit := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, granterBalance))
+
+	contractAllowance := func(contract common.Address) feegrant.FeeAllowanceI {
+		allowance, err := evmtypes.NewContractAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, []string{contract.Hex()}, nil)
+		suite.Require().NoError(err)
+		return allowance
+	}
+
+	grantSender := func(ctx sdk.Context, granter sdk.AccAddress, allowance feegrant.FeeAllowanceI) {
+		err := suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter, addr.Bytes(), allowance)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name       string
+		accessList ethtypes.AccessList
+		grant      func(ctx sdk.Context)
+		expGranter sdk.AccAddress
+	}{
+		{
+			"fail - no allowance",
+			ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, false)},
+			func(ctx sdk.Context) {},
+			nil,
+		},
+		{
+			"fail - allowance not selected",
+			nil,
+			func(ctx sdk.Context) {
+				grantSender(ctx, granter, &feegrant.BasicAllowance{SpendLimit: spendLimit})
+			},
+			nil,
+		},
+		{
+			"success - allowance granted to the sender",
+			ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, false)},
+			func(ctx sdk.Context) {
+				grantSender(ctx, granter, &feegrant.BasicAllowance{SpendLimit: spendLimit})
+			},
+			granter,
+		},
+		{
+			"success - allowance granted to the called contract",
+			ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, true)},
+			func(ctx sdk.Context) {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter, contract.Bytes(), contractAllowance(contract))
+				suite.Require().NoError(err)
+			},
+			granter,
+		},
+		{
+			"fail - allowance granted to the sender while the one of the contract is selected",
+			ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, true)},
+			func(ctx sdk.Context) {
+				grantSender(ctx, granter, &feegrant.BasicAllowance{SpendLimit: spendLimit})
+			},
+			nil,
+		},
+		{
+			"fail - allowance scoped to another contract",
+			ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, false)},
+			func(ctx sdk.Context) {
+				grantSender(ctx, granter, contractAllowance(testutiltx.GenerateAddress()))
+			},
+			nil,
+		},
+		{
+			"fail - allowance of another granter not used",
+			ethtypes.AccessList{evmtypes.FeeGranterAccessTuple(granter, false)},
+			func(ctx sdk.Context) {
+				grantSender(ctx, otherGranter, &feegrant.BasicAllowance{SpendLimit: spendLimit})
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := suite.ctx.CacheContext()
+			for _, addr := range []sdk.AccAddress{granter, otherGranter} {
+				err := testutil.FundAccount(cacheCtx, suite.app.BankKeeper, addr, spendLimit)
+				suite.Require().NoError(err)
+			}
+			tc.grant(cacheCtx)
+
+			msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:  chainID,
+				To:       &contract,
+				GasLimit: gasLimit,
+				GasPrice: baseFee,
+				Accesses: &tc.accessList,
+			})
+			msg.From = addr.Hex()
+			tx := suite.CreateTestTx(msg, priv, 1, false)
+			msg.From = addr.Hex()
+
+			ctx := cacheCtx.
+				WithIsCheckTx(true).
+				WithGasMeter(sdk.NewInfiniteGasMeter()).
+				WithBlockGasMeter(sdk.NewGasMeter(1e19))
+			ctx, err := dec.AnteHandle(ctx, tx, false, testutil.NextFn)
+			if tc.expGranter == nil {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			balance := suite.app.BankKeeper.GetBalance(ctx, tc.expGranter, utils.BaseDenom)
+			suite.Require().Equal(granterBalance.Sub(fee), balance.Amount)
+
+			payment, found := suite.app.EvmKeeper.GetFeePaymentTransient(ctx, common.HexToHash(msg.Hash))
+			suite.Require().True(found)
+			suite.Require().Equal(evmtypes.FeePayment{
+				Payer: common.BytesToAddress(tc.expGranter).Hex(),
+				Fee:   sdk.NewCoin(utils.BaseDenom, fee),
+			}, payment)
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestCanTransferDecorator() {
 	dec := ethante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -20,6 +20,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -37,10 +38,20 @@ type EVMKeeper interface { //nolint: revive
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	DeductTxCostsInFeeDenom(ctx sdk.Context, fees sdk.Coins, from common.Address, txHash common.Hash, choice evmtypes.FeeDenomChoice) (sdk.Coins, error)
+	SetFeePaymentTransient(ctx sdk.Context, txHash common.Hash, payment evmtypes.FeePayment)
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
+	CheckTxPermissions(ctx sdk.Context, params evmtypes.Params, from common.Address, to *common.Address, data []byte) error
+}
+
+// FeegrantKeeper defines the expected feegrant keeper interface used to pay the
+// fees of the Ethereum txs with the allowances chosen by their access list.
+type FeegrantKeeper interface {
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
 type FeeMarketKeeper interface {
@@ -0,0 +1,65 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// EthPermissionsDecorator checks the senders, contract deployments and contract
+// calls of the Ethereum transactions against the Permissions of the EVM params.
+type EthPermissionsDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthPermissionsDecorator creates a new EthPermissionsDecorator
+func NewEthPermissionsDecorator(ek EVMKeeper) EthPermissionsDecorator {
+	return EthPermissionsDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle rejects the transactions whose sender is denied or that deploy or
+// call contracts without being allowed to. The sender must have been recovered
+// by the EthSigVerificationDecorator. The contracts deployed by other contracts
+// are checked during the execution.
+func (epd EthPermissionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params := epd.evmKeeper.GetParams(ctx)
+
+	for i, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to unpack tx data any for tx %d", i)
+		}
+
+		from := common.BytesToAddress(msgEthTx.GetFrom())
+		if err := epd.evmKeeper.CheckTxPermissions(ctx, params, from, txData.GetTo(), txData.GetData()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
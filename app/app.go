@@ -126,6 +126,10 @@ import (
 	ethante "github.com/evmos/evmos/v12/app/ante/evm"
 	"github.com/evmos/evmos/v12/encoding"
 	"github.com/evmos/evmos/v12/ethereum/eip712"
+	erc20precompile "github.com/evmos/evmos/v12/precompiles/erc20"
+	forwarderprecompile "github.com/evmos/evmos/v12/precompiles/forwarder"
+	ics20precompile "github.com/evmos/evmos/v12/precompiles/ics20"
+	stakingprecompile "github.com/evmos/evmos/v12/precompiles/staking"
 	srvflags "github.com/evmos/evmos/v12/server/flags"
 	evmostypes "github.com/evmos/evmos/v12/types"
 	"github.com/evmos/evmos/v12/x/evm"
@@ -195,6 +199,11 @@ func init() {
 // Name defines the application binary name
 const Name = "akkadd"
 
+// EvmHookGasLimit defines the default gas limit of each call to the EVM hooks
+// that don't revert the transaction when they fail. It is overridden by the
+// evm_hooks params of the EVM module.
+const EvmHookGasLimit = 300_000
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -327,6 +336,9 @@ type Evmos struct {
 	// the configurator
 	configurator module.Configurator
 
+	// the ante handler, kept to replay blocks outside of the BaseApp
+	anteHandler sdk.AnteHandler
+
 	tpsCounter *tpsCounter
 }
 
@@ -456,7 +468,10 @@ func NewEvmos(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.FeeMarketKeeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
-	)
+	).WithParallelExecution(
+		cast.ToString(appOpts.Get(srvflags.EVMParallelExecution)),
+		cast.ToInt(appOpts.Get(srvflags.EVMParallelWorkers)),
+	).WithStateCache(evmkeeper.DefaultStateCacheSize)
 
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -544,10 +559,23 @@ func NewEvmos(
 
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
-			app.Erc20Keeper.Hooks(),
-			app.IncentivesKeeper.Hooks(),
-			app.RevenueKeeper.Hooks(),
-			app.ClaimsKeeper.Hooks(),
+			// a failed ERC20 conversion must revert the transfer to the module account
+			evmkeeper.NewIsolatedEvmHook(app.EvmKeeper, app.Erc20Keeper.Hooks(), evmkeeper.EvmHookOptions{
+				Name:          erc20types.ModuleName,
+				RevertOnError: true,
+			}),
+			evmkeeper.NewIsolatedEvmHook(app.EvmKeeper, app.IncentivesKeeper.Hooks(), evmkeeper.EvmHookOptions{
+				Name:     incentivestypes.ModuleName,
+				GasLimit: EvmHookGasLimit,
+			}),
+			evmkeeper.NewIsolatedEvmHook(app.EvmKeeper, app.RevenueKeeper.Hooks(), evmkeeper.EvmHookOptions{
+				Name:     revenuetypes.ModuleName,
+				GasLimit: EvmHookGasLimit,
+			}),
+			evmkeeper.NewIsolatedEvmHook(app.EvmKeeper, app.ClaimsKeeper.Hooks(), evmkeeper.EvmHookOptions{
+				Name:     claimstypes.ModuleName,
+				GasLimit: EvmHookGasLimit,
+			}),
 		),
 	)
 
@@ -559,6 +587,32 @@ func NewEvmos(
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 	)
 
+	// register the stateful precompiled contracts, which are activated through the EVM params
+	// NOTE: the staking keeper must have its hooks set beforehand
+	stakingPrecompile, err := stakingprecompile.NewPrecompile(app.StakingKeeper, app.DistrKeeper)
+	if err != nil {
+		panic(err)
+	}
+	ics20Precompile, err := ics20precompile.NewPrecompile(app.TransferKeeper, app.AuthzKeeper)
+	if err != nil {
+		panic(err)
+	}
+	forwarderPrecompile, err := forwarderprecompile.NewPrecompile(app.EvmKeeper)
+	if err != nil {
+		panic(err)
+	}
+	app.EvmKeeper = app.EvmKeeper.WithPrecompiles(stakingPrecompile, ics20Precompile, forwarderPrecompile)
+
+	// register the ERC-20 precompiled contracts of the precompile token pairs
+	erc20PrecompileProvider, err := erc20precompile.NewProvider(app.BankKeeper, app.Erc20Keeper)
+	if err != nil {
+		panic(err)
+	}
+	app.EvmKeeper = app.EvmKeeper.WithPrecompileProvider(erc20PrecompileProvider)
+
+	// pay the fees of the Ethereum txs with the ERC-20 tokens of the fee denoms token pairs
+	app.EvmKeeper = app.EvmKeeper.WithERC20Keeper(app.Erc20Keeper)
+
 	app.RecoveryKeeper = recoverykeeper.NewKeeper(
 		keys[recoverytypes.StoreKey],
 		appCodec,
@@ -869,7 +923,8 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		panic(err)
 	}
 
-	app.SetAnteHandler(ante.NewAnteHandler(options))
+	app.anteHandler = ante.NewAnteHandler(options)
+	app.SetAnteHandler(app.anteHandler)
 }
 
 func (app *Evmos) setPostHandler() {
@@ -1038,6 +1093,12 @@ func (app *Evmos) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
 }
 
+// SetBlockTxs implements the server.BlockTxsApplication interface, used by the
+// parallel execution of the Ethereum transactions of the blocks.
+func (app *Evmos) SetBlockTxs(txDecoder sdk.TxDecoder, blockTxs func(height int64) [][]byte) {
+	app.EvmKeeper.SetBlockTxs(txDecoder, blockTxs)
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *Evmos) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(
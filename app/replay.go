@@ -0,0 +1,444 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package app
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v12/encoding"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// ReplayDiff is a value that differs between the committed and the replayed
+// execution.
+type ReplayDiff struct {
+	Field     string `json:"field"`
+	Committed string `json:"committed"`
+	Replayed  string `json:"replayed"`
+}
+
+// ReplayTxResult is the comparison of a replayed transaction with its
+// committed result.
+type ReplayTxResult struct {
+	Index       int          `json:"index"`
+	Hash        string       `json:"hash"`
+	EthereumTxs []string     `json:"ethereum_txs,omitempty"`
+	Error       string       `json:"error,omitempty"`
+	Diffs       []ReplayDiff `json:"diffs,omitempty"`
+}
+
+// ReplayStorageDiff is an EVM storage slot written during the replay whose
+// value differs from the one committed at the replayed height.
+type ReplayStorageDiff struct {
+	Address   string `json:"address"`
+	Key       string `json:"key"`
+	Committed string `json:"committed"`
+	Replayed  string `json:"replayed"`
+	// Txs are the indexes of the transactions that wrote the slot, -1 being
+	// the BeginBlock.
+	Txs []int `json:"txs"`
+}
+
+// ReplayReport is the result of a block replay.
+type ReplayReport struct {
+	Height int64            `json:"height"`
+	Txs    []ReplayTxResult `json:"txs"`
+	// TouchedSlots is the number of EVM storage slots written during the replay.
+	TouchedSlots int `json:"touched_slots"`
+	// StorageChecked is false when the state committed at the replayed height
+	// isn't available (e.g. pruned), in which case the storage isn't compared.
+	StorageChecked bool                `json:"storage_checked"`
+	Storage        []ReplayStorageDiff `json:"storage,omitempty"`
+}
+
+// Mismatches returns the number of differences found by the replay.
+func (r ReplayReport) Mismatches() int {
+	n := len(r.Storage)
+	for _, tx := range r.Txs {
+		n += len(tx.Diffs)
+	}
+	return n
+}
+
+// ReplayBlock re-executes a committed block on top of the state of the previous
+// height and compares the results with the committed ones. The BeginBlock and
+// every transaction are executed as the BaseApp does, with the Ethereum
+// transactions applied through the EVM keeper ApplyTransaction. The EndBlock
+// isn't executed.
+//
+// Nothing is written to the application database: all the state changes are
+// discarded once the replay is done.
+func (app *Evmos) ReplayBlock(
+	req abci.RequestBeginBlock,
+	txs tmtypes.Txs,
+	results []*abci.ResponseDeliverTx,
+) (report *ReplayReport, err error) {
+	height := req.Header.Height
+	if len(txs) != len(results) {
+		return nil, fmt.Errorf("block %d has %d txs but %d results", height, len(txs), len(results))
+	}
+
+	cms := app.CommitMultiStore()
+	base, err := cms.CacheMultiStoreWithVersion(height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("state at height %d is not available: %w", height-1, err)
+	}
+
+	// the writes of every execution step are traced when the step is written
+	// to the base store, in order to collect the touched EVM storage slots
+	trace := new(bytes.Buffer)
+	traced := base.SetTracer(trace)
+
+	evmKey := app.GetKey(evmtypes.StoreKey)
+	touched := make(map[string][]int)
+	step := func(index int, run func(ms storetypes.CacheMultiStore) error) error {
+		ms := traced.CacheMultiStore()
+		if err := run(ms); err != nil {
+			return err
+		}
+
+		ms.Write()
+		return collectStorageWrites(trace, evmKey.Name(), index, touched)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("replay of block %d panicked: %v", height, r)
+		}
+	}()
+
+	ctx := sdk.NewContext(base, req.Header, false, app.Logger())
+
+	var gasMeter sdk.GasMeter
+	if maxGas := app.GetConsensusParams(ctx).GetBlock().GetMaxGas(); maxGas > 0 {
+		gasMeter = sdk.NewGasMeter(uint64(maxGas))
+	} else {
+		gasMeter = sdk.NewInfiniteGasMeter()
+	}
+
+	ctx = ctx.
+		WithBlockGasMeter(gasMeter).
+		WithHeaderHash(req.Hash).
+		WithConsensusParams(app.GetConsensusParams(ctx)).
+		WithVoteInfos(req.LastCommitInfo.GetVotes())
+
+	if err := step(-1, func(ms storetypes.CacheMultiStore) error {
+		app.BeginBlocker(ctx.WithMultiStore(ms), req)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	txDecoder := encoding.MakeConfig(ModuleBasics).TxConfig.TxDecoder()
+	report = &ReplayReport{Height: height}
+
+	for i, txBytes := range txs {
+		result := ReplayTxResult{
+			Index: i,
+			Hash:  fmt.Sprintf("%X", txBytes.Hash()),
+		}
+
+		if err := step(i, func(ms storetypes.CacheMultiStore) error {
+			txCtx := ctx.
+				WithMultiStore(ms).
+				WithTxBytes(txBytes).
+				WithEventManager(sdk.NewEventManager())
+
+			tx, err := txDecoder(txBytes)
+			if err != nil {
+				err = errorsmod.Wrap(errortypes.ErrTxDecode, err.Error())
+			}
+
+			var (
+				gasUsed   uint64
+				responses []*evmtypes.MsgEthereumTxResponse
+			)
+
+			if err == nil {
+				for _, msg := range tx.GetMsgs() {
+					if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+						result.EthereumTxs = append(result.EthereumTxs, ethMsg.Hash)
+					}
+				}
+
+				gasUsed, responses, err = app.replayTx(txCtx, tx)
+			}
+
+			result.Diffs = compareTxResult(results[i], gasUsed, responses, err)
+			if err != nil {
+				result.Error = err.Error()
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		report.Txs = append(report.Txs, result)
+	}
+
+	report.TouchedSlots = len(touched)
+
+	committed, err := cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return report, nil
+	}
+
+	report.StorageChecked = true
+	report.Storage = compareStorage(base.GetKVStore(evmKey), committed.GetKVStore(evmKey), touched)
+
+	return report, nil
+}
+
+// replayTx executes the transaction as the BaseApp does on DeliverTx: the ante
+// handler changes are kept even if the messages fail, while the message changes
+// are only kept if all of them succeed. It returns the gas used and the
+// responses of the Ethereum messages, indexed by message.
+func (app *Evmos) replayTx(
+	ctx sdk.Context,
+	tx sdk.Tx,
+) (gasUsed uint64, responses []*evmtypes.MsgEthereumTxResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = errorsmod.Wrap(errortypes.ErrOutOfGas, oog.Descriptor)
+			} else {
+				err = errorsmod.Wrapf(errortypes.ErrPanic, "%v", r)
+			}
+		}
+
+		gasUsed = ctx.GasMeter().GasConsumed()
+		ctx.BlockGasMeter().ConsumeGas(ctx.GasMeter().GasConsumedToLimit(), "block gas meter")
+	}()
+
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if app.anteHandler != nil {
+		anteCtx, writeAnte := ctx.CacheContext()
+		newCtx, err := app.anteHandler(anteCtx, tx, false)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		ctx = newCtx.WithMultiStore(ctx.MultiStore())
+		writeAnte()
+	}
+
+	msgCtx, writeMsgs := ctx.CacheContext()
+	responses = make([]*evmtypes.MsgEthereumTxResponse, len(msgs))
+
+	for i, msg := range msgs {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			res, err := app.EvmKeeper.ApplyTransaction(msgCtx, ethMsg.AsTransaction())
+			if err != nil {
+				return 0, nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
+			}
+
+			responses[i] = res
+			continue
+		}
+
+		handler := app.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return 0, nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		if _, err := handler(msgCtx, msg); err != nil {
+			return 0, nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+	}
+
+	writeMsgs()
+	return 0, responses, nil
+}
+
+// compareTxResult compares the replayed execution of a transaction with its
+// committed DeliverTx result.
+func compareTxResult(
+	committed *abci.ResponseDeliverTx,
+	gasUsed uint64,
+	responses []*evmtypes.MsgEthereumTxResponse,
+	replayErr error,
+) []ReplayDiff {
+	var diffs []ReplayDiff
+
+	codespace, code, _ := errorsmod.ABCIInfo(replayErr, false)
+	if committed.Codespace != codespace || committed.Code != code {
+		return append(diffs, ReplayDiff{
+			Field:     "code",
+			Committed: fmt.Sprintf("%s/%d: %s", committed.Codespace, committed.Code, committed.Log),
+			Replayed:  fmt.Sprintf("%s/%d", codespace, code),
+		})
+	}
+
+	if replayErr != nil {
+		return nil
+	}
+
+	diffs = appendDiff(diffs, "gas_used", committed.GasUsed, gasUsed)
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(committed.Data, &txMsgData); err != nil {
+		return append(diffs, ReplayDiff{Field: "data", Committed: err.Error()})
+	}
+
+	for i, replayed := range responses {
+		if replayed == nil {
+			continue
+		}
+
+		field := fmt.Sprintf("msgs[%d]", i)
+		if i >= len(txMsgData.MsgResponses) {
+			diffs = append(diffs, ReplayDiff{Field: field, Committed: "missing", Replayed: replayed.Hash})
+			continue
+		}
+
+		var res evmtypes.MsgEthereumTxResponse
+		if err := proto.Unmarshal(txMsgData.MsgResponses[i].Value, &res); err != nil {
+			diffs = append(diffs, ReplayDiff{Field: field, Committed: err.Error()})
+			continue
+		}
+
+		diffs = append(diffs, compareEthereumTxResponse(field, &res, replayed)...)
+	}
+
+	return diffs
+}
+
+// compareEthereumTxResponse compares the receipt fields of an Ethereum message.
+func compareEthereumTxResponse(field string, committed, replayed *evmtypes.MsgEthereumTxResponse) []ReplayDiff {
+	var diffs []ReplayDiff
+
+	diffs = appendDiff(diffs, field+".hash", committed.Hash, replayed.Hash)
+	diffs = appendDiff(diffs, field+".gas_used", committed.GasUsed, replayed.GasUsed)
+	diffs = appendDiff(diffs, field+".vm_error", committed.VmError, replayed.VmError)
+	diffs = appendDiff(diffs, field+".ret", hexutil.Encode(committed.Ret), hexutil.Encode(replayed.Ret))
+	diffs = appendDiff(diffs, field+".logs", len(committed.Logs), len(replayed.Logs))
+
+	for i := 0; i < len(committed.Logs) && i < len(replayed.Logs); i++ {
+		c, r := committed.Logs[i], replayed.Logs[i]
+		logField := fmt.Sprintf("%s.logs[%d]", field, i)
+
+		diffs = appendDiff(diffs, logField+".address", c.Address, r.Address)
+		diffs = appendDiff(diffs, logField+".topics", strings.Join(c.Topics, ","), strings.Join(r.Topics, ","))
+		diffs = appendDiff(diffs, logField+".data", hexutil.Encode(c.Data), hexutil.Encode(r.Data))
+		diffs = appendDiff(diffs, logField+".index", c.Index, r.Index)
+	}
+
+	return diffs
+}
+
+// appendDiff appends a diff if the committed and the replayed values differ.
+func appendDiff(diffs []ReplayDiff, field string, committed, replayed interface{}) []ReplayDiff {
+	c, r := fmt.Sprint(committed), fmt.Sprint(replayed)
+	if c == r {
+		return diffs
+	}
+
+	return append(diffs, ReplayDiff{Field: field, Committed: c, Replayed: r})
+}
+
+// collectStorageWrites reads the traced store operations and records the EVM
+// storage slots written by the execution step.
+func collectStorageWrites(trace *bytes.Buffer, storeName string, index int, touched map[string][]int) error {
+	defer trace.Reset()
+
+	dec := json.NewDecoder(trace)
+	for dec.More() {
+		var op struct {
+			Operation string                 `json:"operation"`
+			Key       string                 `json:"key"`
+			Metadata  map[string]interface{} `json:"metadata"`
+		}
+
+		if err := dec.Decode(&op); err != nil {
+			return fmt.Errorf("failed to decode store trace: %w", err)
+		}
+
+		if (op.Operation != "write" && op.Operation != "delete") || op.Metadata["store_name"] != storeName {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return err
+		}
+
+		if len(key) != len(evmtypes.KeyPrefixStorage)+common.AddressLength+common.HashLength ||
+			!bytes.HasPrefix(key, evmtypes.KeyPrefixStorage) {
+			continue
+		}
+
+		txs := touched[string(key)]
+		if len(txs) == 0 || txs[len(txs)-1] != index {
+			touched[string(key)] = append(txs, index)
+		}
+	}
+
+	return nil
+}
+
+// compareStorage compares the replayed value of the touched EVM storage slots
+// with the committed ones.
+func compareStorage(replayed, committed storetypes.KVStore, touched map[string][]int) []ReplayStorageDiff {
+	keys := make([]string, 0, len(touched))
+	for key := range touched {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var diffs []ReplayStorageDiff
+	for _, key := range keys {
+		replayedValue := common.BytesToHash(replayed.Get([]byte(key)))
+		committedValue := common.BytesToHash(committed.Get([]byte(key)))
+		if replayedValue == committedValue {
+			continue
+		}
+
+		prefixLen := len(evmtypes.KeyPrefixStorage)
+		diffs = append(diffs, ReplayStorageDiff{
+			Address:   common.BytesToAddress([]byte(key[prefixLen : prefixLen+common.AddressLength])).Hex(),
+			Key:       common.BytesToHash([]byte(key[prefixLen+common.AddressLength:])).Hex(),
+			Committed: committedValue.Hex(),
+			Replayed:  replayedValue.Hex(),
+			Txs:       touched[key],
+		})
+	}
+
+	return diffs
+}
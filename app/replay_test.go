@@ -0,0 +1,96 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/ibc-go/v6/testing/mock"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/evmos/evmos/v12/encoding"
+	"github.com/evmos/evmos/v12/utils"
+)
+
+func TestReplayBlock(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(100000000000000))),
+	}
+
+	app := NewEvmos(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{})
+
+	genesisState := GenesisStateWithValSet(app, NewDefaultGenesisState(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	chainID := utils.MainnetChainID + "-1"
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         chainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	// commit an empty first block and a second block with an undecodable tx
+	var (
+		req     abci.RequestBeginBlock
+		txs     = tmtypes.Txs{[]byte("invalid tx")}
+		results []*abci.ResponseDeliverTx
+	)
+
+	for height := int64(1); height <= 2; height++ {
+		req = abci.RequestBeginBlock{Header: tmproto.Header{ChainID: chainID, Height: height}}
+		app.BeginBlock(req)
+
+		if height == 2 {
+			for _, tx := range txs {
+				res := app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+				results = append(results, &res)
+			}
+		}
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	require.NotZero(t, results[0].Code)
+
+	report, err := app.ReplayBlock(req, txs, results)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), report.Height)
+	require.Len(t, report.Txs, 1)
+	require.NotEmpty(t, report.Txs[0].Error)
+	require.True(t, report.StorageChecked)
+	require.Zero(t, report.Mismatches())
+
+	// a tampered result is reported
+	tampered := *results[0]
+	tampered.Code = 0
+	report, err = app.ReplayBlock(req, txs, []*abci.ResponseDeliverTx{&tampered})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Mismatches())
+	require.Equal(t, "code", report.Txs[0].Diffs[0].Field)
+
+	_, err = app.ReplayBlock(req, txs, nil)
+	require.Error(t, err)
+}
@@ -17,14 +17,18 @@ package client
 
 import (
 	"bufio"
+	"crypto/ecdsa"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -32,6 +36,11 @@ import (
 	"github.com/evmos/evmos/v12/crypto/hd"
 )
 
+const (
+	flagFile     = "file"
+	flagLightKDF = "light-kdf"
+)
+
 // UnsafeExportEthKeyCommand exports a key with the given name as a private key in hex format.
 func UnsafeExportEthKeyCommand() *cobra.Command {
 	return &cobra.Command{
@@ -46,57 +55,134 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 				return err
 			}
 
-			decryptPassword := ""
-			conf := true
-
 			inBuf := bufio.NewReader(cmd.InOrStdin())
-			switch clientCtx.Keyring.Backend() {
-			case keyring.BackendFile:
-				decryptPassword, err = input.GetPassword(
-					"**WARNING this is an unsafe way to export your unencrypted private key**\nEnter key password:",
-					inBuf)
-			case keyring.BackendOS:
-				conf, err = input.GetConfirmation(
-					"**WARNING** this is an unsafe way to export your unencrypted private key, are you sure?",
-					inBuf, cmd.ErrOrStderr())
-			}
-			if err != nil || !conf {
+			key, err := exportEthPrivKey(
+				clientCtx, cmd, inBuf, args[0],
+				"**WARNING this is an unsafe way to export your unencrypted private key**\nEnter key password:",
+				"**WARNING** this is an unsafe way to export your unencrypted private key, are you sure?",
+			)
+			if err != nil || key == nil {
 				return err
 			}
 
-			// Exports private key from keybase using password
-			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], decryptPassword)
+			// Formats key for output
+			privB := ethcrypto.FromECDSA(key)
+			keyS := strings.ToUpper(hexutil.Encode(privB)[2:])
+
+			fmt.Println(keyS)
+
+			return nil
+		},
+	}
+}
+
+// ExportKeystoreCommand exports a key with the given name as a Web3 Secret Storage (v3) keystore file.
+func ExportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eth-keystore [name]",
+		Short: "Export an Ethereum private key as an encrypted keystore file",
+		Long: `Export an Ethereum private key as a Web3 Secret Storage (v3) keystore file, encrypted with
+scrypt, which can be imported by geth, clef or other Ethereum tooling. The keystore file is
+written to stdout unless the --file flag is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
-			if err != nil {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			privKey, err := exportEthPrivKey(
+				clientCtx, cmd, inBuf, args[0],
+				"Enter key password:",
+				"Export the private key as an encrypted keystore file?",
+			)
+			if err != nil || privKey == nil {
 				return err
 			}
 
-			if algo != ethsecp256k1.KeyType {
-				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+			password, err := input.GetPassword("Enter passphrase to encrypt the keystore file:", inBuf)
+			if err != nil {
+				return err
 			}
 
-			// Converts key to Evmos secp256k1 implementation
-			ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
-			if !ok {
-				return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+			scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+			if lightKDF, _ := cmd.Flags().GetBool(flagLightKDF); lightKDF {
+				scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
 			}
 
-			key, err := ethPrivKey.ToECDSA()
+			keyJSON, err := keystore.EncryptKey(newKeystoreKey(privKey), password, scryptN, scryptP)
 			if err != nil {
 				return err
 			}
 
-			// Formats key for output
-			privB := ethcrypto.FromECDSA(key)
-			keyS := strings.ToUpper(hexutil.Encode(privB)[2:])
-
-			fmt.Println(keyS)
+			output, _ := cmd.Flags().GetString(flagFile)
+			if output == "" {
+				fmt.Println(string(keyJSON))
+				return nil
+			}
 
-			return nil
+			return os.WriteFile(output, keyJSON, 0o600)
 		},
 	}
+
+	cmd.Flags().String(flagFile, "", "File to write the keystore to (default: stdout)")
+	cmd.Flags().Bool(flagLightKDF, false, "Use less secure scrypt parameters to encrypt the keystore (faster, lower memory)")
+	return cmd
+}
+
+// exportEthPrivKey decrypts the Ethereum private key with the given name from
+// the keyring. It returns a nil key if the user doesn't confirm the export.
+func exportEthPrivKey(
+	clientCtx client.Context,
+	cmd *cobra.Command,
+	inBuf *bufio.Reader,
+	name, passwordPrompt, confirmPrompt string,
+) (*ecdsa.PrivateKey, error) {
+	var err error
+	decryptPassword := ""
+	conf := true
+
+	switch clientCtx.Keyring.Backend() {
+	case keyring.BackendFile:
+		decryptPassword, err = input.GetPassword(passwordPrompt, inBuf)
+	case keyring.BackendOS:
+		conf, err = input.GetConfirmation(confirmPrompt, inBuf, cmd.ErrOrStderr())
+	}
+	if err != nil || !conf {
+		return nil, err
+	}
+
+	// Exports private key from keybase using password
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(name, decryptPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo != ethsecp256k1.KeyType {
+		return nil, fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	// Converts key to Evmos secp256k1 implementation
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+
+	return ethPrivKey.ToECDSA()
+}
+
+// newKeystoreKey creates a keystore key with a random id for the given private key.
+func newKeystoreKey(privKey *ecdsa.PrivateKey) *keystore.Key {
+	return &keystore.Key{
+		Id:         uuid.New(),
+		Address:    ethcrypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}
 }
@@ -17,13 +17,22 @@ package client
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 
+	"github.com/bgentry/speakeasy"
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
 
 	"github.com/evmos/evmos/v12/crypto/hd"
@@ -40,6 +49,19 @@ func UnsafeImportKeyCommand() *cobra.Command {
 	}
 }
 
+// ImportKeystoreCommand imports a private key from a Web3 Secret Storage (v3) keystore file.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth-keystore <name> <keyfile>",
+		Short: "Import an Ethereum keystore file into the local keybase",
+		Long: `Import a private key from a Web3 Secret Storage (v3) keystore file, as created by geth,
+clef or other Ethereum tooling, into the local keybase. Both scrypt and pbkdf2 encrypted
+keystore files are supported.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runImportKeystoreCmd,
+	}
+}
+
 func runImportCmd(cmd *cobra.Command, args []string) error {
 	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
 	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
@@ -48,16 +70,68 @@ func runImportCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	inBuf := bufio.NewReader(cmd.InOrStdin())
+	return importEthPrivKey(clientCtx, inBuf, args[0], common.FromHex(args[1]))
+}
+
+func runImportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	password, err := getKeystorePassword("Enter password to decrypt the keystore file:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt keystore file: %w", err)
+	}
+
+	if err := importEthPrivKey(clientCtx, inBuf, args[0], ethcrypto.FromECDSA(key.PrivateKey)); err != nil {
+		return err
+	}
+
+	cmd.Printf("imported key %s with address %s\n", args[0], key.Address.Hex())
+	return nil
+}
+
+// importEthPrivKey encrypts the given Ethereum private key with a passphrase
+// and stores it in the keyring with the given name.
+func importEthPrivKey(clientCtx client.Context, inBuf *bufio.Reader, name string, key []byte) error {
 	passphrase, err := input.GetPassword("Enter passphrase to encrypt your key:", inBuf)
 	if err != nil {
 		return err
 	}
 
 	privKey := &ethsecp256k1.PrivKey{
-		Key: common.FromHex(args[1]),
+		Key: key,
 	}
 
 	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, "eth_secp256k1")
 
-	return clientCtx.Keyring.ImportPrivKey(args[0], armor, passphrase)
+	return clientCtx.Keyring.ImportPrivKey(name, armor, passphrase)
+}
+
+// getKeystorePassword prompts for the password of a keystore file. Unlike
+// input.GetPassword, it doesn't enforce a minimum length, as keystore files
+// created by other tools can be encrypted with short (or empty) passwords.
+func getKeystorePassword(prompt string, inBuf *bufio.Reader) (string, error) {
+	if isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd()) {
+		return speakeasy.FAsk(os.Stderr, prompt)
+	}
+
+	password, err := inBuf.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimRight(password, "\r\n"), nil
 }
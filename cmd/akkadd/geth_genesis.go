@@ -0,0 +1,172 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/evmos/v12/types"
+	"github.com/evmos/evmos/v12/x/evm/alloc"
+)
+
+// GethGenesisCmd returns the command to convert between the genesis file and
+// a geth genesis.json.
+func GethGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "geth-genesis",
+		Short:                      "Convert the EVM genesis state from and to a geth genesis.json",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ExportGethGenesisCmd(defaultNodeHome),
+		ImportGethGenesisCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// ExportGethGenesisCmd returns the command to export the EVM state of the
+// genesis file as a geth genesis.json.
+func ExportGethGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [output-file]",
+		Short: "Export the EVM state of genesis.json as a geth genesis.json",
+		Long: `Export the EVM accounts (code and storage), the account nonces and the
+balances of the EVM denomination of genesis.json as the alloc of a geth genesis.json.
+The chain config is mapped from the EVM params. The output is written to STDOUT
+unless an output file is given.`,
+		Example: fmt.Sprintf("%s geth-genesis export geth-genesis.json", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			out := cmd.OutOrStdout()
+			if len(args) == 1 {
+				f, err := os.Create(filepath.Clean(args[0]))
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				out = f
+			}
+
+			w := bufio.NewWriter(out)
+			if err := alloc.Export(clientCtx.Codec, config.GenesisFile(), w); err != nil {
+				return fmt.Errorf("failed to export geth genesis: %w", err)
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// ImportGethGenesisCmd returns the command to import the alloc of a geth
+// genesis.json into the genesis file.
+func ImportGethGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import GETH_GENESIS_FILE",
+		Short: "Import the alloc of a geth genesis.json into genesis.json",
+		Long: `Import the alloc of a geth genesis.json into genesis.json. Each account is
+added as an EthAccount with its nonce, a balance of the EVM denomination and, if it has
+code or storage, an EVM genesis account. The chain config of the geth genesis replaces
+the EVM chain config. The accounts must not already exist in genesis.json.`,
+		Example: fmt.Sprintf("%s geth-genesis import /path/to/geth/genesis.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+
+			// write next to the genesis file so that it can be atomically replaced
+			tmp, err := os.CreateTemp(filepath.Dir(genFile), "genesis-*.json")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmp.Name())
+
+			w := bufio.NewWriter(tmp)
+			summary, err := alloc.Import(clientCtx.Codec, genFile, args[0], w)
+			if err != nil {
+				tmp.Close()
+				return fmt.Errorf("failed to import geth genesis: %w", err)
+			}
+
+			if err := w.Flush(); err != nil {
+				tmp.Close()
+				return err
+			}
+
+			if err := tmp.Close(); err != nil {
+				return err
+			}
+
+			if err := os.Rename(tmp.Name(), genFile); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf(
+				"imported %d accounts (%d contracts) holding %s of the EVM denomination\n",
+				summary.Accounts, summary.Contracts, summary.Balance,
+			)
+
+			if summary.ChainConfig == nil || summary.ChainConfig.ChainID == nil {
+				return nil
+			}
+
+			chainID, err := types.ParseChainID(summary.ChainID)
+			if err == nil && chainID.Cmp(summary.ChainConfig.ChainID) != 0 {
+				cmd.PrintErrf(
+					"WARNING: the geth chain ID %s differs from the EIP-155 chain ID %s of %s\n",
+					summary.ChainConfig.ChainID, chainID, summary.ChainID,
+				)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
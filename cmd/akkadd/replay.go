@@ -0,0 +1,188 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnode "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/evmos/evmos/v12/app"
+)
+
+// FlagHeight defines the height of the block to replay
+const FlagHeight = "height"
+
+// ReplayCmd returns the command to re-execute a committed block from the local
+// stores and compare the results with the committed ones.
+func ReplayCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-execute a committed block and diff the EVM results with the committed ones",
+		Long: `Re-execute the block at the given height on top of the state of the previous height,
+loaded from the local application store, and compare the results with the ones committed by
+the node: tx result codes, gas used, Ethereum receipts (hash, gas used, VM error, return data
+and logs) and the value of every EVM storage slot written during the block.
+
+The node must be stopped, and the state at height-1 must not be pruned. The storage is only
+compared when the state at the replayed height is also available. Nothing is written to the
+node databases. The command fails if any difference is found.`,
+		Example: fmt.Sprintf("%s evm replay --height 1000", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			if height < 2 {
+				return fmt.Errorf("invalid height %d, the first block can't be replayed", height)
+			}
+
+			blockDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockDB.Close()
+
+			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			blockStore := tmstore.NewBlockStore(blockDB)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			})
+
+			block := blockStore.LoadBlock(height)
+			if block == nil {
+				return fmt.Errorf("block %d not found", height)
+			}
+
+			abciResponses, err := stateStore.LoadABCIResponses(height)
+			if err != nil {
+				return fmt.Errorf("failed to load the results of block %d: %w", height, err)
+			}
+
+			req, err := beginBlockRequest(block, stateStore)
+			if err != nil {
+				return err
+			}
+
+			appDB, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(cfg.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			evmosApp, ok := appCreator(log.NewNopLogger(), appDB, nil, serverCtx.Viper).(*app.Evmos)
+			if !ok {
+				return fmt.Errorf("invalid application type")
+			}
+
+			report, err := evmosApp.ReplayBlock(req, block.Txs, abciResponses.DeliverTxs)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+
+			if n := report.Mismatches(); n > 0 {
+				return fmt.Errorf("found %d differences replaying block %d", n, height)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the block to replay")
+	if err := cmd.MarkFlagRequired(FlagHeight); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// beginBlockRequest builds the BeginBlock request of the block as Tendermint
+// does, using the validator set of the previous height.
+func beginBlockRequest(block *tmtypes.Block, stateStore sm.Store) (abci.RequestBeginBlock, error) {
+	state, err := stateStore.Load()
+	if err != nil {
+		return abci.RequestBeginBlock{}, err
+	}
+
+	votes := make([]abci.VoteInfo, block.LastCommit.Size())
+	if block.Height > state.InitialHeight {
+		valSet, err := stateStore.LoadValidators(block.Height - 1)
+		if err != nil {
+			return abci.RequestBeginBlock{}, err
+		}
+
+		if len(valSet.Validators) != len(votes) {
+			return abci.RequestBeginBlock{}, fmt.Errorf(
+				"commit size (%d) doesn't match the validator set size (%d) at height %d",
+				len(votes), len(valSet.Validators), block.Height,
+			)
+		}
+
+		for i, val := range valSet.Validators {
+			votes[i] = abci.VoteInfo{
+				Validator:       tmtypes.TM2PB.Validator(val),
+				SignedLastBlock: !block.LastCommit.Signatures[i].Absent(),
+			}
+		}
+	}
+
+	var byzantineValidators []abci.Evidence
+	for _, evidence := range block.Evidence.Evidence {
+		byzantineValidators = append(byzantineValidators, evidence.ABCI()...)
+	}
+
+	return abci.RequestBeginBlock{
+		Hash:   block.Hash(),
+		Header: *block.Header.ToProto(),
+		LastCommitInfo: abci.LastCommitInfo{
+			Round: block.LastCommit.Round,
+			Votes: votes,
+		},
+		ByzantineValidators: byzantineValidators,
+	}, nil
+}
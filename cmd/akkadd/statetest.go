@@ -0,0 +1,124 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/utils"
+	"github.com/evmos/evmos/v12/x/evm/statetest"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+)
+
+const (
+	// FlagFork defines the fork of the state tests to run
+	FlagFork = "fork"
+	// FlagRun defines the regular expression of the state tests to run
+	FlagRun = "run"
+)
+
+// StateTestCmd returns the command to run the ethereum/tests GeneralStateTests
+// fixtures through the EVM keeper.
+func StateTestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statetest [fixtures-dir]",
+		Short: "Run the ethereum/tests GeneralStateTests fixtures through the EVM keeper",
+		Long: `Run the GeneralStateTests fixtures of the ethereum/tests repository found in the directory
+tree through the EVM keeper ApplyMessageWithConfig, on an in-memory chain, with the chain config
+and extra EIPs of each fork. The post-state root and logs hash of every transaction variant are
+compared with the expected ones, and the mismatches are reported per fork.
+
+Forks that are not supported by the EVM are skipped. The command fails if any mismatch is found.`,
+		Example: fmt.Sprintf("%s evm statetest ./tests/GeneralStateTests/stExample --fork London", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fork, err := cmd.Flags().GetString(FlagFork)
+			if err != nil {
+				return err
+			}
+
+			var run *regexp.Regexp
+			if expr, _ := cmd.Flags().GetString(FlagRun); expr != "" {
+				if run, err = regexp.Compile(expr); err != nil {
+					return fmt.Errorf("invalid --%s expression: %w", FlagRun, err)
+				}
+			}
+
+			// the gas used must not be rounded up to match Ethereum
+			feemarketGenesis := feemarkettypes.DefaultGenesisState()
+			feemarketGenesis.Params.MinGasMultiplier = sdk.ZeroDec()
+
+			evmosApp := app.Setup(false, feemarketGenesis)
+			ctx := evmosApp.BaseApp.NewContext(false, tmproto.Header{
+				ChainID: utils.TestnetChainID + "-1",
+				Height:  1,
+			})
+
+			runner := statetest.NewRunner(evmosApp.EvmKeeper, evmosApp.AccountKeeper)
+			report, err := runner.RunDir(ctx, args[0], fork, run)
+			if err != nil {
+				return err
+			}
+
+			failures := report.Failures()
+			for _, result := range failures {
+				cmd.Printf("FAIL %s %s/%s/%d\n", result.File, result.Name, result.Fork, result.Index)
+				if result.Root != result.ExpectedRoot {
+					cmd.Printf("  post state root: got %s, want %s\n", result.Root, result.ExpectedRoot)
+				}
+				if result.Logs != result.ExpectedLogs {
+					cmd.Printf("  logs hash: got %s, want %s\n", result.Logs, result.ExpectedLogs)
+				}
+				if result.Error != "" {
+					cmd.Printf("  error: %s\n", result.Error)
+				}
+			}
+
+			forks := make([]string, 0, len(report.Forks))
+			for name := range report.Forks {
+				forks = append(forks, name)
+			}
+			sort.Strings(forks)
+
+			cmd.Printf("%-20s %8s %8s %8s\n", "FORK", "PASSED", "FAILED", "SKIPPED")
+			for _, name := range forks {
+				summary := report.Forks[name]
+				cmd.Printf("%-20s %8d %8d %8d\n", name, summary.Passed, summary.Failed, summary.Skipped)
+			}
+
+			if len(failures) > 0 {
+				return fmt.Errorf("%d of %d state tests failed", len(failures), len(report.Results))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagFork, "", "Only run the state tests of the fork")
+	cmd.Flags().String(FlagRun, "", "Only run the state tests with a name matching the regular expression")
+
+	return cmd
+}
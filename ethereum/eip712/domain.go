@@ -16,6 +16,7 @@
 package eip712
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
@@ -32,3 +33,16 @@ func createEIP712Domain(chainID uint64) apitypes.TypedDataDomain {
 
 	return domain
 }
+
+// createForwarderDomain creates the typed data domain of the forward requests
+// verified by the given forwarder contract for the given chainID.
+func createForwarderDomain(chainID uint64, forwarder common.Address) apitypes.TypedDataDomain {
+	domain := apitypes.TypedDataDomain{
+		Name:              ForwarderDomainName,
+		Version:           ForwarderDomainVersion,
+		ChainId:           math.NewHexOrDecimal256(int64(chainID)), // #nosec G701
+		VerifyingContract: forwarder.Hex(),
+	}
+
+	return domain
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package eip712
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// ForwarderDomainName is the name of the typed data domain of the forward requests.
+	ForwarderDomainName = "Forwarder"
+	// ForwarderDomainVersion is the version of the typed data domain of the forward requests.
+	ForwarderDomainVersion = "1.0.0"
+
+	forwardRequestType = "ForwardRequest"
+)
+
+// ForwardRequest defines an EIP-2771 meta-transaction: a call that the From
+// account signs and that any relayer submits through the trusted forwarder,
+// which appends the From address to the call data of the target contract.
+type ForwardRequest struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Gas   *big.Int
+	Nonce *big.Int
+	// Deadline is the unix time after which the request can't be executed, or
+	// zero if the request doesn't expire.
+	Deadline *big.Int
+	Data     []byte
+}
+
+// forwardRequestTypes defines the EIP-712 types of the forward requests.
+var forwardRequestTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	forwardRequestType: {
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "gas", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+	},
+}
+
+// WrapForwardRequestToTypedData wraps a forward request into the EIP-712
+// TypedData signed by its From account, for the given chainID and forwarder
+// contract.
+func WrapForwardRequestToTypedData(
+	chainID uint64,
+	forwarder common.Address,
+	req ForwardRequest,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       forwardRequestTypes,
+		PrimaryType: forwardRequestType,
+		Domain:      createForwarderDomain(chainID, forwarder),
+		Message: apitypes.TypedDataMessage{
+			"from":     req.From.Hex(),
+			"to":       req.To.Hex(),
+			"value":    hexOrDecimal(req.Value),
+			"gas":      hexOrDecimal(req.Gas),
+			"nonce":    hexOrDecimal(req.Nonce),
+			"deadline": hexOrDecimal(req.Deadline),
+			"data":     hexutil.Bytes(req.Data),
+		},
+	}
+}
+
+// ForwardRequestHash returns the EIP-712 hash of a forward request, which is
+// signed by its From account.
+func ForwardRequestHash(chainID uint64, forwarder common.Address, req ForwardRequest) (common.Hash, error) {
+	typedData := WrapForwardRequestToTypedData(chainID, forwarder, req)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(sigHash), nil
+}
+
+// RecoverForwardRequestSigner returns the address of the account that signed
+// the forward request. The signature is in the [R || S || V] format, where V
+// is either 0/1 or 27/28.
+func RecoverForwardRequestSigner(
+	chainID uint64,
+	forwarder common.Address,
+	req ForwardRequest,
+	signature []byte,
+) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length; expected %d; got: %d", crypto.SignatureLength, len(signature))
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, errors.New("invalid signature recovery id")
+	}
+
+	sigHash, err := ForwardRequestHash(chainID, forwarder, req)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	pubKey, err := crypto.SigToPub(sigHash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// hexOrDecimal converts an integer into its typed data representation,
+// defaulting to zero if it's not set.
+func hexOrDecimal(value *big.Int) *math.HexOrDecimal256 {
+	if value == nil {
+		return math.NewHexOrDecimal256(0)
+	}
+	return (*math.HexOrDecimal256)(value)
+}
@@ -0,0 +1,57 @@
+package eip712_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v12/ethereum/eip712"
+)
+
+func TestRecoverForwardRequestSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	chainID := uint64(9000)
+	forwarder := common.HexToAddress("0x0000000000000000000000000000000000000803")
+	req := eip712.ForwardRequest{
+		From:     from,
+		To:       common.HexToAddress("0x0000000000000000000000000000000000000abc"),
+		Value:    big.NewInt(1),
+		Gas:      big.NewInt(100000),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(0),
+		Data:     []byte{0x12, 0x34, 0x56, 0x78},
+	}
+
+	sigHash, err := eip712.ForwardRequestHash(chainID, forwarder, req)
+	require.NoError(t, err)
+	signature, err := crypto.Sign(sigHash.Bytes(), key)
+	require.NoError(t, err)
+
+	signer, err := eip712.RecoverForwardRequestSigner(chainID, forwarder, req, signature)
+	require.NoError(t, err)
+	require.Equal(t, from, signer)
+
+	// the recovery id can be offset by 27
+	signature[crypto.RecoveryIDOffset] += 27
+	signer, err = eip712.RecoverForwardRequestSigner(chainID, forwarder, req, signature)
+	require.NoError(t, err)
+	require.Equal(t, from, signer)
+
+	// the signature is only valid for the signed chain ID and forwarder
+	signer, err = eip712.RecoverForwardRequestSigner(chainID+1, forwarder, req, signature)
+	require.NoError(t, err)
+	require.NotEqual(t, from, signer)
+
+	signer, err = eip712.RecoverForwardRequestSigner(chainID, req.To, req, signature)
+	require.NoError(t, err)
+	require.NotEqual(t, from, signer)
+
+	_, err = eip712.RecoverForwardRequestSigner(chainID, forwarder, req, signature[1:])
+	require.Error(t, err)
+}
@@ -49,11 +49,21 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// syntheticTokens is set if the synthetic transactions are indexed
+	syntheticTokens *rpctypes.SyntheticTokensQuerier
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// EnableSyntheticTxs enables the indexing of the synthetic transactions, which
+// represent the balance changes of the Cosmos transactions of the tokens
+// returned by the given querier.
+func (kv *KVIndexer) EnableSyntheticTxs(tokens *rpctypes.SyntheticTokensQuerier) {
+	kv.syntheticTokens = tokens
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -82,6 +92,9 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 		}
 
 		if !isEthTx(tx) {
+			if err := kv.indexSyntheticTx(batch, block, txIndex, result); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
 			continue
 		}
 
@@ -135,6 +148,41 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 	return nil
 }
 
+// indexSyntheticTx indexes the synthetic transaction of a Cosmos transaction
+// if it has any synthetic logs. Synthetic transactions are only indexed by hash,
+// as they are not part of the transaction list of the block.
+func (kv *KVIndexer) indexSyntheticTx(batch dbm.Batch, block *tmtypes.Block, txIndex int, result *abci.ResponseDeliverTx) error {
+	if kv.syntheticTokens == nil || !rpctypes.IsSyntheticTxCandidate(result) {
+		return nil
+	}
+
+	tokens, err := kv.syntheticTokens.Tokens(block.Height)
+	if err != nil {
+		kv.logger.Error("Fail to query synthetic tokens", "err", err, "block", block.Height, "txIndex", txIndex)
+		return nil
+	}
+
+	if len(rpctypes.SyntheticLogsFromEvents(result.Events, tokens)) == 0 {
+		return nil
+	}
+
+	txResult := evmostypes.TxResult{
+		Height:     block.Height,
+		TxIndex:    uint32(txIndex),
+		EthTxIndex: -1,
+		GasUsed:    uint64(result.GasUsed),
+		Synthetic:  true,
+	}
+	txResult.CumulativeGasUsed = txResult.GasUsed
+
+	bz := kv.clientCtx.Codec.MustMarshal(&txResult)
+	txHash := rpctypes.SyntheticTxHash(block.Txs[txIndex])
+	if err := batch.Set(TxHashKey(txHash), bz); err != nil {
+		return errorsmod.Wrap(err, "set tx-hash key")
+	}
+	return nil
+}
+
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
 func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
 	return LoadLastBlock(kv.db)
@@ -0,0 +1,220 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+// Package common defines the base of the stateful precompiled contracts that
+// are registered on the EVM keeper.
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+)
+
+// Precompile is the base of the stateful precompiled contracts. It decodes the
+// calls according to the contract ABI and charges the gas of the Cosmos SDK
+// store operations performed by the contract methods.
+type Precompile struct {
+	abi.ABI
+	// ContractAddress is the address of the precompiled contract.
+	ContractAddress common.Address
+	// KvGasConfig defines the gas costs of the store operations. The flat and
+	// per byte costs are also charged upfront for the call input.
+	KvGasConfig storetypes.GasConfig
+}
+
+// NewPrecompile creates a new Precompile base with the given ABI and address,
+// using the default Cosmos SDK gas costs for the store operations.
+func NewPrecompile(contractABI abi.ABI, address common.Address) Precompile {
+	return Precompile{
+		ABI:             contractABI,
+		ContractAddress: address,
+		KvGasConfig:     storetypes.KVGasConfig(),
+	}
+}
+
+// Address returns the address of the precompiled contract.
+func (p Precompile) Address() common.Address {
+	return p.ContractAddress
+}
+
+// RequiredGas returns the gas charged upfront for the given call input: the
+// write costs for the methods that modify the state and the read costs for the
+// rest of them.
+func (p Precompile) RequiredGas(input []byte, isTransaction bool) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	argsLen := uint64(len(input) - 4)
+	if isTransaction {
+		return p.KvGasConfig.WriteCostFlat + p.KvGasConfig.WriteCostPerByte*argsLen
+	}
+	return p.KvGasConfig.ReadCostFlat + p.KvGasConfig.ReadCostPerByte*argsLen
+}
+
+// MethodFromInput returns the ABI method that matches the selector of the given
+// call input, along with its unpacked arguments.
+func (p Precompile) MethodFromInput(input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, ErrInvalidInput
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownMethod, err.Error())
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidInput, err.Error())
+	}
+	return method, args, nil
+}
+
+// CheckCall decodes the call of the given contract and returns the called ABI
+// method along with its unpacked arguments, see RunSetup for the errors
+// returned.
+func (p Precompile) CheckCall(
+	contract *vm.Contract,
+	readOnly bool,
+	isTransaction func(method string) bool,
+) (*abi.Method, []interface{}, error) {
+	method, args, err := p.MethodFromInput(contract.Input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if readOnly && isTransaction(method.Name) {
+		return nil, nil, vm.ErrWriteProtection
+	}
+
+	if !method.IsPayable() && CallValue(contract).Sign() > 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrNonPayable, method.Name)
+	}
+	return method, args, nil
+}
+
+// CallValue returns the value sent with the call of the given contract. The
+// DELEGATECALLs to the precompiled contracts have no value, which is returned as
+// zero.
+func CallValue(contract *vm.Contract) *big.Int {
+	if value := contract.Value(); value != nil {
+		return value
+	}
+	return new(big.Int)
+}
+
+// RunSetup decodes the call of the given contract and returns the Context where
+// the method must be executed, which is a branch of the transaction Context
+// that is discarded if the EVM state is reverted. The Context gas meter is
+// limited to the gas left on the contract, see UseGas.
+//
+// The ErrWriteProtection error is returned when the method modifies the state,
+// as reported by isTransaction, and the call is read-only. The ErrNonPayable
+// error is returned when value is sent to a method that is not payable.
+func (p Precompile) RunSetup(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	readOnly bool,
+	isTransaction func(method string) bool,
+) (ctx sdk.Context, stateDB statedb.ExtStateDB, method *abi.Method, args []interface{}, err error) {
+	stateDB, ok := evm.StateDB.(statedb.ExtStateDB)
+	if !ok {
+		return sdk.Context{}, nil, nil, nil, ErrNotExtStateDB
+	}
+
+	method, args, err = p.CheckCall(contract, readOnly, isTransaction)
+	if err != nil {
+		return sdk.Context{}, nil, nil, nil, err
+	}
+
+	ctx, err = stateDB.BranchContext()
+	if err != nil {
+		return sdk.Context{}, nil, nil, nil, err
+	}
+
+	ctx = ctx.
+		WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
+		WithKVGasConfig(p.KvGasConfig)
+
+	return ctx, stateDB, method, args, nil
+}
+
+// UseGas charges the gas consumed on the Context returned by RunSetup to the
+// contract.
+func UseGas(ctx sdk.Context, contract *vm.Contract) error {
+	if !contract.UseGas(ctx.GasMeter().GasConsumed()) {
+		return vm.ErrOutOfGas
+	}
+	return nil
+}
+
+// HandleGasError recovers from the out of gas panics of the Context gas meter
+// and sets the given error to vm.ErrOutOfGas. Any other panic is propagated.
+// It must be deferred as: defer HandleGasError(&err)()
+func HandleGasError(err *error) func() {
+	return func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			*err = vm.ErrOutOfGas
+		}
+	}
+}
+
+// AddLog adds an EVM log of the given ABI event, emitted by the precompiled
+// contract. The topics are the indexed arguments and the data are the
+// non-indexed arguments of the event.
+func (p Precompile) AddLog(evm *vm.EVM, eventName string, topics []common.Hash, data ...interface{}) error {
+	event, found := p.Events[eventName]
+	if !found {
+		return fmt.Errorf("event %s not found in the contract ABI", eventName)
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address:     p.ContractAddress,
+		Topics:      append([]common.Hash{event.ID}, topics...),
+		Data:        packed,
+		BlockNumber: evm.Context.BlockNumber.Uint64(),
+	})
+	return nil
+}
+
+var (
+	// ErrInvalidInput is returned when the call input can't be decoded.
+	ErrInvalidInput = errors.New("invalid precompile input")
+	// ErrUnknownMethod is returned when the call selector doesn't match any method.
+	ErrUnknownMethod = errors.New("unknown precompile method")
+	// ErrNonPayable is returned when value is sent to a method that is not payable.
+	ErrNonPayable = errors.New("precompile method is not payable")
+	// ErrNotExtStateDB is returned when the EVM StateDB doesn't support stateful precompiles.
+	ErrNotExtStateDB = errors.New("stateful precompiles are not supported by the EVM state")
+)
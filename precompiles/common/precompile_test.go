@@ -0,0 +1,93 @@
+package common
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[
+	{"type":"function","name":"balance","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"send","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]}
+]`
+
+func newTestPrecompile(t *testing.T) Precompile {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+	return NewPrecompile(contractABI, common.HexToAddress("0x0000000000000000000000000000000000000900"))
+}
+
+func TestMethodFromInput(t *testing.T) {
+	p := newTestPrecompile(t)
+	to := common.HexToAddress("0x01")
+
+	input, err := p.Pack("send", to, big.NewInt(10))
+	require.NoError(t, err)
+
+	method, args, err := p.MethodFromInput(input)
+	require.NoError(t, err)
+	require.Equal(t, "send", method.Name)
+	require.Equal(t, []interface{}{to, big.NewInt(10)}, args)
+
+	_, _, err = p.MethodFromInput(input[:3])
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	_, _, err = p.MethodFromInput([]byte{1, 2, 3, 4})
+	require.ErrorIs(t, err, ErrUnknownMethod)
+
+	_, _, err = p.MethodFromInput(input[:10])
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestCheckCall(t *testing.T) {
+	p := newTestPrecompile(t)
+	caller := vm.AccountRef(common.HexToAddress("0x01"))
+	isTransaction := func(method string) bool { return method == "send" }
+
+	input, err := p.Pack("send", common.HexToAddress("0x02"), big.NewInt(10))
+	require.NoError(t, err)
+
+	call := func(value *big.Int, readOnly bool) error {
+		contract := vm.NewContract(caller, vm.AccountRef(p.Address()), value, 100_000)
+		contract.Input = input
+		_, _, err := p.CheckCall(contract, readOnly, isTransaction)
+		return err
+	}
+
+	require.NoError(t, call(big.NewInt(0), false))
+	require.ErrorIs(t, call(big.NewInt(1), false), ErrNonPayable)
+	require.ErrorIs(t, call(big.NewInt(0), true), vm.ErrWriteProtection)
+	// the DELEGATECALLs have no value
+	require.NoError(t, call(nil, false))
+}
+
+func TestRequiredGas(t *testing.T) {
+	p := newTestPrecompile(t)
+	input := make([]byte, 36)
+
+	require.Equal(t, uint64(0), p.RequiredGas(input[:3], true))
+	require.Equal(t, p.KvGasConfig.ReadCostFlat+32*p.KvGasConfig.ReadCostPerByte, p.RequiredGas(input, false))
+	require.Equal(t, p.KvGasConfig.WriteCostFlat+32*p.KvGasConfig.WriteCostPerByte, p.RequiredGas(input, true))
+}
+
+func TestHandleGasError(t *testing.T) {
+	run := func(f func()) (err error) {
+		defer HandleGasError(&err)()
+		f()
+		return nil
+	}
+
+	require.NoError(t, run(func() {}))
+	require.ErrorIs(t, run(func() {
+		panic(storetypes.ErrorOutOfGas{Descriptor: "test"})
+	}), vm.ErrOutOfGas)
+	require.Panics(t, func() {
+		_ = run(func() { panic("other") })
+	})
+}
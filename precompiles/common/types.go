@@ -0,0 +1,61 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Coin is the ABI representation of an sdk.Coin.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// NewCoinsResponse converts the given coins into their ABI representation.
+func NewCoinsResponse(coins sdk.Coins) []Coin {
+	res := make([]Coin, len(coins))
+	for i, coin := range coins {
+		res[i] = Coin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return res
+}
+
+// NewSdkCoins converts the given ABI coins into sdk.Coins, returning an error
+// if any of them is invalid.
+func NewSdkCoins(coins []Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		if coin.Amount == nil || coin.Amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid amount for denom %s", coin.Denom)
+		}
+		sdkCoin := sdk.Coin{Denom: coin.Denom, Amount: sdk.NewIntFromBigInt(coin.Amount)}
+		if err := sdkCoin.Validate(); err != nil {
+			return nil, err
+		}
+		sdkCoins = append(sdkCoins, sdkCoin)
+	}
+	return sdkCoins.Sort(), nil
+}
+
+// AddressTopic returns the topic of an indexed address event argument.
+func AddressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @title ERC-20 precompiled contract of a native Cosmos coin
+/// @dev The interface of the precompiled contracts that expose the native
+/// Cosmos coins registered as precompile token pairs on x/erc20. The balances
+/// are the bank balances of the coin, so no conversion is required, and the
+/// allowances are stored on the x/erc20 module state. The address of the
+/// contract is derived from the module name and the coin denomination.
+interface IERC20Metadata {
+    /// @dev Emitted when value tokens are moved from one account to another.
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    /// @dev Emitted when the allowance of a spender for an owner is set.
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    /// @dev Returns the name of the coin, as defined by its bank metadata.
+    function name() external view returns (string memory);
+
+    /// @dev Returns the symbol of the coin, as defined by its bank metadata.
+    function symbol() external view returns (string memory);
+
+    /// @dev Returns the exponent of the last denomination unit of the coin metadata.
+    function decimals() external view returns (uint8);
+
+    /// @dev Returns the bank supply of the coin.
+    function totalSupply() external view returns (uint256);
+
+    /// @dev Returns the bank balance of the account.
+    function balanceOf(address account) external view returns (uint256);
+
+    /// @dev Returns the remaining tokens that the spender can transfer on behalf of the owner.
+    function allowance(address owner, address spender) external view returns (uint256);
+
+    /// @dev Moves amount tokens from the caller's account to the recipient.
+    function transfer(address to, uint256 amount) external returns (bool);
+
+    /// @dev Sets amount as the allowance of the spender over the caller's tokens.
+    function approve(address spender, uint256 amount) external returns (bool);
+
+    /// @dev Moves amount tokens from the sender to the recipient using the
+    /// allowance mechanism. The amount is deducted from the caller's allowance.
+    function transferFrom(address from, address to, uint256 amount) external returns (bool);
+
+    /// @dev Atomically increases the allowance of the spender by addedValue.
+    function increaseAllowance(address spender, uint256 addedValue) external returns (bool);
+
+    /// @dev Atomically decreases the allowance of the spender by subtractedValue.
+    function decreaseAllowance(address spender, uint256 subtractedValue) external returns (bool);
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+// Package erc20 implements the ERC-20 precompiled contracts of the native
+// Cosmos coins registered as precompile token pairs on x/erc20. The balances of
+// the contracts are the bank balances of the coins, so no conversion is
+// required. See IERC20Metadata.sol for the Solidity interface.
+package erc20
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	erc20keeper "github.com/evmos/evmos/v12/x/erc20/keeper"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// abiJSON is the ABI of the IERC20Metadata interface.
+//
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = &Precompile{}
+
+// Precompile defines the ERC-20 precompiled contract of a token pair.
+type Precompile struct {
+	cmn.Precompile
+	tokenPair   erc20types.TokenPair
+	bankKeeper  bankkeeper.Keeper
+	erc20Keeper erc20keeper.Keeper
+}
+
+// LoadABI parses the ABI of the ERC-20 precompiled contracts.
+func LoadABI() (abi.ABI, error) {
+	return abi.JSON(bytes.NewReader(abiJSON))
+}
+
+// NewPrecompile creates a new ERC-20 Precompile instance for the given token
+// pair, which is located at the token pair ERC20 address.
+func NewPrecompile(
+	contractABI abi.ABI,
+	tokenPair erc20types.TokenPair,
+	bankKeeper bankkeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
+) *Precompile {
+	return &Precompile{
+		Precompile:  cmn.NewPrecompile(contractABI, tokenPair.GetERC20Contract()),
+		tokenPair:   tokenPair,
+		bankKeeper:  bankKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// the error is returned on Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract methods with the caller as the owner
+// of the tokens.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// the gas meter of the context panics when running out of gas
+	defer cmn.HandleGasError(&err)()
+
+	if p.IsTransaction(method.Name) {
+		if err := p.checkEnabled(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	switch method.Name {
+	// ERC-20 transactions
+	case TransferMethod:
+		bz, err = p.Transfer(ctx, evm, contract, method, args)
+	case TransferFromMethod:
+		bz, err = p.TransferFrom(ctx, evm, contract, method, args)
+	case ApproveMethod:
+		bz, err = p.Approve(ctx, evm, contract, method, args)
+	case IncreaseAllowanceMethod:
+		bz, err = p.IncreaseAllowance(ctx, evm, contract, method, args)
+	case DecreaseAllowanceMethod:
+		bz, err = p.DecreaseAllowance(ctx, evm, contract, method, args)
+	// ERC-20 queries
+	case NameMethod:
+		bz, err = p.Name(ctx, method)
+	case SymbolMethod:
+		bz, err = p.Symbol(ctx, method)
+	case DecimalsMethod:
+		bz, err = p.Decimals(ctx, method)
+	case TotalSupplyMethod:
+		bz, err = p.TotalSupply(ctx, method)
+	case BalanceOfMethod:
+		bz, err = p.BalanceOf(ctx, method, args)
+	case AllowanceMethod:
+		bz, err = p.Allowance(ctx, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmn.UseGas(ctx, contract); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// IsTransaction returns true if the given method modifies the state.
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case TransferMethod, TransferFromMethod, ApproveMethod, IncreaseAllowanceMethod, DecreaseAllowanceMethod:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,256 @@
+package erc20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/precompiles/erc20"
+	precompiletestutil "github.com/evmos/evmos/v12/precompiles/testutil"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+const denom = "acoin"
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	app       *app.Evmos
+	abi       abi.ABI
+	address   common.Address
+	spender   common.Address
+	recipient common.Address
+	tokenPair erc20types.TokenPair
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app, suite.ctx = precompiletestutil.Setup(suite.T())
+	suite.address = utiltx.GenerateAddress()
+	suite.spender = utiltx.GenerateAddress()
+	suite.recipient = utiltx.GenerateAddress()
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoinPrecompile(suite.ctx, banktypes.Metadata{
+		Description: "description",
+		Base:        denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+		Name:    "Coin",
+		Symbol:  "COIN",
+		Display: "coin",
+	})
+	suite.Require().NoError(err)
+	suite.tokenPair = *pair
+
+	suite.abi, err = erc20.LoadABI()
+	suite.Require().NoError(err)
+}
+
+// call applies a message from the given address to the ERC-20 precompile.
+func (suite *PrecompileTestSuite) call(from common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	return precompiletestutil.Call(suite.T(), suite.ctx, suite.app, suite.abi, from, suite.tokenPair.GetERC20Contract(), big.NewInt(0), method, args...)
+}
+
+// query calls the given query method and returns its unpacked output.
+func (suite *PrecompileTestSuite) query(method string, args ...interface{}) interface{} {
+	res := suite.call(suite.address, method, args...)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.abi.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(out, 1)
+	return out[0]
+}
+
+func (suite *PrecompileTestSuite) balance(address common.Address) *big.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, address.Bytes(), denom).Amount.BigInt()
+}
+
+func (suite *PrecompileTestSuite) requireLog(log *evmtypes.Log, event string, from, to common.Address, value *big.Int) {
+	suite.Require().Equal(suite.tokenPair.Erc20Address, log.Address)
+	suite.Require().Equal([]string{
+		suite.abi.Events[event].ID.Hex(),
+		common.BytesToHash(from.Bytes()).Hex(),
+		common.BytesToHash(to.Bytes()).Hex(),
+	}, log.Topics)
+	suite.Require().Equal(common.BigToHash(value).Bytes(), log.Data)
+}
+
+func (suite *PrecompileTestSuite) TestRegisterCoinPrecompile() {
+	suite.Require().Equal(erc20types.PrecompileAddress(denom), suite.tokenPair.GetERC20Contract())
+	suite.Require().True(suite.tokenPair.IsPrecompile())
+
+	// no contract is deployed
+	acc := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, suite.tokenPair.GetERC20Contract())
+	suite.Require().True(acc == nil || !acc.IsContract())
+
+	// coins don't need to be converted
+	_, err := suite.app.Erc20Keeper.ConvertCoin(
+		sdk.WrapSDKContext(suite.ctx),
+		erc20types.NewMsgConvertCoin(sdk.NewCoin(denom, sdkmath.NewInt(1)), suite.address, suite.address.Bytes()),
+	)
+	suite.Require().ErrorIs(err, erc20types.ErrTokenPairPrecompile)
+}
+
+func (suite *PrecompileTestSuite) TestQueries() {
+	suite.Require().Equal("Coin", suite.query(erc20.NameMethod))
+	suite.Require().Equal("COIN", suite.query(erc20.SymbolMethod))
+	suite.Require().Equal(uint8(18), suite.query(erc20.DecimalsMethod))
+	suite.Require().Equal(big.NewInt(1000), suite.query(erc20.TotalSupplyMethod))
+	suite.Require().Equal(big.NewInt(1000), suite.query(erc20.BalanceOfMethod, suite.address))
+	suite.Require().Zero(suite.query(erc20.BalanceOfMethod, suite.recipient).(*big.Int).Sign())
+	suite.Require().Zero(suite.query(erc20.AllowanceMethod, suite.address, suite.spender).(*big.Int).Sign())
+}
+
+func (suite *PrecompileTestSuite) TestTransfer() {
+	res := suite.call(suite.address, erc20.TransferMethod, suite.recipient, big.NewInt(100))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.requireLog(res.Logs[0], erc20.EventTypeTransfer, suite.address, suite.recipient, big.NewInt(100))
+	suite.Require().Equal(big.NewInt(900), suite.balance(suite.address))
+	suite.Require().Equal(big.NewInt(100), suite.balance(suite.recipient))
+
+	// insufficient funds
+	res = suite.call(suite.address, erc20.TransferMethod, suite.recipient, big.NewInt(1000))
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(big.NewInt(900), suite.balance(suite.address))
+
+	// zero address
+	res = suite.call(suite.address, erc20.TransferMethod, common.Address{}, big.NewInt(1))
+	suite.Require().True(res.Failed())
+
+	// blocked module account
+	feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	res = suite.call(suite.address, erc20.TransferMethod, feeCollector, big.NewInt(1))
+	suite.Require().True(res.Failed())
+
+	// read-only call
+	suite.Require().Equal(big.NewInt(100), suite.query(erc20.BalanceOfMethod, suite.recipient))
+}
+
+func (suite *PrecompileTestSuite) TestApproveAndTransferFrom() {
+	// no allowance
+	res := suite.call(suite.spender, erc20.TransferFromMethod, suite.address, suite.recipient, big.NewInt(1))
+	suite.Require().True(res.Failed())
+
+	res = suite.call(suite.address, erc20.ApproveMethod, suite.spender, big.NewInt(100))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.requireLog(res.Logs[0], erc20.EventTypeApproval, suite.address, suite.spender, big.NewInt(100))
+	suite.Require().Equal(big.NewInt(100), suite.query(erc20.AllowanceMethod, suite.address, suite.spender))
+
+	res = suite.call(suite.spender, erc20.TransferFromMethod, suite.address, suite.recipient, big.NewInt(60))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 2)
+	suite.requireLog(res.Logs[0], erc20.EventTypeApproval, suite.address, suite.spender, big.NewInt(40))
+	suite.requireLog(res.Logs[1], erc20.EventTypeTransfer, suite.address, suite.recipient, big.NewInt(60))
+	suite.Require().Equal(big.NewInt(60), suite.balance(suite.recipient))
+
+	// insufficient allowance
+	res = suite.call(suite.spender, erc20.TransferFromMethod, suite.address, suite.recipient, big.NewInt(41))
+	suite.Require().True(res.Failed())
+
+	res = suite.call(suite.address, erc20.IncreaseAllowanceMethod, suite.spender, big.NewInt(10))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(big.NewInt(50), suite.query(erc20.AllowanceMethod, suite.address, suite.spender))
+
+	res = suite.call(suite.address, erc20.DecreaseAllowanceMethod, suite.spender, big.NewInt(51))
+	suite.Require().True(res.Failed())
+	res = suite.call(suite.address, erc20.DecreaseAllowanceMethod, suite.spender, big.NewInt(50))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Zero(suite.query(erc20.AllowanceMethod, suite.address, suite.spender).(*big.Int).Sign())
+
+	// the maximum allowance is not decreased
+	res = suite.call(suite.address, erc20.ApproveMethod, suite.spender, math.MaxBig256)
+	suite.Require().False(res.Failed(), res.VmError)
+	res = suite.call(suite.address, erc20.IncreaseAllowanceMethod, suite.spender, big.NewInt(1))
+	suite.Require().True(res.Failed())
+	res = suite.call(suite.spender, erc20.TransferFromMethod, suite.address, suite.recipient, big.NewInt(10))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(math.MaxBig256, suite.query(erc20.AllowanceMethod, suite.address, suite.spender))
+
+	suite.Require().Len(suite.app.Erc20Keeper.GetAllowances(suite.ctx), 1)
+}
+
+func (suite *PrecompileTestSuite) TestTransferFromContract() {
+	router := utiltx.GenerateAddress()
+	stateDB := testutil.NewStateDB(suite.ctx, suite.app.EvmKeeper)
+	stateDB.SetCode(router, testutil.ProxyContractCode(suite.tokenPair.GetERC20Contract()))
+	suite.Require().NoError(stateDB.Commit())
+
+	res := suite.call(suite.address, erc20.ApproveMethod, router, big.NewInt(100))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	input, err := suite.abi.Pack(erc20.TransferFromMethod, suite.address, suite.recipient, big.NewInt(60))
+	suite.Require().NoError(err)
+
+	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+	suite.Require().NoError(err)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.spender)
+	msg := ethtypes.NewMessage(suite.spender, &router, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, input, nil, true)
+
+	// the router contract spends its allowance
+	res, err = suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, cfg, suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{}))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 2)
+	suite.requireLog(res.Logs[0], erc20.EventTypeApproval, suite.address, router, big.NewInt(40))
+	suite.requireLog(res.Logs[1], erc20.EventTypeTransfer, suite.address, suite.recipient, big.NewInt(60))
+	suite.Require().Equal(big.NewInt(60), suite.balance(suite.recipient))
+	suite.Require().Equal(big.NewInt(940), suite.balance(suite.address))
+
+	// the allowance of the router is not available to the sender of the transaction
+	res = suite.call(suite.spender, erc20.TransferFromMethod, suite.address, suite.recipient, big.NewInt(1))
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestDisabledTokenPair() {
+	_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, denom)
+	suite.Require().NoError(err)
+
+	res := suite.call(suite.address, erc20.TransferMethod, suite.recipient, big.NewInt(1))
+	suite.Require().True(res.Failed())
+	res = suite.call(suite.address, erc20.ApproveMethod, suite.spender, big.NewInt(1))
+	suite.Require().True(res.Failed())
+
+	// queries are still available
+	suite.Require().Equal(big.NewInt(1000), suite.query(erc20.BalanceOfMethod, suite.address))
+}
+
+func (suite *PrecompileTestSuite) TestDelegateCall() {
+	precompileAddr := suite.tokenPair.GetERC20Contract()
+
+	// the DELEGATECALLs to the precompile are read-only
+	res := precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.abi, suite.address, precompileAddr, erc20.TransferMethod, suite.recipient, big.NewInt(100))
+	suite.Require().True(res.Failed())
+	suite.Require().Zero(suite.balance(suite.recipient).Sign())
+
+	res = precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.abi, suite.address, precompileAddr, erc20.BalanceOfMethod, suite.address)
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := suite.abi.Unpack(erc20.BalanceOfMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{big.NewInt(1000)}, out)
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20keeper "github.com/evmos/evmos/v12/x/erc20/keeper"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+var _ evmtypes.PrecompileProvider = &Provider{}
+
+// Provider provides the ERC-20 precompiled contracts of the precompile token
+// pairs registered on x/erc20 to the EVM keeper.
+type Provider struct {
+	abi         abi.ABI
+	bankKeeper  bankkeeper.Keeper
+	erc20Keeper erc20keeper.Keeper
+}
+
+// NewProvider creates a new Provider of the ERC-20 precompiled contracts.
+func NewProvider(bankKeeper bankkeeper.Keeper, erc20Keeper erc20keeper.Keeper) (*Provider, error) {
+	contractABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		abi:         contractABI,
+		bankKeeper:  bankKeeper,
+		erc20Keeper: erc20Keeper,
+	}, nil
+}
+
+// GetPrecompile implements the evmtypes.PrecompileProvider interface. It returns
+// the ERC-20 precompiled contract of the precompile token pair registered at the
+// given address.
+func (p Provider) GetPrecompile(ctx sdk.Context, address common.Address) (evmtypes.StatefulPrecompiledContract, bool) {
+	pair, found := p.erc20Keeper.GetPrecompileTokenPair(ctx, address)
+	if !found {
+		return nil, false
+	}
+
+	return NewPrecompile(p.abi, pair, p.bankKeeper, p.erc20Keeper), true
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Name returns the name of the coin, as defined by its bank metadata, or the
+// denomination if the coin has no metadata.
+func (p Precompile) Name(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	if !found || metadata.Name == "" {
+		return method.Outputs.Pack(p.tokenPair.Denom)
+	}
+	return method.Outputs.Pack(metadata.Name)
+}
+
+// Symbol returns the symbol of the coin, as defined by its bank metadata, or
+// the denomination if the coin has no metadata.
+func (p Precompile) Symbol(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	if !found || metadata.Symbol == "" {
+		return method.Outputs.Pack(p.tokenPair.Denom)
+	}
+	return method.Outputs.Pack(metadata.Symbol)
+}
+
+// Decimals returns the exponent of the last denomination unit of the coin
+// metadata, as for the ERC20 contracts deployed for the token pairs.
+func (p Precompile) Decimals(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	decimals := uint8(0)
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	if found && len(metadata.DenomUnits) > 0 {
+		decimals = uint8(metadata.DenomUnits[len(metadata.DenomUnits)-1].Exponent) //#nosec G701 -- validated on registration
+	}
+	return method.Outputs.Pack(decimals)
+}
+
+// TotalSupply returns the bank supply of the coin.
+func (p Precompile) TotalSupply(ctx sdk.Context, method *abi.Method) ([]byte, error) {
+	supply := p.bankKeeper.GetSupply(ctx, p.tokenPair.Denom)
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// BalanceOf returns the bank balance of the account.
+func (p Precompile) BalanceOf(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := checkArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	account, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	balance := p.bankKeeper.GetBalance(ctx, accAddress(account), p.tokenPair.Denom)
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+// Allowance returns the remaining tokens that the spender can transfer on
+// behalf of the owner.
+func (p Precompile) Allowance(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	owner, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	spender, err := parseAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	return method.Outputs.Pack(allowance)
+}
@@ -0,0 +1,220 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package erc20
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// Transfer moves the given amount of the caller's tokens to the recipient.
+func (p Precompile) Transfer(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, amount, err := parseAddressAndAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.transfer(ctx, evm, contract.Caller(), to, amount); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// TransferFrom moves the given amount of the sender's tokens to the recipient,
+// deducting it from the allowance of the caller unless the caller is the sender
+// or the allowance is the maximum uint256.
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	from, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	to, amount, err := parseAddressAndAmount(args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	spender := contract.Caller()
+	if spender != from {
+		allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), from, spender)
+		if allowance.Cmp(amount) < 0 {
+			return nil, ErrInsufficientAllowance
+		}
+
+		if allowance.Cmp(math.MaxBig256) != 0 {
+			if err := p.approve(ctx, evm, from, spender, new(big.Int).Sub(allowance, amount)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := p.transfer(ctx, evm, from, to, amount); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// Approve sets the allowance of the spender over the caller's tokens.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, amount, err := parseAddressAndAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.approve(ctx, evm, contract.Caller(), spender, amount); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// IncreaseAllowance increases the allowance of the spender over the caller's
+// tokens by the given amount.
+func (p Precompile) IncreaseAllowance(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, addedValue, err := parseAddressAndAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.Caller()
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	allowance.Add(allowance, addedValue)
+	if allowance.Cmp(math.MaxBig256) > 0 {
+		return nil, ErrAllowanceOverflow
+	}
+
+	if err := p.approve(ctx, evm, owner, spender, allowance); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// DecreaseAllowance decreases the allowance of the spender over the caller's
+// tokens by the given amount.
+func (p Precompile) DecreaseAllowance(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, subtractedValue, err := parseAddressAndAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.Caller()
+	allowance := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	if allowance.Cmp(subtractedValue) < 0 {
+		return nil, ErrDecreasedAllowanceBelowZero
+	}
+
+	if err := p.approve(ctx, evm, owner, spender, allowance.Sub(allowance, subtractedValue)); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// transfer sends the amount of coins from the sender to the recipient through
+// the bank keeper and emits the Transfer log.
+func (p Precompile) transfer(ctx sdk.Context, evm *vm.EVM, from, to common.Address, amount *big.Int) error {
+	if to == (common.Address{}) {
+		return ErrTransferToZeroAddress
+	}
+
+	if p.bankKeeper.BlockedAddr(accAddress(to)) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	if amount.Sign() > 0 {
+		coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: sdk.NewIntFromBigInt(amount)}}
+		if err := p.bankKeeper.SendCoins(ctx, accAddress(from), accAddress(to), coins); err != nil {
+			return err
+		}
+	}
+
+	return p.AddLog(
+		evm, EventTypeTransfer,
+		[]common.Hash{cmn.AddressTopic(from), cmn.AddressTopic(to)},
+		amount,
+	)
+}
+
+// approve sets the allowance of the spender over the owner's tokens and emits
+// the Approval log.
+func (p Precompile) approve(ctx sdk.Context, evm *vm.EVM, owner, spender common.Address, amount *big.Int) error {
+	if spender == (common.Address{}) {
+		return ErrApproveToZeroAddress
+	}
+
+	p.erc20Keeper.SetAllowance(ctx, p.Address(), owner, spender, amount)
+
+	return p.AddLog(
+		evm, EventTypeApproval,
+		[]common.Hash{cmn.AddressTopic(owner), cmn.AddressTopic(spender)},
+		amount,
+	)
+}
+
+// checkEnabled returns an error if the transactions of the precompile are
+// disabled, either through the x/erc20 parameters or the token pair status.
+func (p Precompile) checkEnabled(ctx sdk.Context) error {
+	if !p.erc20Keeper.IsERC20Enabled(ctx) {
+		return errorsmod.Wrap(erc20types.ErrERC20Disabled, "module is currently disabled by governance")
+	}
+
+	// the token pair is loaded again, as it could have been toggled within the block
+	pair, found := p.erc20Keeper.GetTokenPair(ctx, p.tokenPair.GetID())
+	if !found || !pair.Enabled {
+		return errorsmod.Wrapf(erc20types.ErrERC20TokenPairDisabled, "token '%s' is not enabled by governance", p.tokenPair.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package erc20
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+const (
+	// TransferMethod defines the ABI method name of the transfer transaction.
+	TransferMethod = "transfer"
+	// TransferFromMethod defines the ABI method name of the transferFrom transaction.
+	TransferFromMethod = "transferFrom"
+	// ApproveMethod defines the ABI method name of the approve transaction.
+	ApproveMethod = "approve"
+	// IncreaseAllowanceMethod defines the ABI method name of the increaseAllowance transaction.
+	IncreaseAllowanceMethod = "increaseAllowance"
+	// DecreaseAllowanceMethod defines the ABI method name of the decreaseAllowance transaction.
+	DecreaseAllowanceMethod = "decreaseAllowance"
+	// NameMethod defines the ABI method name of the name query.
+	NameMethod = "name"
+	// SymbolMethod defines the ABI method name of the symbol query.
+	SymbolMethod = "symbol"
+	// DecimalsMethod defines the ABI method name of the decimals query.
+	DecimalsMethod = "decimals"
+	// TotalSupplyMethod defines the ABI method name of the totalSupply query.
+	TotalSupplyMethod = "totalSupply"
+	// BalanceOfMethod defines the ABI method name of the balanceOf query.
+	BalanceOfMethod = "balanceOf"
+	// AllowanceMethod defines the ABI method name of the allowance query.
+	AllowanceMethod = "allowance"
+)
+
+const (
+	// EventTypeTransfer defines the event type of the token transfers.
+	EventTypeTransfer = "Transfer"
+	// EventTypeApproval defines the event type of the allowance updates.
+	EventTypeApproval = "Approval"
+)
+
+var (
+	// ErrTransferToZeroAddress is returned when the recipient of a transfer is the zero address.
+	ErrTransferToZeroAddress = errors.New("ERC20: transfer to the zero address")
+	// ErrApproveToZeroAddress is returned when the spender of an allowance is the zero address.
+	ErrApproveToZeroAddress = errors.New("ERC20: approve to the zero address")
+	// ErrInsufficientAllowance is returned when the allowance of the spender is lower than the amount.
+	ErrInsufficientAllowance = errors.New("ERC20: insufficient allowance")
+	// ErrDecreasedAllowanceBelowZero is returned when the allowance is decreased by more than its value.
+	ErrDecreasedAllowanceBelowZero = errors.New("ERC20: decreased allowance below zero")
+	// ErrAllowanceOverflow is returned when the allowance is increased over the maximum uint256.
+	ErrAllowanceOverflow = errors.New("ERC20: allowance overflow")
+)
+
+// parseAddress parses an address argument.
+func parseAddress(arg interface{}) (common.Address, error) {
+	address, ok := arg.(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid address: %v", arg)
+	}
+	return address, nil
+}
+
+// parseAmount parses a uint256 amount, which can be zero.
+func parseAmount(arg interface{}) (*big.Int, error) {
+	amount, ok := arg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() < 0 || amount.Cmp(math.MaxBig256) > 0 {
+		return nil, fmt.Errorf("invalid amount: %v", arg)
+	}
+	return amount, nil
+}
+
+// parseAddressAndAmount parses the address and amount arguments shared by most
+// of the ERC-20 transactions.
+func parseAddressAndAmount(args []interface{}) (common.Address, *big.Int, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return common.Address{}, nil, err
+	}
+	address, err := parseAddress(args[0])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	amount, err := parseAmount(args[1])
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return address, amount, nil
+}
+
+// checkArgsLength returns an error if the number of arguments is not the expected one.
+func checkArgsLength(args []interface{}, expected int) error {
+	if len(args) != expected {
+		return fmt.Errorf("invalid number of arguments; expected %d; got: %d", expected, len(args))
+	}
+	return nil
+}
+
+// accAddress returns the Cosmos account address of a hex address.
+func accAddress(address common.Address) sdk.AccAddress {
+	return address.Bytes()
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ForwarderI contract's address.
+address constant FORWARDER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The ForwarderI contract's instance.
+ForwarderI constant FORWARDER_CONTRACT = ForwarderI(FORWARDER_PRECOMPILE_ADDRESS);
+
+/// @dev ForwardRequest is an EIP-2771 meta-transaction, signed by the `from` account
+/// as EIP-712 typed data with the domain:
+/// {name: "Forwarder", version: "1.0.0", chainId: block.chainid, verifyingContract: FORWARDER_PRECOMPILE_ADDRESS}.
+/// The deadline is the unix time after which the request can't be executed, or zero
+/// if the request doesn't expire.
+struct ForwardRequest {
+    address from;
+    address to;
+    uint256 value;
+    uint256 gas;
+    uint256 nonce;
+    uint256 deadline;
+    bytes data;
+}
+
+/// @title EIP-2771 trusted forwarder precompiled contract
+/// @dev The interface through which relayers submit the forward requests signed by
+/// other accounts. The forwarder calls the `to` contract with the request data
+/// followed by the `from` address, so the contracts that trust the forwarder
+/// read the signer as the sender of the call (i.e. `_msgSender()`).
+interface ForwarderI {
+    /// @dev Returns the nonce of the next forward request of the given account.
+    function getNonce(address from) external view returns (uint256 nonce);
+
+    /// @dev Returns true if the forward request can be executed: the signature is valid,
+    /// the nonce is the next one of the signer and the request hasn't expired.
+    function verify(ForwardRequest calldata request, bytes calldata signature) external view returns (bool valid);
+
+    /// @dev Executes the forward request, which must be valid. The value sent must match
+    /// the request value, and the gas left must cover the request gas. The nonce of the
+    /// signer is used even if the forwarded call fails, in which case the value is
+    /// returned to the relayer.
+    function execute(
+        ForwardRequest calldata request,
+        bytes calldata signature
+    ) external payable returns (bool success, bytes memory returnData);
+
+    /// @dev Emitted when a forward request is executed.
+    event ExecutedForwardRequest(address indexed from, address indexed to, uint256 nonce, bool success);
+}
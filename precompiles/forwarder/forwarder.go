@@ -0,0 +1,117 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+// Package forwarder implements the EIP-2771 trusted forwarder precompiled
+// contract, through which relayers submit the calls signed by other accounts.
+// See ForwarderI.sol for the Solidity interface.
+package forwarder
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	evmkeeper "github.com/evmos/evmos/v12/x/evm/keeper"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// PrecompileAddress is the address of the forwarder precompiled contract.
+const PrecompileAddress = "0x0000000000000000000000000000000000000803"
+
+// abiJSON is the ABI of the ForwarderI interface.
+//
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = &Precompile{}
+
+// Precompile defines the forwarder precompiled contract. The nonces of the
+// signers are kept in the EVM storage of the precompile address, and the
+// forwarded calls are checked against the permissions of the EVM parameters.
+type Precompile struct {
+	cmn.Precompile
+	evmKeeper *evmkeeper.Keeper
+}
+
+// LoadABI returns the parsed ABI of the ForwarderI interface.
+func LoadABI() (abi.ABI, error) {
+	return abi.JSON(bytes.NewReader(abiJSON))
+}
+
+// NewPrecompile creates a new forwarder Precompile instance.
+func NewPrecompile(evmKeeper *evmkeeper.Keeper) (*Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.NewPrecompile(newABI, common.HexToAddress(PrecompileAddress)),
+		evmKeeper:  evmKeeper,
+	}, nil
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract,
+// which includes the recovery of the signer for the methods that verify the
+// forward requests. The gas of the forwarded call is charged on Run.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// the error is returned on Run
+		return 0
+	}
+
+	gas := p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+	if method.Name != GetNonceMethod {
+		gas += params.EcrecoverGas
+	}
+	return gas
+}
+
+// Run executes the precompiled contract methods.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := p.CheckCall(contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// Forwarder transactions
+	case ExecuteMethod:
+		return p.Execute(evm, contract, method, args)
+	// Forwarder queries
+	case VerifyMethod:
+		return p.Verify(evm, method, args)
+	case GetNonceMethod:
+		return p.GetNonce(evm, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction returns true if the given method modifies the state.
+func (Precompile) IsTransaction(method string) bool {
+	return method == ExecuteMethod
+}
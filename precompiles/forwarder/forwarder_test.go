@@ -0,0 +1,292 @@
+package forwarder_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v12/ethereum/eip712"
+	"github.com/evmos/evmos/v12/precompiles/forwarder"
+	precompiletestutil "github.com/evmos/evmos/v12/precompiles/testutil"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/utils"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// senderEchoCode is the runtime code of a contract that returns the last 32
+// bytes of its call input, whose lower 20 bytes are the EIP-2771 sender.
+var senderEchoCode = common.FromHex("0x36602090033560005260206000f3")
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *app.Evmos
+	relayer    common.Address
+	signer     common.Address
+	signerKey  *ethsecp256k1.PrivKey
+	target     common.Address
+	precompile *forwarder.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app, suite.ctx = precompiletestutil.Setup(suite.T())
+	suite.relayer = utiltx.GenerateAddress()
+	suite.signer, suite.signerKey = utiltx.NewAddrKey()
+	suite.target = utiltx.GenerateAddress()
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.relayer.Bytes(), sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18))))
+	suite.Require().NoError(err)
+
+	stateDB := testutil.NewStateDB(suite.ctx, suite.app.EvmKeeper)
+	stateDB.SetCode(suite.target, senderEchoCode)
+	suite.Require().NoError(stateDB.Commit())
+
+	suite.precompile, err = forwarder.NewPrecompile(suite.app.EvmKeeper)
+	suite.Require().NoError(err)
+
+	precompiletestutil.ActivatePrecompiles(suite.T(), suite.ctx, suite.app, forwarder.PrecompileAddress)
+}
+
+// call applies a message from the relayer to the forwarder precompile.
+func (suite *PrecompileTestSuite) call(value *big.Int, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	return precompiletestutil.Call(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.relayer, common.HexToAddress(forwarder.PrecompileAddress), value, method, args...)
+}
+
+// sign returns the signature of the forward request by the given key.
+func (suite *PrecompileTestSuite) sign(req forwarder.ForwardRequest, key *ethsecp256k1.PrivKey) []byte {
+	sigHash, err := eip712.ForwardRequestHash(suite.app.EvmKeeper.ChainID().Uint64(), suite.precompile.Address(), req)
+	suite.Require().NoError(err)
+
+	ecdsaKey, err := key.ToECDSA()
+	suite.Require().NoError(err)
+
+	signature, err := crypto.Sign(sigHash.Bytes(), ecdsaKey)
+	suite.Require().NoError(err)
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature
+}
+
+func (suite *PrecompileTestSuite) nonce() *big.Int {
+	res := suite.call(big.NewInt(0), forwarder.GetNonceMethod, suite.signer)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(forwarder.GetNonceMethod, res.Ret)
+	suite.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *PrecompileTestSuite) request() forwarder.ForwardRequest {
+	return forwarder.ForwardRequest{
+		From:     suite.signer,
+		To:       suite.target,
+		Value:    big.NewInt(100),
+		Gas:      big.NewInt(100_000),
+		Nonce:    suite.nonce(),
+		Deadline: big.NewInt(0),
+		Data:     common.FromHex("0x12345678000000000000000000000000"),
+	}
+}
+
+func (suite *PrecompileTestSuite) TestExecute() {
+	req := suite.request()
+	signature := suite.sign(req, suite.signerKey)
+
+	// the value sent must match the request value
+	res := suite.call(big.NewInt(0), forwarder.ExecuteMethod, req, signature)
+	suite.Require().True(res.Failed())
+
+	res = suite.call(req.Value, forwarder.ExecuteMethod, req, signature)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(forwarder.ExecuteMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().True(out[0].(bool))
+	// the target contract reads the signer as the sender
+	suite.Require().Equal(suite.signer, common.BytesToAddress(out[1].([]byte)))
+
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(suite.precompile.Events[forwarder.EventTypeExecutedForwardRequest].ID.Hex(), res.Logs[0].Topics[0])
+	suite.Require().Equal(big.NewInt(1), suite.nonce())
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.target.Bytes(), utils.BaseDenom)
+	suite.Require().Equal(req.Value.Int64(), balance.Amount.Int64())
+
+	// the request can't be replayed
+	res = suite.call(req.Value, forwarder.ExecuteMethod, req, signature)
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestExecuteFailedCall() {
+	// the forwarded call runs out of gas
+	req := suite.request()
+	req.Gas = big.NewInt(1)
+	signature := suite.sign(req, suite.signerKey)
+
+	res := suite.call(req.Value, forwarder.ExecuteMethod, req, signature)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(forwarder.ExecuteMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().False(out[0].(bool))
+
+	// the nonce is used regardless of the result of the call
+	suite.Require().Equal(big.NewInt(1), suite.nonce())
+
+	// the value is returned to the relayer
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.relayer.Bytes(), utils.BaseDenom)
+	suite.Require().Equal(int64(1e18), balance.Amount.Int64())
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, suite.target.Bytes(), utils.BaseDenom)
+	suite.Require().True(balance.IsZero())
+}
+
+func (suite *PrecompileTestSuite) TestExecutePermissions() {
+	testCases := []struct {
+		name        string
+		permissions func() evmtypes.Permissions
+		expErr      error
+	}{
+		{
+			"denied signer",
+			func() evmtypes.Permissions {
+				return evmtypes.Permissions{DeniedSenders: []string{suite.signer.Hex()}}
+			},
+			evmtypes.ErrSenderDenied,
+		},
+		{
+			"signer not allowed to call the target",
+			func() evmtypes.Permissions {
+				return evmtypes.Permissions{Call: evmtypes.CallPolicy{
+					Permissioned:    true,
+					PublicContracts: []string{forwarder.PrecompileAddress},
+				}}
+			},
+			evmtypes.ErrCallNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			req := suite.request()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.Permissions = tc.permissions()
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			res := suite.call(req.Value, forwarder.ExecuteMethod, req, suite.sign(req, suite.signerKey))
+			suite.Require().True(res.Failed())
+			suite.Require().Contains(res.VmError, tc.expErr.Error())
+
+			// the request isn't executed
+			suite.Require().Zero(suite.nonce().Sign())
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.target.Bytes(), utils.BaseDenom)
+			suite.Require().True(balance.IsZero())
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestVerify() {
+	_, otherKey := utiltx.NewAddrKey()
+
+	testCases := []struct {
+		name     string
+		malleate func(req *forwarder.ForwardRequest) []byte
+		expValid bool
+	}{
+		{
+			"valid",
+			func(req *forwarder.ForwardRequest) []byte {
+				return suite.sign(*req, suite.signerKey)
+			},
+			true,
+		},
+		{
+			"valid - not expired",
+			func(req *forwarder.ForwardRequest) []byte {
+				req.Deadline = big.NewInt(suite.ctx.BlockTime().Unix() + 60)
+				return suite.sign(*req, suite.signerKey)
+			},
+			true,
+		},
+		{
+			"invalid - signed by another account",
+			func(req *forwarder.ForwardRequest) []byte {
+				return suite.sign(*req, otherKey)
+			},
+			false,
+		},
+		{
+			"invalid - modified after signing",
+			func(req *forwarder.ForwardRequest) []byte {
+				signature := suite.sign(*req, suite.signerKey)
+				req.Value = big.NewInt(1e18)
+				return signature
+			},
+			false,
+		},
+		{
+			"invalid - nonce",
+			func(req *forwarder.ForwardRequest) []byte {
+				req.Nonce = big.NewInt(1)
+				return suite.sign(*req, suite.signerKey)
+			},
+			false,
+		},
+		{
+			"invalid - expired",
+			func(req *forwarder.ForwardRequest) []byte {
+				req.Deadline = big.NewInt(suite.ctx.BlockTime().Unix() - 60)
+				return suite.sign(*req, suite.signerKey)
+			},
+			false,
+		},
+		{
+			"invalid - signature length",
+			func(req *forwarder.ForwardRequest) []byte {
+				return suite.sign(*req, suite.signerKey)[1:]
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := suite.request()
+			signature := tc.malleate(&req)
+
+			res := suite.call(big.NewInt(0), forwarder.VerifyMethod, req, signature)
+			suite.Require().False(res.Failed(), res.VmError)
+
+			out, err := suite.precompile.Unpack(forwarder.VerifyMethod, res.Ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expValid, out[0].(bool))
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestDelegateCall() {
+	precompileAddr := common.HexToAddress(forwarder.PrecompileAddress)
+	req := suite.request()
+	req.Value = big.NewInt(0)
+
+	// the DELEGATECALLs to the precompile are read-only
+	res := precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.relayer, precompileAddr, forwarder.ExecuteMethod, req, suite.sign(req, suite.signerKey))
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(req.Nonce, suite.nonce())
+
+	res = precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.relayer, precompileAddr, forwarder.GetNonceMethod, suite.signer)
+	suite.Require().False(res.Failed(), res.VmError)
+}
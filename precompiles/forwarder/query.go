@@ -0,0 +1,52 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package forwarder
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// GetNonce returns the nonce of the next forward request of an account.
+func (p Precompile) GetNonce(
+	evm *vm.EVM,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	from, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.getNonce(evm, from))
+}
+
+// Verify returns true if the forward request can be executed.
+func (p Precompile) Verify(
+	evm *vm.EVM,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, signature, err := parseRequestArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.verify(evm, req, signature) == nil)
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package forwarder
+
+import (
+	"fmt"
+	"math/big"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+)
+
+// Execute verifies the forward request and calls its target contract from the
+// forwarder, with the request data followed by the signer address as input.
+// The signer must be allowed to call the target by the permissions of the EVM
+// parameters, as if it sent the call in a transaction. The nonce of the signer
+// is used regardless of the result of the forwarded call, which is returned
+// along with its return data.
+func (p Precompile) Execute(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, signature, err := parseRequestArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.verify(evm, req, signature); err != nil {
+		return nil, err
+	}
+
+	if err := p.checkPermissions(evm, contract, req); err != nil {
+		return nil, err
+	}
+
+	value := req.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	if cmn.CallValue(contract).Cmp(value) != 0 {
+		return nil, fmt.Errorf("invalid value; expected %s; got: %s", value, cmn.CallValue(contract))
+	}
+
+	gas := req.Gas.Uint64()
+	if contract.Gas < gas {
+		return nil, fmt.Errorf("insufficient gas for the forward request; expected %d; got: %d", gas, contract.Gas)
+	}
+
+	p.setNonce(evm, req.From, new(big.Int).Add(req.Nonce, big.NewInt(1)))
+
+	input := make([]byte, 0, len(req.Data)+len(req.From))
+	input = append(input, req.Data...)
+	input = append(input, req.From.Bytes()...)
+
+	// the value sent to the forwarder is transferred to the target contract
+	ret, leftOverGas, callErr := evm.Call(vm.AccountRef(p.Address()), req.To, input, gas, value)
+	if !contract.UseGas(gas - leftOverGas) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	success := callErr == nil
+	if !success && value.Sign() > 0 {
+		// the value is returned to the relayer
+		evm.Context.Transfer(evm.StateDB, p.Address(), contract.Caller(), value)
+	}
+
+	if err := p.AddLog(
+		evm,
+		EventTypeExecutedForwardRequest,
+		[]common.Hash{cmn.AddressTopic(req.From), cmn.AddressTopic(req.To)},
+		req.Nonce,
+		success,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(success, ret)
+}
+
+// checkPermissions checks the signer and the target of the forward request
+// against the permissions of the EVM parameters. The gas of the store reads is
+// charged to the contract.
+func (p Precompile) checkPermissions(evm *vm.EVM, contract *vm.Contract, req ForwardRequest) (err error) {
+	stateDB, ok := evm.StateDB.(statedb.ExtStateDB)
+	if !ok {
+		return cmn.ErrNotExtStateDB
+	}
+
+	ctx, err := stateDB.BranchContext()
+	if err != nil {
+		return err
+	}
+	ctx = ctx.
+		WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
+		WithKVGasConfig(p.KvGasConfig)
+
+	defer cmn.HandleGasError(&err)()
+
+	params := p.evmKeeper.GetParams(ctx)
+	if err := p.evmKeeper.CheckTxPermissions(ctx, params, req.From, &req.To, req.Data); err != nil {
+		return err
+	}
+	return cmn.UseGas(ctx, contract)
+}
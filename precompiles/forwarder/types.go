@@ -0,0 +1,146 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package forwarder
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/ethereum/eip712"
+)
+
+const (
+	// ExecuteMethod defines the ABI method name of the execute transaction.
+	ExecuteMethod = "execute"
+	// VerifyMethod defines the ABI method name of the verify query.
+	VerifyMethod = "verify"
+	// GetNonceMethod defines the ABI method name of the getNonce query.
+	GetNonceMethod = "getNonce"
+)
+
+// EventTypeExecutedForwardRequest defines the event type of the execute transaction.
+const EventTypeExecutedForwardRequest = "ExecutedForwardRequest"
+
+// ForwardRequest is the ABI representation of an EIP-2771 forward request.
+type ForwardRequest = eip712.ForwardRequest
+
+var (
+	// ErrInvalidNonce is returned when the nonce of a forward request isn't the
+	// next one of the signer.
+	ErrInvalidNonce = errors.New("invalid forward request nonce")
+	// ErrExpiredRequest is returned when the deadline of a forward request has passed.
+	ErrExpiredRequest = errors.New("forward request expired")
+	// ErrInvalidSignature is returned when a forward request isn't signed by its From account.
+	ErrInvalidSignature = errors.New("invalid forward request signature")
+)
+
+// nonceKey returns the EVM storage key of the nonce of the given account.
+func nonceKey(from common.Address) common.Hash {
+	return crypto.Keccak256Hash(from.Bytes())
+}
+
+// getNonce returns the nonce of the next forward request of the given account.
+func (p Precompile) getNonce(evm *vm.EVM, from common.Address) *big.Int {
+	return evm.StateDB.GetState(p.Address(), nonceKey(from)).Big()
+}
+
+// setNonce sets the nonce of the next forward request of the given account.
+func (p Precompile) setNonce(evm *vm.EVM, from common.Address, nonce *big.Int) {
+	evm.StateDB.SetState(p.Address(), nonceKey(from), common.BigToHash(nonce))
+}
+
+// verify returns an error if the forward request can't be executed: it must
+// be signed by its From account, its nonce must be the next one of the signer
+// and its deadline, if any, must not have passed.
+func (p Precompile) verify(evm *vm.EVM, req ForwardRequest, signature []byte) error {
+	if req.Gas == nil || !req.Gas.IsUint64() {
+		return fmt.Errorf("invalid forward request gas: %v", req.Gas)
+	}
+
+	if nonce := p.getNonce(evm, req.From); req.Nonce == nil || req.Nonce.Cmp(nonce) != 0 {
+		return fmt.Errorf("%w; expected %s; got: %v", ErrInvalidNonce, nonce, req.Nonce)
+	}
+
+	if req.Deadline != nil && req.Deadline.Sign() > 0 && evm.Context.Time.Cmp(req.Deadline) > 0 {
+		return fmt.Errorf("%w at %s", ErrExpiredRequest, req.Deadline)
+	}
+
+	signer, err := eip712.RecoverForwardRequestSigner(evm.ChainConfig().ChainID.Uint64(), p.Address(), req, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+	if signer != req.From {
+		return fmt.Errorf("%w; signer %s; from: %s", ErrInvalidSignature, signer, req.From)
+	}
+	return nil
+}
+
+// parseRequestArgs parses the forward request and signature arguments of the
+// execute and verify methods.
+func parseRequestArgs(args []interface{}) (ForwardRequest, []byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return ForwardRequest{}, nil, err
+	}
+
+	var req ForwardRequest
+	if err := convertType(args[0], &req); err != nil {
+		return ForwardRequest{}, nil, fmt.Errorf("invalid forward request: %s", err.Error())
+	}
+	if req.From == (common.Address{}) || req.To == (common.Address{}) {
+		return ForwardRequest{}, nil, fmt.Errorf("invalid forward request addresses; from: %s; to: %s", req.From, req.To)
+	}
+
+	signature, ok := args[1].([]byte)
+	if !ok {
+		return ForwardRequest{}, nil, fmt.Errorf("invalid signature: %v", args[1])
+	}
+	return req, signature, nil
+}
+
+// parseAddress parses a non-zero address argument.
+func parseAddress(arg interface{}) (common.Address, error) {
+	address, ok := arg.(common.Address)
+	if !ok || address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("invalid address: %v", arg)
+	}
+	return address, nil
+}
+
+// convertType converts an unpacked ABI tuple into the given struct pointer.
+func convertType(arg interface{}, out interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	abi.ConvertType(arg, out)
+	return nil
+}
+
+// checkArgsLength returns an error if the number of arguments is not the expected one.
+func checkArgsLength(args []interface{}, expected int) error {
+	if len(args) != expected {
+		return fmt.Errorf("invalid number of arguments; expected %d; got: %d", expected, len(args))
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICS20I contract's address.
+address constant ICS20_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000802;
+
+/// @dev The ICS20I contract's instance.
+ICS20I constant ICS20_CONTRACT = ICS20I(ICS20_PRECOMPILE_ADDRESS);
+
+/// @dev Coin is a struct that represents a token with a denomination and an amount.
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+/// @dev Height is a monotonically increasing data type that can be compared
+/// against another Height for the purposes of updating and freezing clients.
+struct Height {
+    uint64 revisionNumber;
+    uint64 revisionHeight;
+}
+
+/// @dev Allocation is the spend limit of the transfers approved to a grantee
+/// through a port and channel. An empty allow list allows any receiver.
+struct Allocation {
+    string sourcePort;
+    string sourceChannel;
+    Coin[] spendLimit;
+    string[] allowList;
+}
+
+/// @dev DenomTrace contains the base denomination of an ICS-20 fungible token
+/// and the source tracing information path.
+struct DenomTrace {
+    string path;
+    string baseDenom;
+}
+
+/// @title ICS-20 transfer precompiled contract
+/// @dev The interface through which Solidity contracts and accounts send
+/// ICS-20 transfers. The transfers are executed through the transfer keeper of
+/// the chain, so the ERC-20 tokens of the registered token pairs are converted
+/// automatically. Accounts can only transfer their own funds, or the funds of
+/// another account that approved them a spend limit through approve.
+interface ICS20I {
+    /// @dev Transfers the tokens of the caller through the given port and channel.
+    /// @return nextSequence The sequence number of the transfer packet.
+    function transfer(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
+    /// @dev Transfers the tokens of the sender, who must have approved the caller.
+    /// @return nextSequence The sequence number of the transfer packet.
+    function transferFrom(
+        address sender,
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
+    /// @dev Approves the grantee to transfer the caller's tokens within the given
+    /// allocations. It overwrites any previous approval of the grantee.
+    function approve(address grantee, Allocation[] calldata allocations) external returns (bool approved);
+
+    /// @dev Revokes the approval of the grantee.
+    function revoke(address grantee) external returns (bool revoked);
+
+    /// @dev Returns the allocations left that the granter approved to the grantee.
+    function allowance(address granter, address grantee) external view returns (Allocation[] memory allocations);
+
+    /// @dev Returns the denomination trace of the given hash (with or without the ibc/ prefix).
+    function denomTrace(string memory hash) external view returns (DenomTrace memory denomTrace);
+
+    /// @dev Returns the hash of the given denomination trace (eg: transfer/channel-0/uatom).
+    function denomHash(string memory trace) external view returns (string memory hash);
+
+    /// @dev Emitted when an ICS-20 transfer is sent.
+    event IBCTransfer(
+        address indexed sender,
+        string sourcePort,
+        string sourceChannel,
+        string denom,
+        uint256 amount,
+        string receiver,
+        string memo
+    );
+
+    /// @dev Emitted when the granter approves the grantee.
+    event Approval(address indexed granter, address indexed grantee);
+
+    /// @dev Emitted when the granter revokes the approval of the grantee.
+    event Revocation(address indexed granter, address indexed grantee);
+}
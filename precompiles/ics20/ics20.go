@@ -0,0 +1,137 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+// Package ics20 implements the precompiled contract through which accounts
+// send ICS-20 transfers and approve other accounts to transfer their funds.
+// See ICS20I.sol for the Solidity interface.
+package ics20
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	transferkeeper "github.com/evmos/evmos/v12/x/ibc/transfer/keeper"
+)
+
+// PrecompileAddress is the address of the ICS-20 precompiled contract.
+const PrecompileAddress = "0x0000000000000000000000000000000000000802"
+
+// abiJSON is the ABI of the ICS20I interface.
+//
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = &Precompile{}
+
+// Precompile defines the ICS-20 precompiled contract.
+type Precompile struct {
+	cmn.Precompile
+	transferKeeper transferkeeper.Keeper
+	authzKeeper    authzkeeper.Keeper
+}
+
+// NewPrecompile creates a new ICS-20 Precompile instance. The transfers are
+// dispatched through the authz keeper to the message handler of the transfer
+// keeper, which checks the approvals of the transfers on behalf of others.
+func NewPrecompile(
+	transferKeeper transferkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile:     cmn.NewPrecompile(newABI, common.HexToAddress(PrecompileAddress)),
+		transferKeeper: transferKeeper,
+		authzKeeper:    authzKeeper,
+	}, nil
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// the error is returned on Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract methods on behalf of the caller.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// the gas meter of the context panics when running out of gas
+	defer cmn.HandleGasError(&err)()
+
+	switch method.Name {
+	// ICS-20 transactions
+	case TransferMethod:
+		bz, err = p.Transfer(ctx, evm, contract, method, args)
+	case TransferFromMethod:
+		bz, err = p.TransferFrom(ctx, evm, contract, method, args)
+	// Authorization transactions
+	case ApproveMethod:
+		bz, err = p.Approve(ctx, evm, contract, method, args)
+	case RevokeMethod:
+		bz, err = p.Revoke(ctx, evm, contract, method, args)
+	// Queries
+	case AllowanceMethod:
+		bz, err = p.Allowance(ctx, method, args)
+	case DenomTraceMethod:
+		bz, err = p.DenomTrace(ctx, method, args)
+	case DenomHashMethod:
+		bz, err = p.DenomHash(ctx, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmn.UseGas(ctx, contract); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// IsTransaction returns true if the given method modifies the state.
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case TransferMethod, TransferFromMethod, ApproveMethod, RevokeMethod:
+		return true
+	default:
+		return false
+	}
+}
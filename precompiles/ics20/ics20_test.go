@@ -0,0 +1,192 @@
+package ics20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	"github.com/evmos/evmos/v12/precompiles/ics20"
+	precompiletestutil "github.com/evmos/evmos/v12/precompiles/testutil"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/utils"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	transfertypes "github.com/evmos/evmos/v12/x/ibc/transfer/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *app.Evmos
+	address    common.Address
+	grantee    common.Address
+	precompile *ics20.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app, suite.ctx = precompiletestutil.Setup(suite.T())
+	suite.address = utiltx.GenerateAddress()
+	suite.grantee = utiltx.GenerateAddress()
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18))))
+	suite.Require().NoError(err)
+
+	suite.precompile, err = ics20.NewPrecompile(suite.app.TransferKeeper, suite.app.AuthzKeeper)
+	suite.Require().NoError(err)
+
+	precompiletestutil.ActivatePrecompiles(suite.T(), suite.ctx, suite.app, ics20.PrecompileAddress)
+}
+
+// call applies a message from the given address to the ICS-20 precompile.
+func (suite *PrecompileTestSuite) call(from common.Address, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	return precompiletestutil.Call(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, from, common.HexToAddress(ics20.PrecompileAddress), big.NewInt(0), method, args...)
+}
+
+func (suite *PrecompileTestSuite) allowance() []ics20.Allocation {
+	res := suite.call(suite.grantee, ics20.AllowanceMethod, suite.address, suite.grantee)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	var out struct{ Allocations []ics20.Allocation }
+	err := suite.precompile.UnpackIntoInterface(&out, ics20.AllowanceMethod, res.Ret)
+	suite.Require().NoError(err)
+	return out.Allocations
+}
+
+func (suite *PrecompileTestSuite) TestApproveAndRevoke() {
+	suite.Require().Empty(suite.allowance())
+
+	allocations := []ics20.Allocation{{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		SpendLimit:    []cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(100)}},
+		AllowList:     []string{},
+	}}
+
+	// cannot approve itself
+	res := suite.call(suite.address, ics20.ApproveMethod, suite.address, allocations)
+	suite.Require().True(res.Failed())
+
+	// invalid allocations
+	res = suite.call(suite.address, ics20.ApproveMethod, suite.grantee, []ics20.Allocation{})
+	suite.Require().True(res.Failed())
+
+	res = suite.call(suite.address, ics20.ApproveMethod, suite.grantee, allocations)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(suite.precompile.Events[ics20.EventTypeApproval].ID.Hex(), res.Logs[0].Topics[0])
+	suite.Require().Equal(allocations, suite.allowance())
+
+	authorization, _ := suite.app.AuthzKeeper.GetAuthorization(
+		suite.ctx, suite.grantee.Bytes(), suite.address.Bytes(), sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+	)
+	suite.Require().IsType(&transfertypes.TransferAuthorization{}, authorization)
+
+	res = suite.call(suite.address, ics20.RevokeMethod, suite.grantee)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(suite.precompile.Events[ics20.EventTypeRevocation].ID.Hex(), res.Logs[0].Topics[0])
+	suite.Require().Empty(suite.allowance())
+
+	// nothing to revoke
+	res = suite.call(suite.address, ics20.RevokeMethod, suite.grantee)
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestTransfer() {
+	height := ics20.Height{RevisionNumber: 1, RevisionHeight: 1000}
+	amount := big.NewInt(10)
+
+	// the channel doesn't exist
+	res := suite.call(suite.address, ics20.TransferMethod, "transfer", "channel-0", utils.BaseDenom, amount, "cosmos1receiver", height, uint64(0), "")
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, "channel")
+
+	// invalid message
+	res = suite.call(suite.address, ics20.TransferMethod, "transfer", "channel-0", utils.BaseDenom, big.NewInt(0), "cosmos1receiver", height, uint64(0), "")
+	suite.Require().True(res.Failed())
+
+	// not approved
+	res = suite.call(suite.grantee, ics20.TransferFromMethod, suite.address, "transfer", "channel-0", utils.BaseDenom, amount, "cosmos1receiver", height, uint64(0), "")
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, "authorization not found")
+
+	// approved, the allowance is restored when the transfer fails
+	allocations := []ics20.Allocation{{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		SpendLimit:    []cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(100)}},
+		AllowList:     []string{},
+	}}
+	res = suite.call(suite.address, ics20.ApproveMethod, suite.grantee, allocations)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res = suite.call(suite.grantee, ics20.TransferFromMethod, suite.address, "transfer", "channel-0", utils.BaseDenom, amount, "cosmos1receiver", height, uint64(0), "")
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, "channel")
+	suite.Require().Equal(allocations, suite.allowance())
+
+	// exceeds the allowance
+	res = suite.call(suite.grantee, ics20.TransferFromMethod, suite.address, "transfer", "channel-0", utils.BaseDenom, big.NewInt(101), "cosmos1receiver", height, uint64(0), "")
+	suite.Require().True(res.Failed())
+	suite.Require().Contains(res.VmError, "spend limit")
+}
+
+func (suite *PrecompileTestSuite) TestDenomTraceAndHash() {
+	denomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	suite.app.TransferKeeper.SetDenomTrace(suite.ctx, denomTrace)
+
+	for _, hash := range []string{denomTrace.Hash().String(), denomTrace.IBCDenom()} {
+		res := suite.call(suite.address, ics20.DenomTraceMethod, hash)
+		suite.Require().False(res.Failed(), res.VmError)
+
+		var out struct{ DenomTrace ics20.DenomTrace }
+		err := suite.precompile.UnpackIntoInterface(&out, ics20.DenomTraceMethod, res.Ret)
+		suite.Require().NoError(err)
+		suite.Require().Equal(ics20.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}, out.DenomTrace)
+	}
+
+	res := suite.call(suite.address, ics20.DenomTraceMethod, ibctransfertypes.ParseDenomTrace("transfer/channel-1/uatom").Hash().String())
+	suite.Require().True(res.Failed())
+
+	res = suite.call(suite.address, ics20.DenomHashMethod, "transfer/channel-0/uatom")
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := suite.precompile.Unpack(ics20.DenomHashMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(denomTrace.Hash().String(), out[0])
+
+	res = suite.call(suite.address, ics20.DenomHashMethod, "transfer/channel-1/uatom")
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err = suite.precompile.Unpack(ics20.DenomHashMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal("", out[0])
+}
+
+func (suite *PrecompileTestSuite) TestDelegateCall() {
+	precompileAddr := common.HexToAddress(ics20.PrecompileAddress)
+	allocations := []ics20.Allocation{{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		SpendLimit:    []cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(100)}},
+		AllowList:     []string{},
+	}}
+
+	// the DELEGATECALLs to the precompile are read-only
+	res := precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.address, precompileAddr, ics20.ApproveMethod, suite.grantee, allocations)
+	suite.Require().True(res.Failed())
+	suite.Require().Empty(suite.allowance())
+
+	res = precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.address, precompileAddr, ics20.AllowanceMethod, suite.address, suite.grantee)
+	suite.Require().False(res.Failed(), res.VmError)
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package ics20
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	transfertypes "github.com/evmos/evmos/v12/x/ibc/transfer/types"
+)
+
+// Allowance returns the allocations left of the TransferAuthorization granted
+// by the granter to the grantee. It returns an empty list if there's no
+// authorization or if it's expired.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	granter, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := parseAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURL := transfertypes.TransferAuthorization{}.MsgTypeURL()
+	authorization, _ := p.authzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), msgTypeURL)
+	if authorization == nil {
+		return method.Outputs.Pack([]Allocation{})
+	}
+
+	transferAuthz, ok := authorization.(*transfertypes.TransferAuthorization)
+	if !ok {
+		return nil, fmt.Errorf("unsupported authorization type %T", authorization)
+	}
+
+	return method.Outputs.Pack(NewAllocationsResponse(transferAuthz.Allocations))
+}
+
+// DenomTrace returns the denomination trace of the given hash, which can be
+// prefixed with "ibc/". It returns an error if the trace is not found.
+func (p Precompile) DenomTrace(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	hexHash, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid hash: %v", args[0])
+	}
+
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(hexHash, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil, err
+	}
+
+	denomTrace, found := p.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, fmt.Errorf("denomination trace not found: %s", hash)
+	}
+
+	return method.Outputs.Pack(DenomTrace{
+		Path:      denomTrace.Path,
+		BaseDenom: denomTrace.BaseDenom,
+	})
+}
+
+// DenomHash returns the hash of the given denomination trace, or an empty
+// string if the trace is not registered.
+func (p Precompile) DenomHash(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	trace, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid trace: %v", args[0])
+	}
+
+	denomTrace := ibctransfertypes.ParseDenomTrace(trace)
+	if err := denomTrace.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !p.transferKeeper.HasDenomTrace(ctx, denomTrace.Hash()) {
+		return method.Outputs.Pack("")
+	}
+	return method.Outputs.Pack(denomTrace.Hash().String())
+}
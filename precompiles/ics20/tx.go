@@ -0,0 +1,189 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package ics20
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	transfertypes "github.com/evmos/evmos/v12/x/ibc/transfer/types"
+)
+
+// Transfer sends an ICS-20 transfer of the caller's tokens.
+func (p Precompile) Transfer(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transfer(ctx, evm, contract.Caller(), contract.Caller(), method, args)
+}
+
+// TransferFrom sends an ICS-20 transfer of the sender's tokens, who must have
+// approved the caller through a TransferAuthorization (or any authz
+// authorization of MsgTransfer).
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, checkArgsLength(args, 9)
+	}
+	sender, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return p.transfer(ctx, evm, contract.Caller(), sender, method, args[1:])
+}
+
+// transfer dispatches the MsgTransfer of the sender through the authz keeper,
+// which accepts it implicitly if the sender is the caller, and executes it
+// through the message handler of the transfer keeper.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	caller, sender common.Address,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	transferArgs, err := parseTransferArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		transferArgs.sourcePort,
+		transferArgs.sourceChannel,
+		transferArgs.token,
+		sdk.AccAddress(sender.Bytes()).String(),
+		transferArgs.receiver,
+		transferArgs.timeoutHeight,
+		transferArgs.timeoutTimestamp,
+		transferArgs.memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	results, err := p.authzKeeper.DispatchActions(ctx, caller.Bytes(), []sdk.Msg{msg})
+	if err != nil {
+		return nil, err
+	}
+
+	var res ibctransfertypes.MsgTransferResponse
+	if err := res.Unmarshal(results[0]); err != nil {
+		return nil, err
+	}
+
+	if err := p.AddLog(
+		evm, EventTypeIBCTransfer,
+		[]common.Hash{cmn.AddressTopic(sender)},
+		transferArgs.sourcePort, transferArgs.sourceChannel,
+		transferArgs.token.Denom, transferArgs.token.Amount.BigInt(),
+		transferArgs.receiver, transferArgs.memo,
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// Approve grants a TransferAuthorization with the given allocations from the
+// caller to the grantee, overwriting any previous authorization of MsgTransfer.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	grantee, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	allocations, err := parseAllocations(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granter := contract.Caller()
+	if granter == grantee {
+		return nil, errorsmod.Wrap(authz.ErrGranteeIsGranter, grantee.String())
+	}
+
+	authorization := transfertypes.NewTransferAuthorization(allocations...)
+	if err := authorization.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := p.authzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), authorization, nil); err != nil {
+		return nil, err
+	}
+
+	if err := p.AddLog(
+		evm, EventTypeApproval,
+		[]common.Hash{cmn.AddressTopic(granter), cmn.AddressTopic(grantee)},
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke deletes the authorization of MsgTransfer from the caller to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	grantee, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	granter := contract.Caller()
+	msgTypeURL := transfertypes.TransferAuthorization{}.MsgTypeURL()
+	if err := p.authzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgTypeURL); err != nil {
+		return nil, err
+	}
+
+	if err := p.AddLog(
+		evm, EventTypeRevocation,
+		[]common.Hash{cmn.AddressTopic(granter), cmn.AddressTopic(grantee)},
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
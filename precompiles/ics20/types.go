@@ -0,0 +1,208 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package ics20
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	transfertypes "github.com/evmos/evmos/v12/x/ibc/transfer/types"
+)
+
+const (
+	// TransferMethod defines the ABI method name of the transfer transaction.
+	TransferMethod = "transfer"
+	// TransferFromMethod defines the ABI method name of the transferFrom transaction.
+	TransferFromMethod = "transferFrom"
+	// ApproveMethod defines the ABI method name of the approve transaction.
+	ApproveMethod = "approve"
+	// RevokeMethod defines the ABI method name of the revoke transaction.
+	RevokeMethod = "revoke"
+	// AllowanceMethod defines the ABI method name of the allowance query.
+	AllowanceMethod = "allowance"
+	// DenomTraceMethod defines the ABI method name of the denomTrace query.
+	DenomTraceMethod = "denomTrace"
+	// DenomHashMethod defines the ABI method name of the denomHash query.
+	DenomHashMethod = "denomHash"
+)
+
+const (
+	// EventTypeIBCTransfer defines the event type of the transfer transactions.
+	EventTypeIBCTransfer = "IBCTransfer"
+	// EventTypeApproval defines the event type of the approve transaction.
+	EventTypeApproval = "Approval"
+	// EventTypeRevocation defines the event type of the revoke transaction.
+	EventTypeRevocation = "Revocation"
+)
+
+// Height is the ABI representation of an IBC client height.
+type Height struct {
+	RevisionNumber uint64
+	RevisionHeight uint64
+}
+
+// Allocation is the ABI representation of a transfer authorization allocation.
+type Allocation struct {
+	SourcePort    string
+	SourceChannel string
+	SpendLimit    []cmn.Coin
+	AllowList     []string
+}
+
+// DenomTrace is the ABI representation of an ICS-20 denomination trace.
+type DenomTrace struct {
+	Path      string
+	BaseDenom string
+}
+
+// NewAllocationsResponse converts the allocations of a transfer authorization
+// into their ABI representation.
+func NewAllocationsResponse(allocations []transfertypes.Allocation) []Allocation {
+	res := make([]Allocation, len(allocations))
+	for i, allocation := range allocations {
+		allowList := allocation.AllowList
+		if allowList == nil {
+			allowList = []string{}
+		}
+		res[i] = Allocation{
+			SourcePort:    allocation.SourcePort,
+			SourceChannel: allocation.SourceChannel,
+			SpendLimit:    cmn.NewCoinsResponse(allocation.SpendLimit),
+			AllowList:     allowList,
+		}
+	}
+	return res
+}
+
+// transferArgs are the arguments of an ICS-20 transfer.
+type transferArgs struct {
+	sourcePort       string
+	sourceChannel    string
+	token            sdk.Coin
+	receiver         string
+	timeoutHeight    clienttypes.Height
+	timeoutTimestamp uint64
+	memo             string
+}
+
+// parseTransferArgs parses the arguments of the transfer method, which are
+// also the arguments of transferFrom after the sender.
+func parseTransferArgs(args []interface{}) (*transferArgs, error) {
+	if err := checkArgsLength(args, 8); err != nil {
+		return nil, err
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid source port: %v", args[0])
+	}
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid source channel: %v", args[1])
+	}
+	denom, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[2])
+	}
+	amount, ok := args[3].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf("invalid amount: %v", args[3])
+	}
+	receiver, ok := args[4].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid receiver: %v", args[4])
+	}
+	var timeoutHeight Height
+	if err := convertType(args[5], &timeoutHeight); err != nil {
+		return nil, fmt.Errorf("invalid timeout height: %w", err)
+	}
+	timeoutTimestamp, ok := args[6].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid timeout timestamp: %v", args[6])
+	}
+	memo, ok := args[7].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid memo: %v", args[7])
+	}
+
+	return &transferArgs{
+		sourcePort:       sourcePort,
+		sourceChannel:    sourceChannel,
+		token:            sdk.Coin{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)},
+		receiver:         receiver,
+		timeoutHeight:    clienttypes.NewHeight(timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight),
+		timeoutTimestamp: timeoutTimestamp,
+		memo:             memo,
+	}, nil
+}
+
+// parseAllocations parses the ABI allocations of the approve method.
+func parseAllocations(arg interface{}) ([]transfertypes.Allocation, error) {
+	var abiAllocations []Allocation
+	if err := convertType(arg, &abiAllocations); err != nil {
+		return nil, fmt.Errorf("invalid allocations: %w", err)
+	}
+
+	allocations := make([]transfertypes.Allocation, len(abiAllocations))
+	for i, allocation := range abiAllocations {
+		spendLimit, err := cmn.NewSdkCoins(allocation.SpendLimit)
+		if err != nil {
+			return nil, err
+		}
+		allocations[i] = transfertypes.Allocation{
+			SourcePort:    allocation.SourcePort,
+			SourceChannel: allocation.SourceChannel,
+			SpendLimit:    spendLimit,
+			AllowList:     allocation.AllowList,
+		}
+	}
+	return allocations, nil
+}
+
+// parseAddress parses an address argument.
+func parseAddress(arg interface{}) (common.Address, error) {
+	address, ok := arg.(common.Address)
+	if !ok || address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("invalid address: %v", arg)
+	}
+	return address, nil
+}
+
+// convertType converts an unpacked ABI tuple into the given struct pointer.
+func convertType(arg interface{}, out interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	abi.ConvertType(arg, out)
+	return nil
+}
+
+// checkArgsLength returns an error if the number of arguments is not the expected one.
+func checkArgsLength(args []interface{}, expected int) error {
+	if len(args) != expected {
+		return fmt.Errorf("invalid number of arguments; expected %d; got: %d", expected, len(args))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The StakingI contract's address.
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000800;
+
+/// @dev The StakingI contract's instance.
+StakingI constant STAKING_CONTRACT = StakingI(STAKING_PRECOMPILE_ADDRESS);
+
+/// @dev Coin is a struct that represents a token with a denomination and an amount.
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+/// @title Staking and distribution precompiled contract
+/// @dev The interface through which Solidity contracts and accounts interact
+/// with the staking and distribution modules. The caller of the transaction
+/// methods is always the delegator. Validators are referred to by their
+/// bech32 operator address.
+interface StakingI {
+    /// @dev Delegates the given amount of the bond denomination to a validator.
+    function delegate(string memory validatorAddress, uint256 amount) external returns (bool success);
+
+    /// @dev Undelegates the given amount of the bond denomination from a validator.
+    /// @return completionTime The unix time at which the unbonding completes.
+    function undelegate(string memory validatorAddress, uint256 amount) external returns (int64 completionTime);
+
+    /// @dev Moves the given amount of the bond denomination from a validator to another.
+    /// @return completionTime The unix time at which the redelegation completes.
+    function redelegate(
+        string memory validatorSrcAddress,
+        string memory validatorDstAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Withdraws the rewards of the delegation to a validator.
+    function withdrawDelegatorRewards(string memory validatorAddress) external returns (Coin[] memory amount);
+
+    /// @dev Returns the shares and the balance of the delegation of an account to a validator.
+    function delegation(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external view returns (uint256 shares, Coin memory balance);
+
+    /// @dev Emitted when the delegator delegates to a validator.
+    event Delegate(address indexed delegatorAddress, address indexed validatorAddress, uint256 amount, uint256 newShares);
+
+    /// @dev Emitted when the delegator undelegates from a validator.
+    event Unbond(address indexed delegatorAddress, address indexed validatorAddress, uint256 amount, uint256 completionTime);
+
+    /// @dev Emitted when the delegator redelegates from a validator to another.
+    event Redelegate(
+        address indexed delegatorAddress,
+        address indexed validatorSrcAddress,
+        address indexed validatorDstAddress,
+        uint256 amount,
+        uint256 completionTime
+    );
+
+    /// @dev Emitted when the delegator withdraws the rewards of a delegation.
+    /// The amount is the withdrawn amount of the bond denomination.
+    event WithdrawDelegatorRewards(address indexed delegatorAddress, address indexed validatorAddress, uint256 amount);
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+)
+
+// Delegation returns the shares and the balance of the delegation of an account
+// to a validator. The shares are returned with 18 decimals. Both are zero if the
+// delegation doesn't exist.
+func (p Precompile) Delegation(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	delegator, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid delegator address: %v", args[0])
+	}
+	valAddr, err := parseValidatorAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := p.stakingKeeper.BondDenom(ctx)
+	balance := cmn.Coin{Denom: bondDenom, Amount: big.NewInt(0)}
+
+	delegation, found := p.stakingKeeper.GetDelegation(ctx, delegator.Bytes(), valAddr)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), balance)
+	}
+
+	validator, found := p.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, fmt.Errorf("validator does not exist: %s", valAddr)
+	}
+
+	balance.Amount = validator.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance)
+}
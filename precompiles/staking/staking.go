@@ -0,0 +1,133 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+// Package staking implements the precompiled contract through which accounts
+// delegate, undelegate, redelegate and withdraw their staking rewards from the
+// EVM. See StakingI.sol for the Solidity interface.
+package staking
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// PrecompileAddress is the address of the staking precompiled contract.
+const PrecompileAddress = "0x0000000000000000000000000000000000000800"
+
+// abiJSON is the ABI of the StakingI interface.
+//
+//go:embed abi.json
+var abiJSON []byte
+
+var _ evmtypes.StatefulPrecompiledContract = &Precompile{}
+
+// Precompile defines the staking and distribution precompiled contract.
+type Precompile struct {
+	cmn.Precompile
+	stakingKeeper      stakingkeeper.Keeper
+	distributionKeeper distributionkeeper.Keeper
+}
+
+// NewPrecompile creates a new staking Precompile instance. The staking keeper
+// must have its hooks already set, so that the hooks of the other modules (eg:
+// the claims ActionDelegate) are executed for the delegations of the precompile.
+func NewPrecompile(
+	stakingKeeper stakingkeeper.Keeper,
+	distributionKeeper distributionkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile:         cmn.NewPrecompile(newABI, common.HexToAddress(PrecompileAddress)),
+		stakingKeeper:      stakingKeeper,
+		distributionKeeper: distributionKeeper,
+	}, nil
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// the error is returned on Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract methods with the caller as delegator.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// the gas meter of the context panics when running out of gas
+	defer cmn.HandleGasError(&err)()
+
+	switch method.Name {
+	// Staking transactions
+	case DelegateMethod:
+		bz, err = p.Delegate(ctx, evm, contract, method, args)
+	case UndelegateMethod:
+		bz, err = p.Undelegate(ctx, evm, contract, method, args)
+	case RedelegateMethod:
+		bz, err = p.Redelegate(ctx, evm, contract, method, args)
+	// Distribution transactions
+	case WithdrawDelegatorRewardsMethod:
+		bz, err = p.WithdrawDelegatorRewards(ctx, evm, contract, method, args)
+	// Staking queries
+	case DelegationMethod:
+		bz, err = p.Delegation(ctx, method, args)
+	default:
+		return nil, fmt.Errorf("%w: %s", cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmn.UseGas(ctx, contract); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// IsTransaction returns true if the given method modifies the state.
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case DelegateMethod, UndelegateMethod, RedelegateMethod, WithdrawDelegatorRewardsMethod:
+		return true
+	default:
+		return false
+	}
+}
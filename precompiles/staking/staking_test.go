@@ -0,0 +1,276 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+	"github.com/evmos/evmos/v12/precompiles/staking"
+	precompiletestutil "github.com/evmos/evmos/v12/precompiles/testutil"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/utils"
+	claimstypes "github.com/evmos/evmos/v12/x/claims/types"
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *app.Evmos
+	address    common.Address
+	validators []stakingtypes.Validator
+	precompile *staking.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app, suite.ctx = precompiletestutil.Setup(suite.T())
+	suite.address = utiltx.GenerateAddress()
+
+	// the genesis validator and a second validator to redelegate to
+	validator := suite.app.StakingKeeper.GetValidators(suite.ctx, 1)[0]
+	privCons, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	validator2, err := stakingtypes.NewValidator(sdk.ValAddress(utiltx.GenerateAddress().Bytes()), privCons.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator2 = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper, suite.ctx, validator2, true)
+	err = suite.app.StakingKeeper.AfterValidatorCreated(suite.ctx, validator2.GetOperator())
+	suite.Require().NoError(err)
+	suite.validators = []stakingtypes.Validator{validator, validator2}
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1e18)), sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)))
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), coins)
+	suite.Require().NoError(err)
+
+	suite.precompile, err = staking.NewPrecompile(suite.app.StakingKeeper, suite.app.DistrKeeper)
+	suite.Require().NoError(err)
+
+	precompiletestutil.ActivatePrecompiles(suite.T(), suite.ctx, suite.app, staking.PrecompileAddress)
+}
+
+// call applies a message from the suite address to the staking precompile.
+func (suite *PrecompileTestSuite) call(value *big.Int, method string, args ...interface{}) *evmtypes.MsgEthereumTxResponse {
+	return precompiletestutil.Call(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.address, common.HexToAddress(staking.PrecompileAddress), value, method, args...)
+}
+
+func (suite *PrecompileTestSuite) delegationShares(valAddr sdk.ValAddress) sdk.Dec {
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, suite.address.Bytes(), valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return delegation.Shares
+}
+
+func (suite *PrecompileTestSuite) TestDelegate() {
+	valAddr := suite.validators[0].GetOperator()
+
+	// claims record of the delegator
+	claimsParams := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+	claimsParams.AirdropStartTime = suite.ctx.BlockTime().Add(-time.Hour)
+	suite.Require().NoError(suite.app.ClaimsKeeper.SetParams(suite.ctx, claimsParams))
+	suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, suite.address.Bytes(), claimstypes.NewClaimsRecord(sdkmath.NewInt(1000)))
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, claimstypes.ModuleName, sdk.NewCoins(sdk.NewCoin(claimsParams.ClaimsDenom, sdkmath.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	res := suite.call(big.NewInt(0), staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(staking.DelegateMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{true}, out)
+
+	shares := suite.delegationShares(valAddr)
+	suite.Require().True(shares.IsPositive())
+
+	suite.Require().Len(res.Logs, 1)
+	log := res.Logs[0]
+	suite.Require().Equal(staking.PrecompileAddress, log.Address)
+	suite.Require().Equal(suite.precompile.Events[staking.EventTypeDelegate].ID.Hex(), log.Topics[0])
+	suite.Require().Equal(common.BytesToHash(suite.address.Bytes()).Hex(), log.Topics[1])
+	suite.Require().Equal(common.BytesToHash(valAddr.Bytes()).Hex(), log.Topics[2])
+
+	// the claims ActionDelegate hook is executed
+	claimsRecord, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, suite.address.Bytes())
+	suite.Require().True(found)
+	suite.Require().True(claimsRecord.HasClaimedAction(claimstypes.ActionDelegate))
+
+	// invalid validator
+	res = suite.call(big.NewInt(0), staking.DelegateMethod, "invalid", big.NewInt(1000))
+	suite.Require().True(res.Failed())
+
+	// insufficient balance
+	res = suite.call(big.NewInt(0), staking.DelegateMethod, valAddr.String(), new(big.Int).Lsh(big.NewInt(1), 100))
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(shares, suite.delegationShares(valAddr))
+
+	// non-payable method
+	res = suite.call(big.NewInt(1), staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().Contains(res.VmError, cmn.ErrNonPayable.Error())
+}
+
+func (suite *PrecompileTestSuite) TestDelegateFromContract() {
+	valAddr := suite.validators[0].GetOperator()
+	vault := utiltx.GenerateAddress()
+
+	stateDB := testutil.NewStateDB(suite.ctx, suite.app.EvmKeeper)
+	stateDB.SetCode(vault, testutil.ProxyContractCode(suite.precompile.Address()))
+	suite.Require().NoError(stateDB.Commit())
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, vault.Bytes(), sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1e18))))
+	suite.Require().NoError(err)
+
+	input, err := suite.precompile.Pack(staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+	suite.Require().NoError(err)
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(suite.address, &vault, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, input, nil, true)
+
+	res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, cfg, suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{}))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := suite.precompile.Unpack(staking.DelegateMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{true}, out)
+
+	// the contract is the delegator, not the transaction sender
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, vault.Bytes(), valAddr)
+	suite.Require().True(found)
+	suite.Require().True(delegation.Shares.IsPositive())
+	suite.Require().True(suite.delegationShares(valAddr).IsZero())
+
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(common.BytesToHash(vault.Bytes()).Hex(), res.Logs[0].Topics[1])
+}
+
+func (suite *PrecompileTestSuite) TestUndelegateAndRedelegate() {
+	valAddr := suite.validators[0].GetOperator()
+	valDstAddr := suite.validators[1].GetOperator()
+
+	res := suite.call(big.NewInt(0), staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res = suite.call(big.NewInt(0), staking.UndelegateMethod, valAddr.String(), big.NewInt(400))
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := suite.precompile.Unpack(staking.UndelegateMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Greater(out[0].(int64), suite.ctx.BlockTime().Unix())
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(suite.precompile.Events[staking.EventTypeUnbond].ID.Hex(), res.Logs[0].Topics[0])
+
+	_, found := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, suite.address.Bytes(), valAddr)
+	suite.Require().True(found)
+
+	res = suite.call(big.NewInt(0), staking.RedelegateMethod, valAddr.String(), valDstAddr.String(), big.NewInt(600))
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(suite.precompile.Events[staking.EventTypeRedelegate].ID.Hex(), res.Logs[0].Topics[0])
+	suite.Require().True(suite.delegationShares(valAddr).IsZero())
+	suite.Require().True(suite.delegationShares(valDstAddr).IsPositive())
+
+	// nothing left to undelegate
+	res = suite.call(big.NewInt(0), staking.UndelegateMethod, valAddr.String(), big.NewInt(1))
+	suite.Require().True(res.Failed())
+}
+
+func (suite *PrecompileTestSuite) TestWithdrawDelegatorRewards() {
+	valAddr := suite.validators[0].GetOperator()
+
+	// no delegation
+	res := suite.call(big.NewInt(0), staking.WithdrawDelegatorRewardsMethod, valAddr.String())
+	suite.Require().True(res.Failed())
+
+	res = suite.call(big.NewInt(0), staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res = suite.call(big.NewInt(0), staking.WithdrawDelegatorRewardsMethod, valAddr.String())
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(suite.precompile.Events[staking.EventTypeWithdrawDelegatorRewards].ID.Hex(), res.Logs[0].Topics[0])
+}
+
+func (suite *PrecompileTestSuite) TestDelegation() {
+	valAddr := suite.validators[0].GetOperator()
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	res := suite.call(big.NewInt(0), staking.DelegationMethod, suite.address, valAddr.String())
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := suite.precompile.Unpack(staking.DelegationMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), out[0].(*big.Int).Int64())
+
+	res = suite.call(big.NewInt(0), staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res = suite.call(big.NewInt(0), staking.DelegationMethod, suite.address, valAddr.String())
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err = suite.precompile.Unpack(staking.DelegationMethod, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.delegationShares(valAddr).BigInt(), out[0])
+	balance := out[1].(struct {
+		Denom  string   `json:"denom"`
+		Amount *big.Int `json:"amount"`
+	})
+	suite.Require().Equal(bondDenom, balance.Denom)
+	suite.Require().Equal(int64(1000), balance.Amount.Int64())
+}
+
+func (suite *PrecompileTestSuite) TestRunReadOnly() {
+	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+	suite.Require().NoError(err)
+
+	input, err := suite.precompile.Pack(staking.DelegateMethod, suite.validators[0].GetOperator().String(), big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	to := suite.precompile.Address()
+	msg := ethtypes.NewMessage(suite.address, &to, 0, big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, input, nil, true)
+	stateDB := statedb.New(suite.ctx, suite.app.EvmKeeper, suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{}))
+	evm := suite.app.EvmKeeper.NewEVM(suite.ctx, msg, cfg, nil, stateDB)
+
+	contract := vm.NewContract(vm.AccountRef(suite.address), vm.AccountRef(to), big.NewInt(0), 1_000_000)
+	contract.Input = input
+
+	_, err = suite.precompile.Run(evm, contract, true)
+	suite.Require().ErrorIs(err, vm.ErrWriteProtection)
+
+	// out of gas
+	contract = vm.NewContract(vm.AccountRef(suite.address), vm.AccountRef(to), big.NewInt(0), 100)
+	contract.Input = input
+	_, err = suite.precompile.Run(evm, contract, false)
+	suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+}
+
+func (suite *PrecompileTestSuite) TestDelegateCall() {
+	valAddr := suite.validators[0].GetOperator()
+	precompileAddr := common.HexToAddress(staking.PrecompileAddress)
+
+	// the DELEGATECALLs to the precompile are read-only
+	res := precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.address, precompileAddr, staking.DelegateMethod, valAddr.String(), big.NewInt(1000))
+	suite.Require().True(res.Failed())
+	suite.Require().True(suite.delegationShares(valAddr).IsZero())
+
+	res = precompiletestutil.DelegateCall(suite.T(), suite.ctx, suite.app, suite.precompile.ABI, suite.address, precompileAddr, staking.DelegationMethod, suite.address, valAddr.String())
+	suite.Require().False(res.Failed(), res.VmError)
+}
@@ -0,0 +1,238 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v12/precompiles/common"
+)
+
+// Delegate delegates the given amount of the bond denomination from the caller
+// to a validator.
+func (p Precompile) Delegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	valAddr, err := parseValidatorAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := contract.Caller()
+	delAddr := sdk.AccAddress(delegator.Bytes())
+	prevShares := p.delegationShares(ctx, delAddr, valAddr)
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Delegate(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	newShares := p.delegationShares(ctx, delAddr, valAddr).Sub(prevShares)
+	if err := p.AddLog(
+		evm, EventTypeDelegate,
+		[]common.Hash{cmn.AddressTopic(delegator), cmn.AddressTopic(common.BytesToAddress(valAddr))},
+		amount.BigInt(), newShares.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Undelegate undelegates the given amount of the bond denomination of the
+// caller from a validator.
+func (p Precompile) Undelegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	valAddr, err := parseValidatorAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := contract.Caller()
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).Undelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.UTC().Unix()
+	if err := p.AddLog(
+		evm, EventTypeUnbond,
+		[]common.Hash{cmn.AddressTopic(delegator), cmn.AddressTopic(common.BytesToAddress(valAddr))},
+		amount.BigInt(), big.NewInt(completionTime),
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// Redelegate moves the given amount of the bond denomination of the caller from
+// a validator to another.
+func (p Precompile) Redelegate(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	valSrcAddr, err := parseValidatorAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+	valDstAddr, err := parseValidatorAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := contract.Caller()
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorSrcAddress: valSrcAddr.String(),
+		ValidatorDstAddress: valDstAddr.String(),
+		Amount:              sdk.NewCoin(p.stakingKeeper.BondDenom(ctx), amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := stakingkeeper.NewMsgServerImpl(p.stakingKeeper).BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := res.CompletionTime.UTC().Unix()
+	if err := p.AddLog(
+		evm, EventTypeRedelegate,
+		[]common.Hash{
+			cmn.AddressTopic(delegator),
+			cmn.AddressTopic(common.BytesToAddress(valSrcAddr)),
+			cmn.AddressTopic(common.BytesToAddress(valDstAddr)),
+		},
+		amount.BigInt(), big.NewInt(completionTime),
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(completionTime)
+}
+
+// WithdrawDelegatorRewards withdraws the rewards of the delegation of the caller
+// to a validator. The rewards are sent to the withdraw address of the caller.
+func (p Precompile) WithdrawDelegatorRewards(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := checkArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	valAddr, err := parseValidatorAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	delegator := contract.Caller()
+	msg := &distributiontypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: valAddr.String(),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := distributionkeeper.NewMsgServerImpl(p.distributionKeeper).WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	bondAmount := res.Amount.AmountOf(p.stakingKeeper.BondDenom(ctx))
+	if err := p.AddLog(
+		evm, EventTypeWithdrawDelegatorRewards,
+		[]common.Hash{cmn.AddressTopic(delegator), cmn.AddressTopic(common.BytesToAddress(valAddr))},
+		bondAmount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Amount))
+}
+
+// delegationShares returns the shares of the delegation, or zero if not found.
+func (p Precompile) delegationShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec {
+	delegation, found := p.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return delegation.Shares
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DelegateMethod defines the ABI method name of the delegate transaction.
+	DelegateMethod = "delegate"
+	// UndelegateMethod defines the ABI method name of the undelegate transaction.
+	UndelegateMethod = "undelegate"
+	// RedelegateMethod defines the ABI method name of the redelegate transaction.
+	RedelegateMethod = "redelegate"
+	// WithdrawDelegatorRewardsMethod defines the ABI method name of the
+	// withdrawDelegatorRewards transaction.
+	WithdrawDelegatorRewardsMethod = "withdrawDelegatorRewards"
+	// DelegationMethod defines the ABI method name of the delegation query.
+	DelegationMethod = "delegation"
+)
+
+const (
+	// EventTypeDelegate defines the event type of the delegate transaction.
+	EventTypeDelegate = "Delegate"
+	// EventTypeUnbond defines the event type of the undelegate transaction.
+	EventTypeUnbond = "Unbond"
+	// EventTypeRedelegate defines the event type of the redelegate transaction.
+	EventTypeRedelegate = "Redelegate"
+	// EventTypeWithdrawDelegatorRewards defines the event type of the
+	// withdrawDelegatorRewards transaction.
+	EventTypeWithdrawDelegatorRewards = "WithdrawDelegatorRewards"
+)
+
+// parseValidatorAddress parses the bech32 operator address of a validator.
+func parseValidatorAddress(arg interface{}) (sdk.ValAddress, error) {
+	bech32, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid validator address: %v", arg)
+	}
+	return sdk.ValAddressFromBech32(bech32)
+}
+
+// parseAmount parses a positive uint256 amount.
+func parseAmount(arg interface{}) (sdk.Int, error) {
+	amount, ok := arg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return sdk.Int{}, fmt.Errorf("invalid amount: %v", arg)
+	}
+	return sdk.NewIntFromBigInt(amount), nil
+}
+
+// checkArgsLength returns an error if the number of arguments is not the expected one.
+func checkArgsLength(args []interface{}, expected int) error {
+	if len(args) != expected {
+		return fmt.Errorf("invalid number of arguments; expected %d; got: %d", expected, len(args))
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Package testutil defines the fixtures shared by the tests of the precompiled
+// contracts.
+package testutil
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/utils"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+)
+
+// Setup returns a new app and the context of its first block. The genesis
+// validator is the block proposer, so that the EVM can be configured from the
+// context.
+func Setup(t *testing.T) (*app.Evmos, sdk.Context) {
+	evmosApp := app.Setup(false, feemarkettypes.DefaultGenesisState())
+	ctx := evmosApp.BaseApp.NewContext(false, testutil.NewHeader(1, time.Now().UTC(), utils.MainnetChainID+"-1", nil, nil, nil))
+
+	validator := evmosApp.StakingKeeper.GetValidators(ctx, 1)[0]
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	header := ctx.BlockHeader()
+	header.ProposerAddress = consAddr
+	return evmosApp, ctx.WithBlockHeader(header)
+}
+
+// ActivatePrecompiles sets the precompiled contracts of the given addresses as
+// the active precompiles of the EVM params.
+func ActivatePrecompiles(t *testing.T, ctx sdk.Context, evmosApp *app.Evmos, addresses ...string) {
+	params := evmosApp.EvmKeeper.GetParams(ctx)
+	params.ActivePrecompiles = addresses
+	require.NoError(t, evmosApp.EvmKeeper.SetParams(ctx, params))
+}
+
+// Call applies a message from the given address to the contract at the given
+// address, calling the method of the contract ABI with the given value and
+// arguments.
+func Call(
+	t *testing.T,
+	ctx sdk.Context,
+	evmosApp *app.Evmos,
+	contractABI abi.ABI,
+	from, to common.Address,
+	value *big.Int,
+	method string,
+	args ...interface{},
+) *evmtypes.MsgEthereumTxResponse {
+	input, err := contractABI.Pack(method, args...)
+	require.NoError(t, err)
+
+	cfg, err := evmosApp.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, evmosApp.EvmKeeper.ChainID())
+	require.NoError(t, err)
+
+	nonce := evmosApp.EvmKeeper.GetNonce(ctx, from)
+	msg := ethtypes.NewMessage(from, &to, nonce, value, 1_000_000, big.NewInt(0), nil, nil, input, nil, true)
+
+	res, err := evmosApp.EvmKeeper.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, evmosApp.EvmKeeper.TxConfig(ctx, common.Hash{}))
+	require.NoError(t, err)
+	return res
+}
+
+// DelegateCall applies a message from the given address to a contract that
+// DELEGATECALLs the contract at the given address, calling the method of the
+// contract ABI with the given arguments.
+func DelegateCall(
+	t *testing.T,
+	ctx sdk.Context,
+	evmosApp *app.Evmos,
+	contractABI abi.ABI,
+	from, to common.Address,
+	method string,
+	args ...interface{},
+) *evmtypes.MsgEthereumTxResponse {
+	proxy := utiltx.GenerateAddress()
+	stateDB := testutil.NewStateDB(ctx, evmosApp.EvmKeeper)
+	stateDB.SetCode(proxy, testutil.DelegateProxyContractCode(to))
+	require.NoError(t, stateDB.Commit())
+
+	return Call(t, ctx, evmosApp, contractABI, from, proxy, big.NewInt(0), method, args...)
+}
@@ -24,14 +24,18 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/evmos/v12/rpc/backend"
+	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/abi"
+	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/admin"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/net"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/personal"
+	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/relay"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/web3"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
 	"github.com/evmos/evmos/v12/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
@@ -52,6 +56,9 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	AdminNamespace    = "admin"
+	ABINamespace      = "abi"
+	RelayNamespace    = "relay"
 
 	apiVersion = "1.0"
 )
@@ -63,6 +70,8 @@ type APICreator = func(
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	stateTrie backend.StateTrie,
+	txStatus *rpctypes.TxStatusStore,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -75,8 +84,10 @@ func init() {
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -92,7 +103,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer, backend.StateTrie, *rpctypes.TxStatusStore) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -102,7 +113,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ backend.StateTrie, _ *rpctypes.TxStatusStore) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -117,8 +128,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -128,12 +141,20 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -143,8 +164,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -159,8 +182,10 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -170,6 +195,52 @@ func init() {
 				},
 			}
 		},
+		AdminNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ backend.StateTrie, _ *rpctypes.TxStatusStore) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx, clientCtx),
+					Public:    false,
+				},
+			}
+		},
+		ABINamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
+			return []rpc.API{
+				{
+					Namespace: ABINamespace,
+					Version:   apiVersion,
+					Service:   abi.NewAPI(ctx.Logger, evmBackend),
+					Public:    false,
+				},
+			}
+		},
+		RelayNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			stateTrie backend.StateTrie,
+			txStatus *rpctypes.TxStatusStore,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
+			return []rpc.API{
+				{
+					Namespace: RelayNamespace,
+					Version:   apiVersion,
+					Service:   relay.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -179,13 +250,15 @@ func GetRPCAPIs(ctx *server.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	stateTrie backend.StateTrie,
+	txStatus *rpctypes.TxStatusStore,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, stateTrie, txStatus)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
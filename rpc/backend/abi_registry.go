@@ -0,0 +1,195 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// abiRegistryDirName is the name of the ABI registry directory within the node's home directory.
+const abiRegistryDirName = "abi-registry"
+
+// ErrABIRegistryNotAvailable is returned when the node-local ABI registry is not configured.
+var ErrABIRegistryNotAvailable = errors.New("ABI registry is not available")
+
+var (
+	abiRegistryOnce      sync.Once
+	sharedABIRegistryRef *rpctypes.ABIRegistry
+)
+
+// sharedABIRegistry returns the node-local ABI registry shared by all the
+// Backend instances of the node. It returns nil if neither the registry
+// directory nor the home directory are set, or if the registry can't be opened.
+func sharedABIRegistry(dir, homeDir string, logger log.Logger) *rpctypes.ABIRegistry {
+	abiRegistryOnce.Do(func() {
+		if dir == "" {
+			if homeDir == "" {
+				return
+			}
+			dir = filepath.Join(homeDir, abiRegistryDirName)
+		}
+
+		registry, err := rpctypes.NewABIRegistry(dir)
+		if err != nil {
+			logger.Error("failed to open ABI registry", "dir", dir, "error", err.Error())
+			return
+		}
+		sharedABIRegistryRef = registry
+	})
+	return sharedABIRegistryRef
+}
+
+// RegisterContractABI registers the ABI of a contract address or of a code hash
+// in the node-local ABI registry.
+func (b *Backend) RegisterContractABI(entry evmtypes.ContractABI) error {
+	if b.abiRegistry == nil {
+		return ErrABIRegistryNotAvailable
+	}
+	return b.abiRegistry.Register(entry)
+}
+
+// GetContractABI returns the ABI of a contract. The ABIs registered in the
+// node-local registry for the contract address, or else for its code hash,
+// take precedence over the ABI registered on chain. It returns nil if no ABI is
+// registered for the contract.
+func (b *Backend) GetContractABI(address common.Address) (*evmtypes.ContractABI, error) {
+	if b.abiRegistry != nil {
+		if entry, ok := b.abiRegistry.Get(address, common.Hash{}); ok {
+			return &entry, nil
+		}
+
+		res, err := b.queryClient.Account(b.ctx, &evmtypes.QueryAccountRequest{Address: address.Hex()})
+		if err != nil {
+			return nil, err
+		}
+
+		if entry, ok := b.abiRegistry.Get(address, common.HexToHash(res.CodeHash)); ok {
+			return &entry, nil
+		}
+	}
+
+	res, err := b.queryClient.ContractABI(b.ctx, &evmtypes.QueryContractABIRequest{Address: address.Hex()})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res.ContractABI, nil
+}
+
+// decodeTrace adds the decoded calls and custom errors of the contracts with a
+// registered ABI to the result of the default struct logger or of the call
+// tracer. Results of other tracers are left unchanged.
+func (b *Backend) decodeTrace(result interface{}, msg *evmtypes.MsgEthereumTx) {
+	frame, ok := result.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	abis := make(map[common.Address]*abi.ABI)
+
+	// struct logger result of the whole transaction
+	if _, ok := frame["structLogs"]; ok {
+		tx := msg.AsTransaction()
+		if tx.To() == nil {
+			return
+		}
+
+		returnValue, _ := frame["returnValue"].(string)
+		failed, _ := frame["failed"].(bool)
+		b.decodeFrame(frame, abis, *tx.To(), tx.Data(), common.FromHex(returnValue), failed)
+		return
+	}
+
+	b.decodeCallFrame(frame, abis)
+}
+
+// decodeCallFrame decodes a call frame of the call tracer and its inner calls.
+func (b *Backend) decodeCallFrame(frame map[string]interface{}, abis map[common.Address]*abi.ABI) {
+	to, _ := frame["to"].(string)
+	input, _ := frame["input"].(string)
+	output, _ := frame["output"].(string)
+	_, failed := frame["error"]
+
+	if common.IsHexAddress(to) {
+		b.decodeFrame(frame, abis, common.HexToAddress(to), common.FromHex(input), common.FromHex(output), failed)
+	}
+
+	calls, _ := frame["calls"].([]interface{})
+	for _, call := range calls {
+		if callFrame, ok := call.(map[string]interface{}); ok {
+			b.decodeCallFrame(callFrame, abis)
+		}
+	}
+}
+
+// decodeFrame sets the decodedCall and decodedError fields of a trace frame if
+// the called contract has a registered ABI.
+func (b *Backend) decodeFrame(
+	frame map[string]interface{},
+	abis map[common.Address]*abi.ABI,
+	to common.Address,
+	input, output []byte,
+	failed bool,
+) {
+	contractABI, ok := abis[to]
+	if !ok {
+		contractABI = b.parsedContractABI(to)
+		abis[to] = contractABI
+	}
+	if contractABI == nil {
+		return
+	}
+
+	if failed {
+		if decodedErr, err := evmtypes.DecodeError(*contractABI, output); err == nil {
+			frame["decodedError"] = decodedErr
+		}
+		output = nil
+	}
+
+	if call, err := evmtypes.DecodeCall(*contractABI, input, output); err == nil {
+		frame["decodedCall"] = call
+	}
+}
+
+// parsedContractABI returns the parsed ABI of a contract or nil if the
+// contract has no registered or valid ABI.
+func (b *Backend) parsedContractABI(address common.Address) *abi.ABI {
+	entry, err := b.GetContractABI(address)
+	if err != nil || entry == nil {
+		return nil
+	}
+
+	contractABI, err := evmtypes.ParseABI(entry.ABI)
+	if err != nil {
+		b.logger.Debug("invalid contract ABI", "address", address.Hex(), "error", err.Error())
+		return nil
+	}
+	return &contractABI
+}
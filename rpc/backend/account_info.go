@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	"github.com/evmos/evmos/v12/statetrie"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 	"github.com/pkg/errors"
 )
@@ -82,6 +83,15 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		height = int64(bn) //#nosec G701 -- checked for int overflow already
 	}
 
+	// return the Ethereum proofs of the node-local state trie if enabled, and
+	// fall back to the IAVL proofs if the trie doesn't contain the height yet
+	if b.stateTrie != nil {
+		res, err := b.stateTrie.GetProof(height, address, storageKeys)
+		if !errors.Is(err, statetrie.ErrHeightNotAvailable) {
+			return res, err
+		}
+	}
+
 	clientCtx := b.clientCtx.WithHeight(height)
 
 	// query storage proofs
@@ -8,11 +8,13 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/evmos/evmos/v12/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	"github.com/evmos/evmos/v12/statetrie"
 	utiltx "github.com/evmos/evmos/v12/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 )
@@ -165,6 +167,86 @@ func (suite *BackendTestSuite) TestGetProof() {
 				},
 			},
 		},
+		{
+			"pass - state trie height not available, fall back to the IAVL proofs",
+			address1,
+			[]string{"0x0"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.ctx = rpctypes.ContextWithHeight(bn.Int64())
+				suite.backend.stateTrie = stateTrieStub{height: bn.Int64() + 1}
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(queryClient, addr, bn.Int64())
+
+				// Use the IAVL height if a valid tendermint height is passed in.
+				iavlHeight := bn.Int64()
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/acc/key",
+					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{""},
+				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:     common.HexToHash(""),
+				Nonce:        0x0,
+				StorageHash:  common.Hash{},
+				StorageProof: []rpctypes.StorageResult{
+					{
+						Key:   "0x0",
+						Value: (*hexutil.Big)(big.NewInt(2)),
+						Proof: []string{""},
+					},
+				},
+			},
+		},
+		{
+			"pass - state trie proofs",
+			address1,
+			[]string{"0x0"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.ctx = rpctypes.ContextWithHeight(bn.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+
+				suite.backend.stateTrie = stateTrieStub{height: bn.Int64()}
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{"0x01"},
+				Balance:      (*hexutil.Big)(big.NewInt(1)),
+				CodeHash:     common.BytesToHash(evmtypes.EmptyCodeHash),
+				Nonce:        0x1,
+				StorageHash:  ethtypes.EmptyRootHash,
+				StorageProof: []rpctypes.StorageResult{
+					{
+						Key:   "0x0",
+						Value: (*hexutil.Big)(big.NewInt(0)),
+						Proof: []string{},
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -183,6 +265,39 @@ func (suite *BackendTestSuite) TestGetProof() {
 	}
 }
 
+// stateTrieStub is a state trie that only contains the state of a height.
+type stateTrieStub struct {
+	height int64
+}
+
+func (st stateTrieStub) StateRoot(height int64) (common.Hash, error) {
+	if height != st.height {
+		return common.Hash{}, statetrie.ErrHeightNotAvailable
+	}
+	return common.BigToHash(big.NewInt(height)), nil
+}
+
+func (st stateTrieStub) GetProof(height int64, address common.Address, storageKeys []string) (*rpctypes.AccountResult, error) {
+	if _, err := st.StateRoot(height); err != nil {
+		return nil, err
+	}
+
+	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	for i, key := range storageKeys {
+		storageProofs[i] = rpctypes.StorageResult{Key: key, Value: (*hexutil.Big)(big.NewInt(0)), Proof: []string{}}
+	}
+
+	return &rpctypes.AccountResult{
+		Address:      address,
+		AccountProof: []string{"0x01"},
+		Balance:      (*hexutil.Big)(big.NewInt(1)),
+		CodeHash:     common.BytesToHash(evmtypes.EmptyCodeHash),
+		Nonce:        0x1,
+		StorageHash:  ethtypes.EmptyRootHash,
+		StorageProof: storageProofs,
+	}, nil
+}
+
 func (suite *BackendTestSuite) TestGetStorageAt() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -24,12 +24,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v12/ethereum/eip712"
 	rpctypes "github.com/evmos/evmos/v12/rpc/types"
 	"github.com/evmos/evmos/v12/server/config"
 	evmostypes "github.com/evmos/evmos/v12/types"
@@ -65,6 +68,10 @@ type EVMBackend interface {
 	ImportRawKey(privkey, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
+	NewAccount(password string) (common.Address, error)
+	UnlockAccount(address common.Address, password string, duration time.Duration) (bool, error)
+	LockAccount(address common.Address) bool
+	KeystoreWallets() []accounts.Wallet
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
@@ -74,6 +81,8 @@ type EVMBackend interface {
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	SendTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (common.Hash, error)
+	SignWithPassphrase(address common.Address, data hexutil.Bytes, passphrase string) (hexutil.Bytes, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 
 	// Blocks Info
@@ -121,6 +130,8 @@ type EVMBackend interface {
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionStatus(hash common.Hash) (*rpctypes.TrackedTx, error)
+	RefreshTxStatuses() error
 
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
@@ -133,11 +144,22 @@ type EVMBackend interface {
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	SyntheticLogs(blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, error)
+	SyntheticTxLogs(res *evmostypes.TxResult) ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	GasProfile(fromBlock, toBlock rpctypes.BlockNumber) (*evmtypes.GasProfile, error)
+
+	// ABI registry
+	RegisterContractABI(entry evmtypes.ContractABI) error
+	GetContractABI(address common.Address) (*evmtypes.ContractABI, error)
+
+	// Relay
+	RelayForwardRequest(req eip712.ForwardRequest, signature hexutil.Bytes) (common.Hash, error)
+	GetForwardRequestNonce(from common.Address) (*big.Int, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -154,6 +176,12 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             evmostypes.EVMTxIndexer
+	txStatus            *rpctypes.TxStatusStore
+	syntheticTokens     *rpctypes.SyntheticTokensQuerier
+	keystore            *keystore.KeyStore
+	externalSigner      *ExternalSigner
+	abiRegistry         *rpctypes.ABIRegistry
+	stateTrie           StateTrie
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -163,6 +191,8 @@ func NewBackend(
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
 	indexer evmostypes.EVMTxIndexer,
+	stateTrie StateTrie,
+	txStatus *rpctypes.TxStatusStore,
 ) *Backend {
 	chainID, err := evmostypes.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -174,7 +204,12 @@ func NewBackend(
 		panic(err)
 	}
 
-	return &Backend{
+	externalSigner, err := sharedExternalSigner(appConf.JSONRPC.ExternalSigner)
+	if err != nil {
+		panic(err)
+	}
+
+	b := &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
 		queryClient:         rpctypes.NewQueryClient(clientCtx),
@@ -183,5 +218,16 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		txStatus:            txStatus,
+		keystore:            sharedKeystore(appConf.JSONRPC.KeystoreDir, clientCtx.HomeDir),
+		externalSigner:      externalSigner,
+		abiRegistry:         sharedABIRegistry(appConf.JSONRPC.ABIRegistryDir, clientCtx.HomeDir, logger),
+		stateTrie:           stateTrie,
+	}
+
+	if appConf.JSONRPC.SyntheticLogs || appConf.JSONRPC.SyntheticTxs {
+		b.syntheticTokens = rpctypes.NewSyntheticTokensQuerier(clientCtx)
 	}
+
+	return b
 }
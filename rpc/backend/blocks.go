@@ -459,6 +459,14 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+
+	// the Tendermint app hash can't be used to verify Ethereum proofs, so the
+	// root of the node-local state trie is returned along with it
+	if b.stateTrie != nil {
+		if root, err := b.stateTrie.StateRoot(block.Height); err == nil {
+			formattedBlock["ethStateRoot"] = root
+		}
+	}
 	return formattedBlock, nil
 }
 
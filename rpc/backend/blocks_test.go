@@ -1131,6 +1131,44 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 	}
 }
 
+func (suite *BackendTestSuite) TestRPCBlockEthStateRoot() {
+	resBlock := &tmrpctypes.ResultBlock{Block: tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height:     1,
+		TxsResults: []*types.ResponseDeliverTx{{Code: 0, GasUsed: 0}},
+	}
+
+	testCases := []struct {
+		name      string
+		stateTrie StateTrie
+		expRoot   bool
+	}{
+		{"state trie disabled", nil, false},
+		{"state root not available", stateTrieStub{height: 2}, false},
+		{"state root available", stateTrieStub{height: 1}, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterBaseFee(queryClient, sdk.NewInt(1))
+			RegisterValidatorAccount(queryClient, sdk.AccAddress(common.Address{}.Bytes()))
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterConsensusParams(client, 1)
+
+			suite.backend.stateTrie = tc.stateTrie
+			block, err := suite.backend.RPCBlockFromTendermintBlock(resBlock, blockRes, false)
+			suite.Require().NoError(err)
+
+			root, ok := block["ethStateRoot"]
+			suite.Require().Equal(tc.expRoot, ok)
+			if tc.expRoot {
+				suite.Require().Equal(common.BigToHash(big.NewInt(1)), root)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestEthMsgsFromTendermintBlock() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 
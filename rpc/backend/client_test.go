@@ -63,6 +63,11 @@ func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
 		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
 }
 
+func RegisterUnconfirmedTxsWithTotal(client *mocks.Client, limit *int, txs []types.Tx, total int) {
+	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
+		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs, Count: len(txs), Total: total}, nil)
+}
+
 func RegisterUnconfirmedTxsEmpty(client *mocks.Client, limit *int) {
 	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{
@@ -75,6 +80,25 @@ func RegisterUnconfirmedTxsError(client *mocks.Client, limit *int) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Num Unconfirmed Transactions
+func RegisterNumUnconfirmedTxs(client *mocks.Client, total int) {
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultUnconfirmedTxs{Count: total, Total: total}, nil)
+}
+
+func RegisterNumUnconfirmedTxsError(client *mocks.Client) {
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// CheckTx
+func RegisterCheckTx(client *mocks.Client, tx types.Tx, code uint32, log string) {
+	client.On("CheckTx", rpc.ContextWithHeight(1), tx).
+		Return(&tmrpctypes.ResultCheckTx{
+			ResponseCheckTx: abci.ResponseCheckTx{Code: code, Log: log},
+		}, nil)
+}
+
 // Status
 func RegisterStatus(client *mocks.Client) {
 	client.On("Status", rpc.ContextWithHeight(1)).
@@ -0,0 +1,203 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ErrExternalSignerAccounts is returned by the account management methods when the
+// accounts are managed by the external signer.
+var ErrExternalSignerAccounts = errors.New("accounts are managed by the external signer")
+
+var (
+	externalSignerOnce sync.Once
+	sharedExtSigner    *ExternalSigner
+	sharedExtSignerErr error
+)
+
+// sharedExternalSigner returns the client of the external signer shared by all
+// the Backend instances of the node. It returns nil if no endpoint is set, and an
+// error if the external signer is configured but can't be reached, as the signing
+// methods must not fall back to the keys of the node in that case.
+func sharedExternalSigner(endpoint string) (*ExternalSigner, error) {
+	externalSignerOnce.Do(func() {
+		if endpoint == "" {
+			return
+		}
+
+		signer, err := DialExternalSigner(context.Background(), endpoint)
+		if err != nil {
+			sharedExtSignerErr = fmt.Errorf("failed to connect to the external signer at %s: %w", endpoint, err)
+			return
+		}
+		sharedExtSigner = signer
+	})
+	return sharedExtSigner, sharedExtSignerErr
+}
+
+// ExternalSigner is a client of a Clef-compatible external signer, which exposes
+// the account_* API over HTTP or IPC. The external signer is responsible for
+// approving or rejecting each signing request; rejections are returned as errors.
+type ExternalSigner struct {
+	client *rpc.Client
+}
+
+// DialExternalSigner connects to the external signer at the given endpoint, which
+// is either an HTTP(S) or WebSocket URL, or the path of an IPC socket. As HTTP
+// connections are established lazily, the version of the external signer's API is
+// requested to make sure that it's reachable.
+func DialExternalSigner(ctx context.Context, endpoint string) (*ExternalSigner, error) {
+	client, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := NewExternalSigner(client)
+	if _, err := signer.Version(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return signer, nil
+}
+
+// NewExternalSigner creates a new external signer client from an RPC client.
+func NewExternalSigner(client *rpc.Client) *ExternalSigner {
+	return &ExternalSigner{client: client}
+}
+
+// Version returns the version of the external signer's API.
+func (s *ExternalSigner) Version(ctx context.Context) (string, error) {
+	var version string
+	if err := s.client.CallContext(ctx, &version, "account_version"); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// Accounts returns the accounts managed by the external signer. Listing the
+// accounts is subject to approval as well.
+func (s *ExternalSigner) Accounts(ctx context.Context) ([]common.Address, error) {
+	addrs := make([]common.Address, 0) // return [] instead of nil if empty
+	if err := s.client.CallContext(ctx, &addrs, "account_list"); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+// SignText requests the signature of the given data according to EIP-191
+// (version 0x45), i.e. keccak256("\x19Ethereum Signed Message:\n"${message length}${message}).
+// The V value of the returned signature is in the 27/28 form.
+func (s *ExternalSigner) SignText(ctx context.Context, address common.Address, data []byte) (hexutil.Bytes, error) {
+	var sig hexutil.Bytes
+	signAddress := common.NewMixedcaseAddress(address)
+	// the pointer is required by the MarshalJSON method of MixedcaseAddress
+	if err := s.client.CallContext(ctx, &sig, "account_signData", accounts.MimetypeTextPlain, &signAddress, hexutil.Encode(data)); err != nil {
+		return nil, err
+	}
+	return toEthereumSignature(sig)
+}
+
+// SignTypedData requests the signature of the given EIP-712 typed data. The V
+// value of the returned signature is in the 27/28 form.
+func (s *ExternalSigner) SignTypedData(ctx context.Context, address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	var sig hexutil.Bytes
+	signAddress := common.NewMixedcaseAddress(address)
+	if err := s.client.CallContext(ctx, &sig, "account_signTypedData", &signAddress, typedData); err != nil {
+		return nil, err
+	}
+	return toEthereumSignature(sig)
+}
+
+// signTransactionResult is the result of the account_signTransaction method.
+type signTransactionResult struct {
+	Raw hexutil.Bytes         `json:"raw"`
+	Tx  *ethtypes.Transaction `json:"tx"`
+}
+
+// SignTx requests the signature of the given transaction for the given chain ID.
+// The external signer may modify the transaction before signing it (eg: when
+// the user edits the gas price), so the returned transaction must be used.
+func (s *ExternalSigner) SignTx(ctx context.Context, address common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+
+	switch tx.Type() {
+	case ethtypes.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case ethtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", tx.Type())
+	}
+
+	var res signTransactionResult
+	if err := s.client.CallContext(ctx, &res, "account_signTransaction", &args); err != nil {
+		return nil, err
+	}
+	if res.Tx == nil {
+		return nil, fmt.Errorf("external signer returned no transaction")
+	}
+	return res.Tx, nil
+}
+
+// Close closes the connection to the external signer.
+func (s *ExternalSigner) Close() {
+	s.client.Close()
+}
+
+// toEthereumSignature validates a signature returned by the external signer and
+// transforms its V value to the 27/28 form if needed.
+func toEthereumSignature(sig hexutil.Bytes) (hexutil.Bytes, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length from external signer: %d", len(sig))
+	}
+	if sig[crypto.RecoveryIDOffset] < 27 {
+		sig[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	}
+	return sig, nil
+}
@@ -0,0 +1,201 @@
+package backend
+
+import (
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	goethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v12/rpc/backend/mocks"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+func (suite *BackendTestSuite) setupExternalSigner() (*utiltx.MockClef, common.Address, *ethsecp256k1.PrivKey) {
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	key, err := priv.ToECDSA()
+	suite.Require().NoError(err)
+
+	clef := utiltx.NewMockClef(key)
+	suite.backend.externalSigner = NewExternalSigner(clef.Client())
+	return clef, goethcrypto.PubkeyToAddress(key.PublicKey), priv
+}
+
+func (suite *BackendTestSuite) TestExternalSignerAccounts() {
+	suite.SetupTest()
+	clef, addr, _ := suite.setupExternalSigner()
+
+	version, err := suite.backend.externalSigner.Version(suite.backend.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(utiltx.MockClefVersion, version)
+
+	accs, err := suite.backend.Accounts()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]common.Address{addr}, accs)
+
+	accs, err = suite.backend.ListAccounts()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]common.Address{addr}, accs)
+
+	// accounts are managed by the external signer
+	_, err = suite.backend.NewAccount("password")
+	suite.Require().ErrorIs(err, ErrExternalSignerAccounts)
+	_, err = suite.backend.UnlockAccount(addr, "password", 0)
+	suite.Require().ErrorIs(err, ErrExternalSignerAccounts)
+
+	clef.SetApprove(false)
+	_, err = suite.backend.Accounts()
+	suite.Require().ErrorContains(err, utiltx.ErrClefRequestDenied.Error())
+}
+
+func (suite *BackendTestSuite) TestExternalSignerSign() {
+	suite.SetupTest()
+	clef, addr, priv := suite.setupExternalSigner()
+
+	// keys of the node's keyring are not used
+	armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+	suite.Require().NoError(suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, ""))
+
+	data := hexutil.Bytes("hello")
+	sig, err := suite.backend.Sign(addr, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, suite.recoverSigner(accounts.TextHash(data), sig))
+
+	// the passphrase is ignored
+	sig, err = suite.backend.SignWithPassphrase(addr, data, "wrong")
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, suite.recoverSigner(accounts.TextHash(data), sig))
+
+	_, err = suite.backend.Sign(utiltx.GenerateAddress(), data)
+	suite.Require().Error(err)
+
+	clef.SetApprove(false)
+	_, err = suite.backend.Sign(addr, data)
+	suite.Require().ErrorContains(err, utiltx.ErrClefRequestDenied.Error())
+	suite.Require().Equal([]string{"account_signData", "account_signData", "account_signData", "account_signData"}, clef.Requests())
+
+	// the keys of the node's keyring sign the same hash
+	suite.backend.externalSigner = nil
+	keyringSig, err := suite.backend.Sign(addr, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sig, keyringSig)
+}
+
+func (suite *BackendTestSuite) TestDialExternalSigner() {
+	_, err := DialExternalSigner(suite.backend.ctx, "http://127.0.0.1:1")
+	suite.Require().Error(err)
+
+	_, err = DialExternalSigner(suite.backend.ctx, filepath.Join(suite.T().TempDir(), "clef.ipc"))
+	suite.Require().Error(err)
+}
+
+func (suite *BackendTestSuite) TestExternalSignerSignTypedData() {
+	suite.SetupTest()
+	clef, addr, _ := suite.setupExternalSigner()
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "Evmos", ChainId: (*math.HexOrDecimal256)(suite.backend.chainID)},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	suite.Require().NoError(err)
+
+	sig, err := suite.backend.SignTypedData(addr, typedData)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, suite.recoverSigner(sigHash, sig))
+
+	clef.SetApprove(false)
+	_, err = suite.backend.SignTypedData(addr, typedData)
+	suite.Require().ErrorContains(err, utiltx.ErrClefRequestDenied.Error())
+}
+
+func (suite *BackendTestSuite) TestExternalSignerSendTransaction() {
+	gasPrice := new(hexutil.Big)
+	gas := hexutil.Uint64(1)
+	nonce := hexutil.Uint64(1)
+	toAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		approve bool
+		expPass bool
+	}{
+		{"fail - rejected by the external signer", false, false},
+		{"pass - signed by the external signer", true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clef, from, priv := suite.setupExternalSigner()
+			clef.SetApprove(tc.approve)
+
+			args := evmtypes.TransactionArgs{
+				From:     &from,
+				To:       &toAddr,
+				GasPrice: gasPrice,
+				Gas:      &gas,
+				Nonce:    &nonce,
+			}
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			RegisterParams(queryClient, &header, 1)
+			_, err := RegisterBlock(client, 1, nil)
+			suite.Require().NoError(err)
+			_, err = RegisterBlockResults(client, 1)
+			suite.Require().NoError(err)
+			RegisterBaseFee(queryClient, sdk.NewInt(1))
+			RegisterParamsWithoutHeader(queryClient, 1)
+
+			// the external signer signs the same transaction as the node would
+			msg := args.ToTransaction()
+			key, err := priv.ToECDSA()
+			suite.Require().NoError(err)
+			signedTx, err := ethtypes.SignTx(msg.AsTransaction(), ethtypes.LatestSignerForChainID(suite.backend.chainID), key)
+			suite.Require().NoError(err)
+			suite.Require().NoError(msg.FromEthereumTx(signedTx))
+			tx, err := msg.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+			suite.Require().NoError(err)
+			txBytes, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+			if tc.approve {
+				RegisterBroadcastTx(client, txBytes)
+			}
+
+			hash, err := suite.backend.SendTransaction(args)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(signedTx.Hash(), hash)
+			} else {
+				suite.Require().ErrorContains(err, utiltx.ErrClefRequestDenied.Error())
+			}
+			suite.Require().Equal([]string{"account_signTransaction"}, clef.Requests())
+		})
+	}
+}
+
+// recoverSigner returns the address that signed the hash, with V in the 27/28 form.
+func (suite *BackendTestSuite) recoverSigner(hash []byte, sig hexutil.Bytes) common.Address {
+	sig = common.CopyBytes(sig)
+	sig[goethcrypto.RecoveryIDOffset] -= 27
+	pubKey, err := goethcrypto.SigToPub(hash, sig)
+	suite.Require().NoError(err)
+	return goethcrypto.PubkeyToAddress(*pubKey)
+}
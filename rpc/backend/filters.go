@@ -33,7 +33,8 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 	return b.GetLogsByHeight(&resBlock.Block.Header.Height)
 }
 
-// GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
+// GetLogsByHeight returns all the logs from all the ethereum transactions in a block,
+// followed by the synthetic logs of the block if they are enabled.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.TendermintBlockResultByNumber(height)
@@ -41,7 +42,24 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 		return nil, err
 	}
 
-	return GetLogsFromBlockResults(blockRes)
+	logs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	syntheticLogs, err := b.SyntheticLogs(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	// group the synthetic logs by transaction
+	for i, log := range syntheticLogs {
+		if i == 0 || syntheticLogs[i-1].TxHash != log.TxHash {
+			logs = append(logs, []*ethtypes.Log{})
+		}
+		logs[len(logs)-1] = append(logs[len(logs)-1], log)
+	}
+	return logs, nil
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
@@ -0,0 +1,227 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// keystoreDirName is the name of the keystore directory within the node's home directory.
+const keystoreDirName = "keystore"
+
+var (
+	// ErrKeystoreNotAvailable is returned when the encrypted keystore is not configured.
+	ErrKeystoreNotAvailable = errors.New("keystore is not available")
+	// ErrKeyringPassword is returned when a password is given for an account of
+	// the node's keyring, whose keys are not protected by a password of their own.
+	ErrKeyringPassword = errors.New("keyring accounts are not protected by a password, import the key into the keystore instead")
+)
+
+var (
+	keyStoreOnce   sync.Once
+	sharedKeyStore *keystore.KeyStore
+)
+
+// sharedKeystore returns the encrypted keystore shared by all the Backend
+// instances of the node, so that the accounts unlocked through the personal
+// namespace can be used by the eth namespace. It returns nil if neither the
+// keystore directory nor the home directory are set.
+func sharedKeystore(dir, homeDir string) *keystore.KeyStore {
+	keyStoreOnce.Do(func() {
+		if dir == "" {
+			if homeDir == "" {
+				return
+			}
+			dir = filepath.Join(homeDir, keystoreDirName)
+		}
+		sharedKeyStore = keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+	})
+	return sharedKeyStore
+}
+
+// keystoreSigner implements the keyring.Signer interface for the accounts of
+// the encrypted keystore. If no passphrase is set, the account must be unlocked.
+type keystoreSigner struct {
+	ks         *keystore.KeyStore
+	passphrase *string
+}
+
+var _ keyring.Signer = keystoreSigner{}
+
+// Sign implements the keyring.Signer interface. Keystore accounts can only be
+// referred to by address.
+func (s keystoreSigner) Sign(uid string, _ []byte) ([]byte, cryptotypes.PubKey, error) {
+	return nil, nil, fmt.Errorf("keystore accounts can't be referred to by name: %s", uid)
+}
+
+// SignByAddress implements the keyring.Signer interface. As for the keys of the
+// keyring, messages that are not 32 bytes long are hashed before signing.
+func (s keystoreSigner) SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	if len(msg) != crypto.DigestLength {
+		msg = crypto.Keccak256(msg)
+	}
+
+	account := accounts.Account{Address: common.BytesToAddress(address.Bytes())}
+
+	var (
+		sig []byte
+		err error
+	)
+	if s.passphrase != nil {
+		sig, err = s.ks.SignHashWithPassphrase(account, *s.passphrase, msg)
+	} else {
+		sig, err = s.ks.SignHash(account, msg)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sig, nil, nil
+}
+
+// signer returns the signer of the given address, which is either the node's
+// keyring or the encrypted keystore. If a passphrase is given, it must decrypt
+// the key of a keystore account, as the keys of the keyring can't be verified
+// against it. If it's nil, the keystore account must be unlocked.
+func (b *Backend) signer(address common.Address, passphrase *string) (keyring.Signer, error) {
+	inKeystore := b.keystore != nil && b.keystore.HasAddress(address)
+	if inKeystore && passphrase != nil {
+		return keystoreSigner{ks: b.keystore, passphrase: passphrase}, nil
+	}
+
+	keyringErr := fmt.Errorf("account not found")
+	if b.clientCtx.Keyring != nil {
+		_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(address.Bytes()))
+		if err == nil {
+			if passphrase != nil {
+				return nil, ErrKeyringPassword
+			}
+			return b.clientCtx.Keyring, nil
+		}
+		keyringErr = err
+	}
+
+	if inKeystore {
+		return keystoreSigner{ks: b.keystore}, nil
+	}
+
+	b.logger.Error("failed to find key in keyring", "address", address.String(), "error", keyringErr.Error())
+	return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, keyringErr.Error())
+}
+
+// keystoreAccounts returns the addresses of the accounts of the encrypted keystore.
+func (b *Backend) keystoreAccounts() []common.Address {
+	if b.keystore == nil {
+		return nil
+	}
+
+	accs := b.keystore.Accounts()
+	addrs := make([]common.Address, 0, len(accs))
+	for _, acc := range accs {
+		addrs = append(addrs, acc.Address)
+	}
+	return addrs
+}
+
+// appendKeystoreAccounts appends the keystore accounts that are not part of the given list.
+func appendKeystoreAccounts(addrs, keystoreAddrs []common.Address) []common.Address {
+	for _, addr := range keystoreAddrs {
+		found := false
+		for _, existing := range addrs {
+			if existing == addr {
+				found = true
+				break
+			}
+		}
+		if !found {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// NewAccount creates a new account in the encrypted keystore, whose key is
+// encrypted with the given password.
+func (b *Backend) NewAccount(password string) (common.Address, error) {
+	if b.externalSigner != nil {
+		return common.Address{}, ErrExternalSignerAccounts
+	}
+	if b.keystore == nil {
+		return common.Address{}, ErrKeystoreNotAvailable
+	}
+
+	acc, err := b.keystore.NewAccount(password)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	b.logger.Info("Your new key was generated", "address", acc.Address.String())
+	b.logger.Info("Please backup your key file!", "path", acc.URL.Path)
+	b.logger.Info("Please remember your password!")
+	return acc.Address, nil
+}
+
+// UnlockAccount decrypts the key of the given keystore account with the given
+// password and keeps it in memory for the given duration. A duration of 0 keeps
+// the account unlocked until the node is stopped or the account is locked.
+// Accounts of the node's keyring are not protected by a password and can't be
+// unlocked.
+func (b *Backend) UnlockAccount(address common.Address, password string, duration time.Duration) (bool, error) {
+	if b.externalSigner != nil {
+		return false, ErrExternalSignerAccounts
+	}
+	if b.keystore == nil || !b.keystore.HasAddress(address) {
+		if b.clientCtx.Keyring != nil {
+			if _, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(address.Bytes())); err == nil {
+				return false, ErrKeyringPassword
+			}
+		}
+		return false, keystore.ErrNoMatch
+	}
+
+	if err := b.keystore.TimedUnlock(accounts.Account{Address: address}, password, duration); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// LockAccount removes the decrypted key of the given keystore account from memory.
+func (b *Backend) LockAccount(address common.Address) bool {
+	if b.keystore == nil || !b.keystore.HasAddress(address) {
+		return false
+	}
+	return b.keystore.Lock(address) == nil
+}
+
+// KeystoreWallets returns the wallets of the encrypted keystore.
+func (b *Backend) KeystoreWallets() []accounts.Wallet {
+	if b.keystore == nil {
+		return nil
+	}
+	return b.keystore.Wallets()
+}
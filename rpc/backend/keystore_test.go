@@ -0,0 +1,131 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (suite *BackendTestSuite) setupKeystore() {
+	suite.backend.keystore = keystore.NewKeyStore(suite.T().TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+}
+
+func (suite *BackendTestSuite) TestKeystoreAccounts() {
+	suite.SetupTest()
+
+	// keystore not available
+	_, err := suite.backend.NewAccount("password")
+	suite.Require().ErrorIs(err, ErrKeystoreNotAvailable)
+	suite.Require().False(suite.backend.LockAccount(common.Address{}))
+
+	suite.setupKeystore()
+
+	addr, err := suite.backend.NewAccount("password")
+	suite.Require().NoError(err)
+
+	priv, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	importedAddr, err := suite.backend.ImportRawKey(common.Bytes2Hex(crypto.FromECDSA(priv)), "password2")
+	suite.Require().NoError(err)
+	suite.Require().Equal(crypto.PubkeyToAddress(priv.PublicKey), importedAddr)
+
+	// the same key can't be imported twice
+	_, err = suite.backend.ImportRawKey(common.Bytes2Hex(crypto.FromECDSA(priv)), "password2")
+	suite.Require().ErrorIs(err, keystore.ErrAccountAlreadyExists)
+
+	list, err := suite.backend.ListAccounts()
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]common.Address{addr, importedAddr}, list)
+
+	accs, err := suite.backend.Accounts()
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]common.Address{addr, importedAddr}, accs)
+
+	suite.Require().Len(suite.backend.KeystoreWallets(), 2)
+}
+
+func (suite *BackendTestSuite) TestKeystoreUnlockAndSign() {
+	suite.SetupTest()
+	suite.setupKeystore()
+
+	addr, err := suite.backend.NewAccount("password")
+	suite.Require().NoError(err)
+
+	data := []byte("hello")
+	recoverAddr := func(sig []byte) common.Address {
+		sig = common.CopyBytes(sig)
+		sig[crypto.RecoveryIDOffset] -= 27
+		pubKey, err := crypto.SigToPub(accounts.TextHash(data), sig)
+		suite.Require().NoError(err)
+		return crypto.PubkeyToAddress(*pubKey)
+	}
+
+	// locked account
+	_, err = suite.backend.Sign(addr, data)
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+
+	// sign with passphrase
+	_, err = suite.backend.SignWithPassphrase(addr, data, "wrong")
+	suite.Require().ErrorIs(err, keystore.ErrDecrypt)
+	sig, err := suite.backend.SignWithPassphrase(addr, data, "password")
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, recoverAddr(sig))
+
+	// unlock
+	unlocked, err := suite.backend.UnlockAccount(addr, "wrong", time.Minute)
+	suite.Require().ErrorIs(err, keystore.ErrDecrypt)
+	suite.Require().False(unlocked)
+
+	unlocked, err = suite.backend.UnlockAccount(common.Address{1}, "password", time.Minute)
+	suite.Require().ErrorIs(err, keystore.ErrNoMatch)
+	suite.Require().False(unlocked)
+
+	unlocked, err = suite.backend.UnlockAccount(addr, "password", time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().True(unlocked)
+
+	sig, err = suite.backend.Sign(addr, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, recoverAddr(sig))
+
+	// lock
+	suite.Require().True(suite.backend.LockAccount(addr))
+	_, err = suite.backend.Sign(addr, data)
+	suite.Require().ErrorIs(err, keystore.ErrLocked)
+
+	// timed unlock expires
+	unlocked, err = suite.backend.UnlockAccount(addr, "password", 100*time.Millisecond)
+	suite.Require().NoError(err)
+	suite.Require().True(unlocked)
+	suite.Require().Eventually(func() bool {
+		_, err := suite.backend.keystore.SignHash(accounts.Account{Address: addr}, crypto.Keccak256(data))
+		return err != nil
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func (suite *BackendTestSuite) TestKeyringAccountPassword() {
+	suite.SetupTest()
+
+	// the key is stored on the keyring as the keystore is not available
+	priv, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	addr, err := suite.backend.ImportRawKey(common.Bytes2Hex(crypto.FromECDSA(priv)), "password")
+	suite.Require().NoError(err)
+
+	_, err = suite.backend.ImportRawKey(common.Bytes2Hex(crypto.FromECDSA(priv)), "password")
+	suite.Require().ErrorIs(err, keystore.ErrAccountAlreadyExists)
+
+	// the password of a keyring account can't be verified
+	unlocked, err := suite.backend.UnlockAccount(addr, "password", time.Minute)
+	suite.Require().ErrorIs(err, ErrKeyringPassword)
+	suite.Require().False(unlocked)
+
+	_, err = suite.backend.SignWithPassphrase(addr, []byte("hello"), "password")
+	suite.Require().ErrorIs(err, ErrKeyringPassword)
+
+	_, err = suite.backend.Sign(addr, []byte("hello"))
+	suite.Require().NoError(err)
+}
@@ -30,6 +30,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -43,6 +44,10 @@ import (
 
 // Accounts returns the list of accounts available to this node.
 func (b *Backend) Accounts() ([]common.Address, error) {
+	if b.externalSigner != nil {
+		return b.externalSigner.Accounts(b.ctx)
+	}
+
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
 
 	infos, err := b.clientCtx.Keyring.List()
@@ -59,7 +64,7 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 		addresses = append(addresses, common.BytesToAddress(addressBytes))
 	}
 
-	return addresses, nil
+	return appendKeystoreAccounts(addresses, b.keystoreAccounts()), nil
 }
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
@@ -191,25 +196,40 @@ func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 	return true
 }
 
-// ImportRawKey armors and encrypts a given raw hex encoded ECDSA key and stores it into the key directory.
-// The name of the key will have the format "personal_<length-keys>", where <length-keys> is the total number of
-// keys stored on the keyring.
+// ImportRawKey encrypts a given raw hex encoded ECDSA key with the passphrase and stores it
+// into the encrypted keystore. If the keystore is not available, the key is stored on the
+// keyring instead, with a name that has the format "personal_<length-keys>", where
+// <length-keys> is the total number of keys stored on the keyring.
 //
-// NOTE: The key will be both armored and encrypted using the same passphrase.
+// NOTE: Keys stored on the keyring will be both armored and encrypted using the same passphrase.
 func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error) {
+	if b.externalSigner != nil {
+		return common.Address{}, ErrExternalSignerAccounts
+	}
+
 	priv, err := crypto.HexToECDSA(privkey)
 	if err != nil {
 		return common.Address{}, err
 	}
 
+	// store the key in the encrypted keystore, if available
+	if b.keystore != nil {
+		acc, err := b.keystore.ImportECDSA(priv, password)
+		if err != nil {
+			return common.Address{}, err
+		}
+
+		b.logger.Info("key successfully imported", "path", acc.URL.Path, "address", acc.Address.String())
+		return acc.Address, nil
+	}
+
 	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
 
 	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
 	ethereumAddr := common.BytesToAddress(addr)
 
-	// return if the key has already been imported
 	if _, err := b.clientCtx.Keyring.KeyByAddress(addr); err == nil {
-		return ethereumAddr, nil
+		return common.Address{}, keystore.ErrAccountAlreadyExists
 	}
 
 	// ignore error as we only care about the length of the list
@@ -229,6 +249,10 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (b *Backend) ListAccounts() ([]common.Address, error) {
+	if b.externalSigner != nil {
+		return b.externalSigner.Accounts(b.ctx)
+	}
+
 	addrs := []common.Address{}
 
 	list, err := b.clientCtx.Keyring.List()
@@ -244,7 +268,7 @@ func (b *Backend) ListAccounts() ([]common.Address, error) {
 		addrs = append(addrs, common.BytesToAddress(pubKey.Address()))
 	}
 
-	return addrs, nil
+	return appendKeystoreAccounts(addrs, b.keystoreAccounts()), nil
 }
 
 // NewAccount will create a new account and returns the address for the new account.
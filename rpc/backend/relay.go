@@ -0,0 +1,149 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v12/ethereum/eip712"
+	"github.com/evmos/evmos/v12/precompiles/forwarder"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// ErrRelayDisabled is returned when the node doesn't have a relayer account configured.
+var ErrRelayDisabled = errors.New("forward requests relaying is disabled")
+
+// forwarderAddress is the address of the EIP-2771 forwarder precompiled contract.
+var forwarderAddress = common.HexToAddress(forwarder.PrecompileAddress)
+
+// RelayForwardRequest submits the EIP-2771 forward request to the forwarder
+// precompile, in a transaction signed by the relayer account of the node. The
+// request must comply with the sponsorship policy of the node, and be
+// executable on the latest block.
+func (b *Backend) RelayForwardRequest(req eip712.ForwardRequest, signature hexutil.Bytes) (common.Hash, error) {
+	if b.cfg.JSONRPC.RelayerAddress == "" {
+		return common.Hash{}, ErrRelayDisabled
+	}
+	relayer := common.HexToAddress(b.cfg.JSONRPC.RelayerAddress)
+
+	if err := b.checkRelayPolicy(req); err != nil {
+		return common.Hash{}, err
+	}
+
+	signer, err := eip712.RecoverForwardRequestSigner(b.chainID.Uint64(), forwarderAddress, req, signature)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if signer != req.From {
+		return common.Hash{}, fmt.Errorf("forward request signed by %s instead of %s", signer, req.From)
+	}
+
+	forwarderABI, err := forwarder.LoadABI()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// the nonce and deadline are checked by the forwarder
+	input, err := forwarderABI.Pack(forwarder.VerifyMethod, req, []byte(signature))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	res, err := b.DoCall(evmtypes.TransactionArgs{
+		From:  &relayer,
+		To:    &forwarderAddress,
+		Input: (*hexutil.Bytes)(&input),
+	}, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	out, err := forwarderABI.Unpack(forwarder.VerifyMethod, res.Ret)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if valid, ok := out[0].(bool); !ok || !valid {
+		return common.Hash{}, errors.New("invalid forward request nonce or expired deadline")
+	}
+
+	input, err = forwarderABI.Pack(forwarder.ExecuteMethod, req, []byte(signature))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// the gas is estimated on SetTxDefaults
+	return b.SendTransaction(evmtypes.TransactionArgs{
+		From:  &relayer,
+		To:    &forwarderAddress,
+		Input: (*hexutil.Bytes)(&input),
+	})
+}
+
+// GetForwardRequestNonce returns the nonce of the next forward request of the
+// given account on the latest block.
+func (b *Backend) GetForwardRequestNonce(from common.Address) (*big.Int, error) {
+	forwarderABI, err := forwarder.LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := forwarderABI.Pack(forwarder.GetNonceMethod, from)
+	if err != nil {
+		return nil, err
+	}
+	res, err := b.DoCall(evmtypes.TransactionArgs{
+		To:    &forwarderAddress,
+		Input: (*hexutil.Bytes)(&input),
+	}, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := forwarderABI.Unpack(forwarder.GetNonceMethod, res.Ret)
+	if err != nil {
+		return nil, err
+	}
+	nonce, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid nonce %v", out[0])
+	}
+	return nonce, nil
+}
+
+// checkRelayPolicy returns an error if the forward request isn't sponsored by
+// the relayer of the node, according to the relay configuration. The relayer
+// only pays the fees of the requests: the requests transferring value, which
+// would be paid from the relayer balance, are not relayed.
+func (b *Backend) checkRelayPolicy(req eip712.ForwardRequest) error {
+	if req.Value != nil && req.Value.Sign() != 0 {
+		return fmt.Errorf("forward requests transferring value are not relayed, got %s", req.Value)
+	}
+
+	if maxGas := b.cfg.JSONRPC.RelayMaxGas; maxGas > 0 && (req.Gas == nil || !req.Gas.IsUint64() || req.Gas.Uint64() > maxGas) {
+		return fmt.Errorf("forward request gas %v exceeds the relay limit %d", req.Gas, maxGas)
+	}
+
+	for _, contract := range b.cfg.JSONRPC.RelayAllowedContracts {
+		if common.HexToAddress(contract) == req.To {
+			return nil
+		}
+	}
+	return fmt.Errorf("forward requests to contract %s are not relayed", req.To)
+}
@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/ethereum/eip712"
+)
+
+func (suite *BackendTestSuite) TestRelayForwardRequestPolicy() {
+	allowed := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+
+	testCases := []struct {
+		name     string
+		relayer  string
+		allowed  []string
+		maxGas   uint64
+		req      eip712.ForwardRequest
+		expError string
+	}{
+		{
+			"fail - relaying disabled",
+			"",
+			nil,
+			0,
+			eip712.ForwardRequest{To: allowed, Gas: big.NewInt(1)},
+			"relaying is disabled",
+		},
+		{
+			"fail - contract not allowed",
+			allowed.Hex(),
+			[]string{allowed.Hex()},
+			0,
+			eip712.ForwardRequest{To: common.HexToAddress("0x0000000000000000000000000000000000000def"), Gas: big.NewInt(1)},
+			"are not relayed",
+		},
+		{
+			"fail - no allowed contract",
+			allowed.Hex(),
+			nil,
+			0,
+			eip712.ForwardRequest{To: allowed, Gas: big.NewInt(1)},
+			"are not relayed",
+		},
+		{
+			"fail - gas above the limit",
+			allowed.Hex(),
+			[]string{allowed.Hex()},
+			100,
+			eip712.ForwardRequest{To: allowed, Gas: big.NewInt(101)},
+			"exceeds the relay limit",
+		},
+		{
+			"fail - value transfer",
+			allowed.Hex(),
+			[]string{allowed.Hex()},
+			100,
+			eip712.ForwardRequest{To: allowed, Value: big.NewInt(1), Gas: big.NewInt(100)},
+			"transferring value are not relayed",
+		},
+		{
+			"fail - invalid signature",
+			allowed.Hex(),
+			[]string{allowed.Hex()},
+			100,
+			eip712.ForwardRequest{To: allowed, Gas: big.NewInt(100)},
+			"invalid signature length",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.backend.cfg.JSONRPC.RelayerAddress = tc.relayer
+			suite.backend.cfg.JSONRPC.RelayAllowedContracts = tc.allowed
+			suite.backend.cfg.JSONRPC.RelayMaxGas = tc.maxGas
+
+			_, err := suite.backend.RelayForwardRequest(tc.req, nil)
+			suite.Require().ErrorContains(err, tc.expError)
+		})
+	}
+}
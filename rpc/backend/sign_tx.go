@@ -22,8 +22,9 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -33,13 +34,31 @@ import (
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 )
 
-// SendTransaction sends transaction based on received args using Node's key to sign it
+// SendTransaction sends transaction based on received args using Node's key to sign it.
+// Accounts of the encrypted keystore must be unlocked. If an external signer is
+// configured, the transaction is signed by the external signer instead.
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	return b.sendTransaction(args, nil)
+}
+
+// SendTransactionWithPassphrase sends transaction based on received args using Node's key
+// to sign it. The passphrase is used to decrypt the key of the encrypted keystore accounts.
+// It's ignored if an external signer is configured, as the external signer approves the request.
+func (b *Backend) SendTransactionWithPassphrase(args evmtypes.TransactionArgs, passphrase string) (common.Hash, error) {
+	return b.sendTransaction(args, &passphrase)
+}
+
+func (b *Backend) sendTransaction(args evmtypes.TransactionArgs, passphrase *string) (common.Hash, error) {
 	// Look up the wallet containing the requested signer
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	var (
+		signer keyring.Signer
+		err    error
+	)
+	if b.externalSigner == nil {
+		signer, err = b.signer(args.GetFrom(), passphrase)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s", err.Error())
+		}
 	}
 
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
@@ -57,14 +76,19 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+	ethSigner := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
 
 	// LegacyTx derives chainID from the signature. To make sure the msg.ValidateBasic makes
 	// the corresponding chainID validation, we need to sign the transaction before calling it
 
 	// Sign transaction
 	msg := args.ToTransaction()
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+	if b.externalSigner != nil {
+		err = b.signTxWithExternalSigner(msg, ethSigner)
+	} else {
+		err = msg.Sign(ethSigner, signer)
+	}
+	if err != nil {
 		b.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
@@ -110,6 +134,7 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	// NOTE: If error is encountered on the node, the broadcast will not return an error
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
+	b.trackTx(txHash, txBytes, rsp, err)
 	if rsp != nil && rsp.Code != 0 {
 		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
 	}
@@ -122,18 +147,55 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	return txHash, nil
 }
 
-// Sign signs the provided data using the private key of address via Geth's signature standard.
+// signTxWithExternalSigner requests the signature of the transaction to the external
+// signer and updates the message with the signed transaction.
+func (b *Backend) signTxWithExternalSigner(msg *evmtypes.MsgEthereumTx, ethSigner ethtypes.Signer) error {
+	from := common.BytesToAddress(msg.GetFrom())
+
+	signedTx, err := b.externalSigner.SignTx(b.ctx, from, msg.AsTransaction(), b.chainID)
+	if err != nil {
+		return errorsmod.Wrap(err, "external signer")
+	}
+
+	sender, err := ethtypes.Sender(ethSigner, signedTx)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid signature from external signer")
+	}
+	if sender != from {
+		return fmt.Errorf("external signer signed with a different account (have=%s, want=%s)", sender, from)
+	}
+
+	return msg.FromEthereumTx(signedTx)
+}
+
+// Sign signs the provided data using the private key of address via Geth's signature standard,
+// i.e. the EIP-191 hash of the data is signed. Accounts of the encrypted keystore must be unlocked.
+// If an external signer is configured, the data is signed by the external signer instead.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return b.sign(address, data, nil)
+}
+
+// SignWithPassphrase signs the provided data using the private key of address via Geth's
+// signature standard. The passphrase is used to decrypt the key of the encrypted keystore accounts.
+// It's ignored if an external signer is configured, as the external signer approves the request.
+func (b *Backend) SignWithPassphrase(address common.Address, data hexutil.Bytes, passphrase string) (hexutil.Bytes, error) {
+	return b.sign(address, data, &passphrase)
+}
+
+func (b *Backend) sign(address common.Address, data hexutil.Bytes, passphrase *string) (hexutil.Bytes, error) {
+	if b.externalSigner != nil {
+		return b.externalSigner.SignText(b.ctx, address, data)
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	signer, err := b.signer(address, passphrase)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		return nil, err
 	}
 
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data)
+	// Sign the EIP-191 hash of the data with the wallet, as the external signer does
+	signature, _, err := signer.SignByAddress(from, accounts.TextHash(data))
 	if err != nil {
 		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
@@ -145,12 +207,15 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 
 // SignTypedData signs EIP-712 conformant typed data
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if b.externalSigner != nil {
+		return b.externalSigner.SignTypedData(b.ctx, address, typedData)
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
+	signer, err := b.signer(address, nil)
 	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		return nil, err
 	}
 
 	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
@@ -159,7 +224,7 @@ func (b *Backend) SignTypedData(address common.Address, typedData apitypes.Typed
 	}
 
 	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, sigHash)
+	signature, _, err := signer.SignByAddress(from, sigHash)
 	if err != nil {
 		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
@@ -0,0 +1,33 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+)
+
+// StateTrie defines the node-local Ethereum Merkle-Patricia trie of the EVM
+// state, used to return Ethereum-compatible state roots and proofs.
+type StateTrie interface {
+	// StateRoot returns the root of the trie after the execution of the block
+	// at the given height.
+	StateRoot(height int64) (common.Hash, error)
+	// GetProof returns the account and storage values of the given address with
+	// their Merkle proofs against the state root of the given height.
+	GetProof(height int64, address common.Address, storageKeys []string) (*rpctypes.AccountResult, error)
+}
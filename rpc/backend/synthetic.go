@@ -0,0 +1,216 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	"github.com/evmos/evmos/v12/types"
+)
+
+// SyntheticLogs returns the synthetic ERC-20 Transfer logs that represent the
+// balance changes of the Cosmos transactions of a block. It returns nil if the
+// synthetic logs are disabled.
+func (b *Backend) SyntheticLogs(blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, error) {
+	if !b.cfg.JSONRPC.SyntheticLogs || b.syntheticTokens == nil {
+		return nil, nil
+	}
+
+	return b.syntheticBlockLogs(blockRes)
+}
+
+// syntheticBlockLogs returns the synthetic logs of all the Cosmos transactions of a block.
+func (b *Backend) syntheticBlockLogs(blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, error) {
+	tokens, err := b.syntheticTokens.Tokens(blockRes.Height)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query synthetic tokens")
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(blockRes.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.Errorf("block not found for height %d", blockRes.Height)
+	}
+
+	return rpctypes.SyntheticLogsFromBlock(resBlock.Block, blockRes.TxsResults, tokens), nil
+}
+
+// SyntheticTxLogs returns the synthetic logs of the synthetic transaction of the given tx result.
+func (b *Backend) SyntheticTxLogs(res *types.TxResult) ([]*ethtypes.Log, error) {
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		return nil, err
+	}
+	return b.syntheticTxLogs(res, blockRes)
+}
+
+// syntheticTxLogs returns the synthetic logs of a single synthetic transaction.
+func (b *Backend) syntheticTxLogs(res *types.TxResult, blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, error) {
+	if b.syntheticTokens == nil {
+		return nil, errors.New("synthetic transactions are disabled")
+	}
+
+	blockLogs, err := b.syntheticBlockLogs(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, log := range blockLogs {
+		if log.TxIndex == uint(res.TxIndex) {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+// getSyntheticTransaction returns the synthetic transaction identified by the
+// hash of the Cosmos transaction it represents. Synthetic transactions are sent
+// by the fee payer of the Cosmos transaction to the emitter of its first synthetic
+// log, have a zero signature and are flagged as synthetic.
+func (b *Backend) getSyntheticTransaction(hash common.Hash, res *types.TxResult) (*rpctypes.RPCTransaction, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	logs, err := b.syntheticTxLogs(res, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := b.syntheticTxSender(resBlock, res)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	blockNumber := hexutil.Big(*big.NewInt(res.Height))
+	txIndex := hexutil.Uint64(res.TxIndex)
+	rpcTx := &rpctypes.RPCTransaction{
+		BlockHash:        &blockHash,
+		BlockNumber:      &blockNumber,
+		From:             from,
+		Gas:              hexutil.Uint64(res.GasUsed),
+		GasPrice:         (*hexutil.Big)(new(big.Int)),
+		Hash:             hash,
+		Input:            hexutil.Bytes{},
+		TransactionIndex: &txIndex,
+		Value:            (*hexutil.Big)(new(big.Int)),
+		Type:             hexutil.Uint64(ethtypes.LegacyTxType),
+		ChainID:          (*hexutil.Big)(b.chainID),
+		V:                (*hexutil.Big)(new(big.Int)),
+		R:                (*hexutil.Big)(new(big.Int)),
+		S:                (*hexutil.Big)(new(big.Int)),
+		Synthetic:        true,
+	}
+
+	if len(logs) > 0 {
+		rpcTx.To = &logs[0].Address
+	}
+
+	return rpcTx, nil
+}
+
+// getSyntheticReceipt returns the receipt of a synthetic transaction.
+func (b *Backend) getSyntheticReceipt(hash common.Hash, res *types.TxResult) (map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		b.logger.Debug("block not found", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	logs, err := b.syntheticTxLogs(res, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := b.syntheticTxSender(resBlock, res)
+	if err != nil {
+		return nil, err
+	}
+
+	cumulativeGasUsed := uint64(0)
+	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
+		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+	}
+	cumulativeGasUsed += res.CumulativeGasUsed
+
+	receipt := map[string]interface{}{
+		"status":            hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		"logs":              logs,
+
+		"transactionHash": hash,
+		"contractAddress": nil,
+		"gasUsed":         hexutil.Uint64(res.GasUsed),
+
+		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockNumber":      hexutil.Uint64(res.Height),
+		"transactionIndex": hexutil.Uint64(res.TxIndex),
+
+		"from": from,
+		"to":   nil,
+		"type": hexutil.Uint(ethtypes.LegacyTxType),
+
+		// flag the receipt, as the transaction is not an ethereum transaction
+		"synthetic": true,
+	}
+
+	if len(logs) > 0 {
+		receipt["to"] = logs[0].Address
+	}
+
+	return receipt, nil
+}
+
+// syntheticTxSender returns the fee payer of the Cosmos transaction represented
+// by a synthetic transaction.
+func (b *Backend) syntheticTxSender(resBlock *tmrpctypes.ResultBlock, res *types.TxResult) (common.Address, error) {
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to decode tx")
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return common.Address{}, nil
+	}
+	return common.BytesToAddress(feeTx.FeePayer()), nil
+}
@@ -131,6 +131,9 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
+	// decode the calls and errors of the contracts with a registered ABI
+	b.decodeTrace(decodedResult, ethMessage)
+
 	return decodedResult, nil
 }
 
@@ -199,3 +202,73 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// GasProfile aggregates the gas used per contract, function selector and opcode
+// by the Ethereum transactions of the block range, tracing every block with the
+// gas profiler. The range is capped by the JSON-RPC block range cap.
+func (b *Backend) GasProfile(fromBlock, toBlock rpctypes.BlockNumber) (*evmtypes.GasProfile, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(fromBlock), int64(toBlock)
+	if fromBlock < rpctypes.EthEarliestBlockNumber {
+		from = int64(latest)
+	}
+	if toBlock < rpctypes.EthEarliestBlockNumber {
+		to = int64(latest)
+	}
+
+	// the genesis is not traceable
+	if from < 1 {
+		from = 1
+	}
+
+	if to < from {
+		return nil, fmt.Errorf("invalid block range: from block %d is after to block %d", from, to)
+	}
+
+	if blockRangeCap := int64(b.RPCBlockRangeCap()); to-from > blockRangeCap {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRangeCap)
+	}
+
+	profile := evmtypes.NewGasProfile()
+	config := &evmtypes.TraceConfig{Tracer: evmtypes.TracerGasProfile}
+
+	for height := from; height <= to; height++ {
+		resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+
+		if resBlock == nil || resBlock.Block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+
+		results, err := b.TraceBlock(rpctypes.BlockNumber(height), config, resBlock)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to trace block %d", height)
+		}
+
+		for _, result := range results {
+			if result == nil || result.Error != "" {
+				continue
+			}
+
+			bz, err := json.Marshal(result.Result)
+			if err != nil {
+				return nil, err
+			}
+
+			txProfile := evmtypes.NewGasProfile()
+			if err := json.Unmarshal(bz, txProfile); err != nil {
+				return nil, errors.Wrapf(err, "invalid gas profile in block %d", height)
+			}
+
+			profile.Merge(txProfile)
+		}
+	}
+
+	return profile, nil
+}
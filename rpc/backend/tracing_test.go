@@ -9,12 +9,14 @@ import (
 	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v12/indexer"
 	"github.com/evmos/evmos/v12/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/metadata"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -252,3 +254,78 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGasProfile() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		fromBlock    rpctypes.BlockNumber
+		toBlock      rpctypes.BlockNumber
+		expPass      bool
+	}{
+		{
+			"fail - from block after to block",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+			},
+			3,
+			2,
+			false,
+		},
+		{
+			"fail - block range exceeds the cap",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+			},
+			1,
+			rpctypes.BlockNumber(suite.backend.RPCBlockRangeCap() + 2),
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlockError(client, 1)
+			},
+			rpctypes.EthEarliestBlockNumber,
+			rpctypes.EthLatestBlockNumber,
+			false,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+			},
+			rpctypes.EthEarliestBlockNumber,
+			rpctypes.EthLatestBlockNumber,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			profile, err := suite.backend.GasProfile(tc.fromBlock, tc.toBlock)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(evmtypes.NewGasProfile(), profile)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
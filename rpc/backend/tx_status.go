@@ -0,0 +1,258 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// trackTx records the result of broadcasting the given transaction to the mempool.
+func (b *Backend) trackTx(txHash common.Hash, txBytes []byte, rsp *sdk.TxResponse, err error) {
+	transition := rpctypes.TxStatusTransition{Status: rpctypes.TxStatusPending}
+	switch {
+	case rsp != nil && rsp.Code != 0:
+		transition.Status = rpctypes.TxStatusRejected
+		transition.Codespace = rsp.Codespace
+		transition.Code = rsp.Code
+		transition.Error = rsp.RawLog
+	case err != nil:
+		transition.Status = rpctypes.TxStatusRejected
+		transition.Error = err.Error()
+	}
+
+	b.txStatus.Track(txHash, tmtypes.Tx(txBytes).Hash(), txBytes, transition)
+}
+
+// GetTransactionStatus returns the lifecycle status of a transaction submitted
+// through this node. It returns nil if the transaction is not tracked.
+func (b *Backend) GetTransactionStatus(hash common.Hash) (*rpctypes.TrackedTx, error) {
+	tracked, found := b.txStatus.Get(hash)
+	if !found {
+		return nil, nil
+	}
+	return &tracked, nil
+}
+
+// RefreshTxStatuses checks whether the pending (or dropped) transactions have
+// been included in a block or have left the mempool, and records the
+// transitions. The JSON-RPC server calls it every second, whatever the enabled
+// namespaces, so that the transitions are recorded shortly after they happen
+// rather than when they are queried.
+func (b *Backend) RefreshTxStatuses() error {
+	hashes := b.txStatus.Pending()
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	mempool, complete, err := b.mempoolTxHashes()
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		tracked, found := b.txStatus.Get(hash)
+		if !found {
+			continue
+		}
+
+		if err := b.refreshTxStatus(tracked, mempool, complete); err != nil {
+			b.logger.Debug("failed to refresh tx status", "hash", hash.Hex(), "error", err.Error())
+		}
+	}
+	return nil
+}
+
+// mempoolTxHashes returns the set of the cosmos hashes of the transactions in
+// the mempool. The set is not complete if the mempool contains more
+// transactions than the max number returned by Tendermint.
+func (b *Backend) mempoolTxHashes() (map[string]bool, bool, error) {
+	num, err := b.clientCtx.Client.NumUnconfirmedTxs(b.ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hashes := make(map[string]bool, num.Total)
+	if num.Total == 0 {
+		return hashes, true, nil
+	}
+
+	limit := num.Total
+	res, err := b.clientCtx.Client.UnconfirmedTxs(b.ctx, &limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, tx := range res.Txs {
+		hashes[string(tx.Hash())] = true
+	}
+	return hashes, len(res.Txs) >= res.Total, nil
+}
+
+// refreshTxStatus checks whether a pending (or dropped) transaction has been
+// included in a block or has left the mempool, and records the transition.
+func (b *Backend) refreshTxStatus(tracked rpctypes.TrackedTx, mempool map[string]bool, complete bool) error {
+	if transition, found := b.txStatusFromBlock(tracked.Hash); found {
+		b.txStatus.Update(tracked.Hash, transition)
+		return nil
+	}
+
+	if tracked.Status == rpctypes.TxStatusDropped {
+		// the tx can still be included if it was re-gossiped by another peer
+		return nil
+	}
+
+	if mempool[string(tracked.CosmosHash)] {
+		return nil
+	}
+
+	transition := rpctypes.TxStatusTransition{
+		Status: rpctypes.TxStatusDropped,
+		Error:  "transaction evicted from the mempool",
+	}
+
+	if !complete {
+		// the tx might be in the part of the mempool that wasn't returned, so
+		// it's only dropped if its nonce has been used by another tx
+		used, err := b.txNonceUsed(tracked.TxBytes())
+		if err != nil || !used {
+			return err
+		}
+		transition.Error = "transaction nonce used by another transaction"
+	}
+
+	// the tx might have been committed while querying the mempool
+	if transition, found := b.txStatusFromBlock(tracked.Hash); found {
+		b.txStatus.Update(tracked.Hash, transition)
+		return nil
+	}
+
+	if complete {
+		// re-run CheckTx to find out whether the tx was removed on recheck
+		checkRes, err := b.clientCtx.Client.CheckTx(b.ctx, tracked.TxBytes())
+		switch {
+		case err != nil:
+			b.logger.Debug("failed to re-check dropped tx", "hash", tracked.Hash.Hex(), "error", err.Error())
+		case checkRes.Code != 0:
+			transition.Codespace = checkRes.Codespace
+			transition.Code = checkRes.Code
+			transition.Error = checkRes.Log
+		}
+	}
+
+	b.txStatus.Update(tracked.Hash, transition)
+	return nil
+}
+
+// txNonceUsed returns true if the committed nonce of the sender of the given
+// Ethereum transaction is greater than the transaction nonce.
+func (b *Backend) txNonceUsed(txBytes []byte) (bool, error) {
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return false, err
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return false, fmt.Errorf("invalid number of messages %d", len(msgs))
+	}
+
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return false, fmt.Errorf("invalid message type %T", msgs[0])
+	}
+
+	nonce, err := b.getAccountNonce(common.HexToAddress(ethMsg.From), false, 0, b.logger)
+	if err != nil {
+		return false, err
+	}
+	return nonce > ethMsg.AsTransaction().Nonce(), nil
+}
+
+// txStatusFromBlock returns the final status transition of a transaction if it
+// has been included in a block.
+func (b *Backend) txStatusFromBlock(hash common.Hash) (rpctypes.TxStatusTransition, bool) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil || res == nil {
+		return rpctypes.TxStatusTransition{}, false
+	}
+
+	height := hexutil.Uint64(res.Height) //#nosec G701 -- block heights are positive
+	transition := rpctypes.TxStatusTransition{
+		Status: rpctypes.TxStatusIncluded,
+		Height: &height,
+	}
+
+	// the tx is included at the time of its block
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err == nil && resBlock != nil && resBlock.Block != nil {
+		transition.Time = resBlock.Block.Time.UTC()
+	}
+
+	if !res.Failed {
+		return transition, true
+	}
+
+	transition.Status = rpctypes.TxStatusReverted
+
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil || blockRes == nil || int(res.TxIndex) >= len(blockRes.TxsResults) {
+		return transition, true
+	}
+
+	txRes := blockRes.TxsResults[res.TxIndex]
+	if txRes.Code != 0 {
+		transition.Status = rpctypes.TxStatusFailed
+		transition.Codespace = txRes.Codespace
+		transition.Code = txRes.Code
+		transition.Error = txRes.Log
+		return transition, true
+	}
+
+	// find the vm error of the ethereum tx within the cosmos tx events
+	for _, event := range txRes.Events {
+		if event.Type != evmtypes.EventTypeEthereumTx {
+			continue
+		}
+
+		var (
+			txHash string
+			vmErr  string
+		)
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case evmtypes.AttributeKeyEthereumTxHash:
+				txHash = string(attr.Value)
+			case evmtypes.AttributeKeyEthereumTxFailed:
+				vmErr = string(attr.Value)
+			}
+		}
+
+		if txHash == hash.Hex() && vmErr != "" {
+			transition.Error = vmErr
+			break
+		}
+	}
+
+	return transition, true
+}
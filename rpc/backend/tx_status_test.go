@@ -0,0 +1,184 @@
+package backend
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/evmos/evmos/v12/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+)
+
+func (suite *BackendTestSuite) TestGetTransactionStatus() {
+	msgEthereumTx, bz := suite.buildEthereumTx()
+	hash := common.HexToHash(msgEthereumTx.Hash)
+	one, mempoolSize := 1, 101
+
+	registerSender := func(client *mocks.Client, sequence uint64) {
+		sender := sdk.AccAddress(common.HexToAddress(msgEthereumTx.From).Bytes())
+		req, err := (&authtypes.QueryAccountRequest{Address: sender.String()}).Marshal()
+		suite.Require().NoError(err)
+		acc := authtypes.NewBaseAccount(sender, nil, 0, sequence)
+		RegisterABCIQueryAccount(client, req, tmrpcclient.ABCIQueryOptions{Height: 1, Prove: false}, acc)
+	}
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		expRefreshErr  bool
+		expTracked     bool
+		expStatus      rpctypes.TxStatus
+		expCode        uint32
+		expTransitions int
+	}{
+		{
+			"not tracked",
+			func() {},
+			false,
+			false,
+			"",
+			0,
+			0,
+		},
+		{
+			"rejected on CheckTx",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{Code: 5, Codespace: "sdk", RawLog: "insufficient funds"}, nil)
+			},
+			false,
+			true,
+			rpctypes.TxStatusRejected,
+			5,
+			1,
+		},
+		{
+			"rejected on broadcast",
+			func() {
+				suite.backend.trackTx(hash, bz, nil, errortypes.ErrInvalidRequest)
+			},
+			false,
+			true,
+			rpctypes.TxStatusRejected,
+			0,
+			1,
+		},
+		{
+			"pending in the mempool",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{}, nil)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxs(client, 1)
+				RegisterUnconfirmedTxs(client, &one, []types.Tx{bz})
+			},
+			false,
+			true,
+			rpctypes.TxStatusPending,
+			0,
+			1,
+		},
+		{
+			"pending - mempool query fails",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{}, nil)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxsError(client)
+			},
+			true,
+			true,
+			rpctypes.TxStatusPending,
+			0,
+			1,
+		},
+		{
+			"pending - not in the returned part of the mempool",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{}, nil)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxs(client, 101)
+				RegisterUnconfirmedTxsWithTotal(client, &mempoolSize, nil, 101)
+				registerSender(client, 0)
+			},
+			false,
+			true,
+			rpctypes.TxStatusPending,
+			0,
+			1,
+		},
+		{
+			"dropped - nonce used by another tx",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{}, nil)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxs(client, 101)
+				RegisterUnconfirmedTxsWithTotal(client, &mempoolSize, nil, 101)
+				registerSender(client, 1)
+			},
+			false,
+			true,
+			rpctypes.TxStatusDropped,
+			0,
+			2,
+		},
+		{
+			"dropped - recheck failed",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{}, nil)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxs(client, 0)
+				RegisterCheckTx(client, bz, 32, "invalid nonce")
+			},
+			false,
+			true,
+			rpctypes.TxStatusDropped,
+			32,
+			2,
+		},
+		{
+			"dropped - evicted",
+			func() {
+				suite.backend.trackTx(hash, bz, &sdk.TxResponse{}, nil)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxs(client, 1)
+				RegisterUnconfirmedTxs(client, &one, []types.Tx{{1}})
+				RegisterCheckTx(client, bz, 0, "")
+			},
+			false,
+			true,
+			rpctypes.TxStatusDropped,
+			0,
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			var err error
+			suite.backend.txStatus, err = rpctypes.NewTxStatusStore(10)
+			suite.Require().NoError(err)
+			tc.registerMock()
+			err = suite.backend.RefreshTxStatuses()
+			if tc.expRefreshErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.backend.GetTransactionStatus(hash)
+			suite.Require().NoError(err)
+			if !tc.expTracked {
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NotNil(res)
+			suite.Require().Equal(hash, res.Hash)
+			suite.Require().Equal(tc.expStatus, res.Status)
+			suite.Require().Len(res.Transitions, tc.expTransitions)
+			suite.Require().Equal(tc.expCode, res.Transitions[len(res.Transitions)-1].Code)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package abi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/evmos/v12/rpc/backend"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// RegisterArgs defines the arguments of abi_register. Exactly one of the
+// contract address and the code hash must be set.
+type RegisterArgs struct {
+	Address  *common.Address `json:"address"`
+	CodeHash *common.Hash    `json:"codeHash"`
+	// ABI is the JSON ABI, either as a JSON array or as a string
+	ABI      json.RawMessage `json:"abi"`
+	Metadata string          `json:"metadata"`
+}
+
+// PrivateAPI is the abi_ prefixed set of APIs to manage the node-local
+// contract ABI registry and decode contract calls.
+type PrivateAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates an instance of the ABI registry API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *PrivateAPI {
+	return &PrivateAPI{
+		logger:  logger.With("api", "abi"),
+		backend: backend,
+	}
+}
+
+// Register registers the ABI and metadata of a contract address or of a code
+// hash in the node-local registry, replacing the previous registration.
+func (api *PrivateAPI) Register(args RegisterArgs) (bool, error) {
+	api.logger.Debug("abi_register")
+
+	if (args.Address == nil) == (args.CodeHash == nil) {
+		return false, errors.New("either the contract address or the code hash must be set")
+	}
+
+	abiJSON := string(args.ABI)
+	if err := json.Unmarshal(args.ABI, &abiJSON); err != nil {
+		// not a JSON string
+		abiJSON = string(args.ABI)
+	}
+
+	entry := evmtypes.ContractABI{
+		ABI:      abiJSON,
+		Metadata: args.Metadata,
+	}
+	if args.Address != nil {
+		entry.Address = args.Address.Hex()
+	} else {
+		entry.CodeHash = args.CodeHash.Hex()
+	}
+
+	if err := api.backend.RegisterContractABI(entry); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetABI returns the ABI registered for a contract in the node-local registry,
+// for the contract address or its code hash, or else on chain. It returns nil
+// if no ABI is registered for the contract.
+func (api *PrivateAPI) GetABI(address common.Address) (*evmtypes.ContractABI, error) {
+	api.logger.Debug("abi_getABI", "address", address.Hex())
+	return api.backend.GetContractABI(address)
+}
+
+// DecodeCall decodes the input of a call to a contract with its registered ABI.
+func (api *PrivateAPI) DecodeCall(address common.Address, input hexutil.Bytes) (*evmtypes.DecodedCall, error) {
+	api.logger.Debug("abi_decodeCall", "address", address.Hex())
+
+	contractABI, err := api.parsedABI(address)
+	if err != nil {
+		return nil, err
+	}
+	return evmtypes.DecodeCall(contractABI, input, nil)
+}
+
+// DecodeError decodes the revert data of a call to a contract with its
+// registered ABI.
+func (api *PrivateAPI) DecodeError(address common.Address, data hexutil.Bytes) (*evmtypes.DecodedError, error) {
+	api.logger.Debug("abi_decodeError", "address", address.Hex())
+
+	contractABI, err := api.parsedABI(address)
+	if err != nil {
+		return nil, err
+	}
+	return evmtypes.DecodeError(contractABI, data)
+}
+
+// parsedABI returns the parsed ABI registered for a contract.
+func (api *PrivateAPI) parsedABI(address common.Address) (gethabi.ABI, error) {
+	entry, err := api.backend.GetContractABI(address)
+	if err != nil {
+		return gethabi.ABI{}, err
+	}
+	if entry == nil {
+		return gethabi.ABI{}, fmt.Errorf("no ABI registered for contract %s", address.Hex())
+	}
+	return evmtypes.ParseABI(entry.ABI)
+}
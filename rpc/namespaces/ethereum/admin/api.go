@@ -0,0 +1,304 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// protocolName is the name of the protocol reported in the node and peer infos.
+const protocolName = "tendermint"
+
+// ErrPeerManagementNotSupported is returned when the Tendermint client of the node
+// doesn't support the management of peers.
+var ErrPeerManagementNotSupported = errors.New("peer management is not supported by the node's client")
+
+// PeerDialer is implemented by the Tendermint clients that expose the unsafe
+// dial_peers method, i.e. the local client and the HTTP client of nodes with
+// the unsafe RPC methods enabled.
+type PeerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// PeerRemover is implemented by the Tendermint clients that are able to disconnect
+// from a peer, which is not supported by the Tendermint RPC. It returns false if
+// the node is not connected to the peer.
+type PeerRemover interface {
+	RemovePeer(ctx context.Context, id p2p.ID) (bool, error)
+}
+
+// NodeInfo is the information of the node returned by admin_nodeInfo. Tendermint
+// node IDs are derived from ed25519 keys, so the node has a Tendermint address
+// (<node-id>@<host>:<port>) instead of an enode URL.
+type NodeInfo struct {
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Address    string                 `json:"address"`
+	IP         string                 `json:"ip"`
+	Ports      Ports                  `json:"ports"`
+	ListenAddr string                 `json:"listenAddr"`
+	Protocols  map[string]interface{} `json:"protocols"`
+}
+
+// Ports defines the network ports of a node. Tendermint uses the same port for
+// the discovery of peers (PEX) and the p2p connections.
+type Ports struct {
+	Discovery int `json:"discovery"`
+	Listener  int `json:"listener"`
+}
+
+// ProtocolInfo is the Tendermint protocol information of the node.
+type ProtocolInfo struct {
+	Network           string `json:"network"`
+	Version           string `json:"version"`
+	P2PVersion        uint64 `json:"p2pVersion"`
+	BlockVersion      uint64 `json:"blockVersion"`
+	AppVersion        uint64 `json:"appVersion"`
+	LatestBlockHeight int64  `json:"latestBlockHeight"`
+	LatestBlockHash   string `json:"latestBlockHash"`
+	CatchingUp        bool   `json:"catchingUp"`
+	ValidatorAddress  string `json:"validatorAddress,omitempty"`
+}
+
+// PeerInfo is the information of a connected peer returned by admin_peers.
+type PeerInfo struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Address   string                 `json:"address"`
+	Caps      []string               `json:"caps"`
+	Network   PeerNetworkInfo        `json:"network"`
+	Protocols map[string]interface{} `json:"protocols"`
+}
+
+// PeerNetworkInfo is the network information of a connected peer.
+type PeerNetworkInfo struct {
+	RemoteAddress string `json:"remoteAddress"`
+	ListenAddr    string `json:"listenAddr"`
+	Inbound       bool   `json:"inbound"`
+}
+
+// PeerProtocolInfo is the Tendermint protocol information of a connected peer.
+type PeerProtocolInfo struct {
+	Network string `json:"network"`
+	Version string `json:"version"`
+}
+
+// API is the private admin prefixed set of APIs in the Admin JSON-RPC spec. The
+// peers are Tendermint peers, referred to by their Tendermint address
+// (<node-id>@<host>:<port>) instead of their enode URL.
+type API struct {
+	ctx      *server.Context
+	logger   log.Logger
+	tmClient rpcclient.Client
+}
+
+// NewAPI creates an instance of the Admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context) *API {
+	return &API{
+		ctx:      ctx,
+		logger:   ctx.Logger.With("api", "admin"),
+		tmClient: clientCtx.Client,
+	}
+}
+
+// NodeInfo returns the information of the node and its Tendermint protocol.
+func (a *API) NodeInfo() (*NodeInfo, error) {
+	a.logger.Debug("admin_nodeInfo")
+
+	status, err := a.tmClient.Status(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := status.NodeInfo
+	host, port := splitListenAddr(nodeInfo.ListenAddr)
+
+	protocol := ProtocolInfo{
+		Network:           nodeInfo.Network,
+		Version:           nodeInfo.Version,
+		P2PVersion:        nodeInfo.ProtocolVersion.P2P,
+		BlockVersion:      nodeInfo.ProtocolVersion.Block,
+		AppVersion:        nodeInfo.ProtocolVersion.App,
+		LatestBlockHeight: status.SyncInfo.LatestBlockHeight,
+		LatestBlockHash:   status.SyncInfo.LatestBlockHash.String(),
+		CatchingUp:        status.SyncInfo.CatchingUp,
+	}
+	if status.ValidatorInfo.VotingPower > 0 {
+		protocol.ValidatorAddress = status.ValidatorInfo.Address.String()
+	}
+
+	return &NodeInfo{
+		ID:         string(nodeInfo.DefaultNodeID),
+		Name:       nodeInfo.Moniker,
+		Address:    p2p.IDAddressString(nodeInfo.DefaultNodeID, net.JoinHostPort(host, strconv.Itoa(port))),
+		IP:         host,
+		Ports:      Ports{Discovery: port, Listener: port},
+		ListenAddr: nodeInfo.ListenAddr,
+		Protocols:  map[string]interface{}{protocolName: protocol},
+	}, nil
+}
+
+// Peers returns the information of the connected peers.
+func (a *API) Peers() ([]*PeerInfo, error) {
+	a.logger.Debug("admin_peers")
+
+	netInfo, err := a.tmClient.NetInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, newPeerInfo(peer))
+	}
+	return peers, nil
+}
+
+// AddPeer dials the given peer. The peer isn't added to the persistent peers of
+// the node, so it's not redialed if the connection is lost.
+func (a *API) AddPeer(url string) (bool, error) {
+	a.logger.Debug("admin_addPeer", "url", url)
+	return a.dialPeer(url, false)
+}
+
+// AddTrustedPeer adds the given peer to the unconditional peers of the node and
+// dials it. Unconditional peers are accepted even if the maximum number of peers
+// is reached.
+func (a *API) AddTrustedPeer(url string) (bool, error) {
+	a.logger.Debug("admin_addTrustedPeer", "url", url)
+	return a.dialPeer(url, true)
+}
+
+// RemovePeer disconnects from the given peer, which is either a Tendermint address
+// or a node ID. It returns false if the node is not connected to the peer.
+func (a *API) RemovePeer(url string) (bool, error) {
+	a.logger.Debug("admin_removePeer", "url", url)
+
+	remover, ok := a.tmClient.(PeerRemover)
+	if !ok {
+		return false, ErrPeerManagementNotSupported
+	}
+
+	id, err := parsePeerID(url)
+	if err != nil {
+		return false, err
+	}
+	return remover.RemovePeer(context.Background(), id)
+}
+
+// Datadir returns the home directory of the node.
+func (a *API) Datadir() string {
+	a.logger.Debug("admin_datadir")
+	return a.ctx.Config.RootDir
+}
+
+func (a *API) dialPeer(url string, unconditional bool) (bool, error) {
+	dialer, ok := a.tmClient.(PeerDialer)
+	if !ok {
+		return false, ErrPeerManagementNotSupported
+	}
+
+	if _, err := p2p.NewNetAddressString(url); err != nil {
+		return false, fmt.Errorf("invalid peer address %s: %w", url, err)
+	}
+
+	// the peers are not dialed as persistent peers, as Tendermint replaces the
+	// configured persistent peers with the dialed ones and can't remove them
+	if _, err := dialer.DialPeers(context.Background(), []string{url}, false, unconditional, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// newPeerInfo converts a Tendermint peer into a PeerInfo.
+func newPeerInfo(peer coretypes.Peer) *PeerInfo {
+	nodeInfo := peer.NodeInfo
+	_, port := splitListenAddr(nodeInfo.ListenAddr)
+
+	caps := make([]string, 0, len(nodeInfo.Channels))
+	for _, ch := range nodeInfo.Channels {
+		caps = append(caps, fmt.Sprintf("%s/%#x", protocolName, ch))
+	}
+
+	return &PeerInfo{
+		ID:      string(nodeInfo.DefaultNodeID),
+		Name:    nodeInfo.Moniker,
+		Address: p2p.IDAddressString(nodeInfo.DefaultNodeID, net.JoinHostPort(peer.RemoteIP, strconv.Itoa(port))),
+		Caps:    caps,
+		Network: PeerNetworkInfo{
+			RemoteAddress: peer.RemoteIP,
+			ListenAddr:    nodeInfo.ListenAddr,
+			Inbound:       !peer.IsOutbound,
+		},
+		Protocols: map[string]interface{}{
+			protocolName: PeerProtocolInfo{
+				Network: nodeInfo.Network,
+				Version: nodeInfo.Version,
+			},
+		},
+	}
+}
+
+// splitListenAddr returns the host and port of a Tendermint listen address
+// (eg: tcp://0.0.0.0:26656). The port is 0 if the address is invalid.
+func splitListenAddr(listenAddr string) (string, int) {
+	if i := strings.Index(listenAddr, "://"); i >= 0 {
+		listenAddr = listenAddr[i+3:]
+	}
+
+	host, portStr, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return listenAddr, 0
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, 0
+	}
+	return host, port
+}
+
+// parsePeerID returns the node ID of a Tendermint address or node ID.
+func parsePeerID(url string) (p2p.ID, error) {
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+	}
+	if i := strings.Index(url, "@"); i >= 0 {
+		url = url[:i]
+	}
+
+	idBytes, err := hex.DecodeString(url)
+	if err != nil {
+		return "", fmt.Errorf("invalid peer id %s: %w", url, err)
+	}
+	if len(idBytes) != p2p.IDByteLength {
+		return "", fmt.Errorf("invalid peer id %s: expected %d bytes, got %d", url, p2p.IDByteLength, len(idBytes))
+	}
+	return p2p.ID(url), nil
+}
@@ -0,0 +1,156 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/evmos/evmos/v12/rpc/backend/mocks"
+)
+
+const (
+	peerID   = "f4c5c9f5bd7b8d7d4a0a2c8d5f8a3c1e9b7d6a4e"
+	peerAddr = peerID + "@127.0.0.1:26656"
+)
+
+// peerManagerClient is a Tendermint client mock that supports the management of peers.
+type peerManagerClient struct {
+	*mocks.Client
+	dialed        []string
+	unconditional []string
+	removed       []p2p.ID
+}
+
+func (c *peerManagerClient) DialPeers(_ context.Context, peers []string, persistent, unconditional, _ bool) (*coretypes.ResultDialPeers, error) {
+	if persistent {
+		return nil, errors.New("peers must not be persistent")
+	}
+	c.dialed = append(c.dialed, peers...)
+	if unconditional {
+		c.unconditional = append(c.unconditional, peers...)
+	}
+	return &coretypes.ResultDialPeers{}, nil
+}
+
+func (c *peerManagerClient) RemovePeer(_ context.Context, id p2p.ID) (bool, error) {
+	c.removed = append(c.removed, id)
+	return id == peerID, nil
+}
+
+func newAPI(tmClient *mocks.Client, peerManagement bool) (*API, *peerManagerClient) {
+	ctx := server.NewDefaultContext()
+	ctx.Config.RootDir = "/home/evmos"
+
+	if !peerManagement {
+		return NewAPI(ctx, client.Context{}.WithClient(tmClient)), nil
+	}
+
+	pmClient := &peerManagerClient{Client: tmClient}
+	return NewAPI(ctx, client.Context{}.WithClient(pmClient)), pmClient
+}
+
+func TestNodeInfo(t *testing.T) {
+	tmClient := mocks.NewClient(t)
+	api, _ := newAPI(tmClient, false)
+
+	tmClient.On("Status", mock.Anything).Return(&coretypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{
+			DefaultNodeID: peerID,
+			ListenAddr:    "tcp://0.0.0.0:26656",
+			Network:       "evmos_9000-1",
+			Version:       "0.34.29",
+			Moniker:       "node0",
+		},
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 10},
+	}, nil)
+
+	info, err := api.NodeInfo()
+	require.NoError(t, err)
+	require.Equal(t, peerID, info.ID)
+	require.Equal(t, "node0", info.Name)
+	require.Equal(t, peerID+"@0.0.0.0:26656", info.Address)
+	require.Equal(t, "0.0.0.0", info.IP)
+	require.Equal(t, Ports{Discovery: 26656, Listener: 26656}, info.Ports)
+
+	protocol, ok := info.Protocols[protocolName].(ProtocolInfo)
+	require.True(t, ok)
+	require.Equal(t, "evmos_9000-1", protocol.Network)
+	require.Equal(t, int64(10), protocol.LatestBlockHeight)
+	require.Empty(t, protocol.ValidatorAddress)
+}
+
+func TestPeers(t *testing.T) {
+	tmClient := mocks.NewClient(t)
+	api, _ := newAPI(tmClient, false)
+
+	tmClient.On("NetInfo", mock.Anything).Return(&coretypes.ResultNetInfo{
+		Peers: []coretypes.Peer{
+			{
+				NodeInfo: p2p.DefaultNodeInfo{
+					DefaultNodeID: peerID,
+					ListenAddr:    "tcp://0.0.0.0:26656",
+					Moniker:       "node1",
+					Channels:      []byte{0x20},
+				},
+				IsOutbound: true,
+				RemoteIP:   "10.0.0.1",
+			},
+		},
+	}, nil)
+
+	peers, err := api.Peers()
+	require.NoError(t, err)
+	require.Len(t, peers, 1)
+	require.Equal(t, peerID, peers[0].ID)
+	require.Equal(t, "node1", peers[0].Name)
+	require.Equal(t, peerID+"@10.0.0.1:26656", peers[0].Address)
+	require.Equal(t, []string{"tendermint/0x20"}, peers[0].Caps)
+	require.Equal(t, "10.0.0.1", peers[0].Network.RemoteAddress)
+	require.False(t, peers[0].Network.Inbound)
+}
+
+func TestManagePeers(t *testing.T) {
+	// client without peer management
+	api, _ := newAPI(mocks.NewClient(t), false)
+	_, err := api.AddPeer(peerAddr)
+	require.ErrorIs(t, err, ErrPeerManagementNotSupported)
+	_, err = api.RemovePeer(peerAddr)
+	require.ErrorIs(t, err, ErrPeerManagementNotSupported)
+
+	api, pmClient := newAPI(mocks.NewClient(t), true)
+
+	// add peers
+	_, err = api.AddPeer("enode://invalid")
+	require.Error(t, err)
+	ok, err := api.AddPeer(peerAddr)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = api.AddTrustedPeer("tcp://" + peerAddr)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{peerAddr, "tcp://" + peerAddr}, pmClient.dialed)
+	require.Equal(t, []string{"tcp://" + peerAddr}, pmClient.unconditional)
+
+	// remove peers by address or id
+	_, err = api.RemovePeer("invalid@127.0.0.1:26656")
+	require.Error(t, err)
+	ok, err = api.RemovePeer(peerAddr)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = api.RemovePeer("a4c5c9f5bd7b8d7d4a0a2c8d5f8a3c1e9b7d6a4e")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, []p2p.ID{peerID, "a4c5c9f5bd7b8d7d4a0a2c8d5f8a3c1e9b7d6a4e"}, pmClient.removed)
+}
+
+func TestDatadir(t *testing.T) {
+	api, _ := newAPI(mocks.NewClient(t), false)
+	require.Equal(t, "/home/evmos", api.Datadir())
+}
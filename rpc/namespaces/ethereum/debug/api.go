@@ -117,6 +117,13 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// GasProfile returns the gas used per contract, function selector and opcode by
+// the Ethereum transactions of the block range.
+func (a *API) GasProfile(fromBlock, toBlock rpctypes.BlockNumber) (*evmtypes.GasProfile, error) {
+	a.logger.Debug("debug_gasProfile", "from", fromBlock, "to", toBlock)
+	return a.backend.GasProfile(fromBlock, toBlock)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
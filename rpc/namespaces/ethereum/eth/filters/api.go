@@ -59,6 +59,7 @@ type Backend interface {
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	SyntheticLogs(blockRes *coretypes.ResultBlockResults) ([]*ethtypes.Log, error)
 
 	BloomStatus() (uint64, uint64)
 
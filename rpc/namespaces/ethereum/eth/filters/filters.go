@@ -196,20 +196,26 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 }
 
 // blockLogs returns the logs matching the filter criteria within a single block.
+// Synthetic logs are not part of the block bloom, so they are always checked.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
-	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
-		return []*ethtypes.Log{}, nil
-	}
+	unfiltered := make([]*ethtypes.Log, 0)
 
-	logsList, err := backend.GetLogsFromBlockResults(blockRes)
-	if err != nil {
-		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
+	if bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
+		logsList, err := backend.GetLogsFromBlockResults(blockRes)
+		if err != nil {
+			return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
+		}
+
+		for _, logs := range logsList {
+			unfiltered = append(unfiltered, logs...)
+		}
 	}
 
-	unfiltered := make([]*ethtypes.Log, 0)
-	for _, logs := range logsList {
-		unfiltered = append(unfiltered, logs...)
+	syntheticLogs, err := f.backend.SyntheticLogs(blockRes)
+	if err != nil {
+		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch synthetic logs block number %d", blockRes.Height)
 	}
+	unfiltered = append(unfiltered, syntheticLogs...)
 
 	logs := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
 	if len(logs) == 0 {
@@ -17,7 +17,9 @@ package personal
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"time"
 
@@ -39,6 +41,9 @@ import (
 	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 )
 
+// defaultUnlockDuration is the default duration an account is unlocked for.
+const defaultUnlockDuration = 300 * time.Second
+
 // PrivateAccountAPI is the personal_ prefixed set of APIs in the Web3 JSON-RPC spec.
 type PrivateAccountAPI struct {
 	backend    backend.EVMBackend
@@ -66,11 +71,10 @@ func NewAPI(
 	}
 }
 
-// ImportRawKey armors and encrypts a given raw hex encoded ECDSA key and stores it into the key directory.
-// The name of the key will have the format "personal_<length-keys>", where <length-keys> is the total number of
-// keys stored on the keyring.
-//
-// NOTE: The key will be both armored and encrypted using the same passphrase.
+// ImportRawKey encrypts a given raw hex encoded ECDSA key with the passphrase and stores it into the
+// encrypted keystore. If the keystore is not available, the key is stored on the keyring with a name that
+// has the format "personal_<length-keys>", where <length-keys> is the total number of keys stored on the keyring.
+// It fails if the key has already been imported.
 func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Address, error) {
 	api.logger.Debug("personal_importRawKey")
 	return api.backend.ImportRawKey(privkey, password)
@@ -84,17 +88,25 @@ func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
 // It removes the key corresponding to the given address from the API's local keys.
+//
+// NOTE: Only the accounts of the encrypted keystore can be locked.
 func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
 	api.logger.Debug("personal_lockAccount", "address", address.String())
-	api.logger.Info("personal_lockAccount not supported")
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false
+	return api.backend.LockAccount(address)
 }
 
 // NewAccount will create a new account and returns the address for the new account.
+// The key of the account is stored in the encrypted keystore, encrypted with the
+// given password. If the keystore is not available, a new mnemonic is stored on the
+// keyring instead.
 func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error) {
 	api.logger.Debug("personal_newAccount")
 
+	addr, err := api.backend.NewAccount(password)
+	if !errors.Is(err, backend.ErrKeystoreNotAvailable) {
+		return addr, err
+	}
+
 	name := "key_" + time.Now().UTC().Format(time.RFC3339)
 
 	// create the mnemonic and save the account
@@ -109,7 +121,7 @@ func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error
 	if err != nil {
 		return common.Address{}, err
 	}
-	addr := common.BytesToAddress(pubKey.Address().Bytes())
+	addr = common.BytesToAddress(pubKey.Address().Bytes())
 	api.logger.Info("Your new key was generated", "address", addr.String())
 	api.logger.Info("Please backup your key file!", "path", os.Getenv("HOME")+"/.evmos/"+name) // TODO: pass the correct binary
 	api.logger.Info("Please remember your password!")
@@ -119,18 +131,32 @@ func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error
 // UnlockAccount will unlock the account associated with the given address with
 // the given password for duration seconds. If duration is nil it will use a
 // default of 300 seconds. It returns an indication if the account was unlocked.
-func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, _ string, _ *uint64) (bool, error) {
+//
+// NOTE: Only the accounts of the encrypted keystore can be unlocked, the
+// accounts of the node's keyring are not protected by a password.
+func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, password string, duration *uint64) (bool, error) {
 	api.logger.Debug("personal_unlockAccount", "address", addr.String())
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false, nil
+
+	const maxDuration = uint64(math.MaxInt64 / int64(time.Second))
+	d := defaultUnlockDuration
+	if duration != nil {
+		if *duration > maxDuration {
+			return false, errors.New("unlock duration too large")
+		}
+		d = time.Duration(*duration) * time.Second //#nosec G701 -- checked for int overflow already
+	}
+
+	return api.backend.UnlockAccount(addr, password, d)
 }
 
 // SendTransaction will create a transaction from the given arguments and
-// tries to sign it with the key associated with args.To. If the given password isn't
+// tries to sign it with the key associated with args.From. If the given password isn't
 // able to decrypt the key it fails.
-func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.TransactionArgs, _ string) (common.Hash, error) {
-	api.logger.Debug("personal_sendTransaction", "address", args.To.String())
-	return api.backend.SendTransaction(args)
+//
+// NOTE: Only the accounts of the encrypted keystore can be used with a password.
+func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.TransactionArgs, password string) (common.Hash, error) {
+	api.logger.Debug("personal_sendTransaction", "address", args.GetFrom().String())
+	return api.backend.SendTransactionWithPassphrase(args, password)
 }
 
 // Sign calculates an Ethereum ECDSA signature for:
@@ -140,11 +166,12 @@ func (api *PrivateAccountAPI) SendTransaction(_ context.Context, args evmtypes.T
 // where the V value will be 27 or 28 for legacy reasons.
 //
 // The key used to calculate the signature is decrypted with the given password.
+// Only the accounts of the encrypted keystore can be used with a password.
 //
 // https://github.com/ethereum/go-ethereum/wiki/Management-APIs#personal_sign
-func (api *PrivateAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, _ string) (hexutil.Bytes, error) {
+func (api *PrivateAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, password string) (hexutil.Bytes, error) {
 	api.logger.Debug("personal_sign", "data", data, "address", addr.String())
-	return api.backend.Sign(addr, data)
+	return api.backend.SignWithPassphrase(addr, data, password)
 }
 
 // EcRecover returns the address for the account that was used to create the signature.
@@ -204,8 +231,24 @@ type RawWallet struct {
 }
 
 // ListWallets will return a list of wallets this node manages.
+//
+// NOTE: Only the wallets of the encrypted keystore are returned.
 func (api *PrivateAccountAPI) ListWallets() []RawWallet {
 	api.logger.Debug("personal_ListWallets")
-	api.logger.Info("currently wallet level that manages accounts is not supported")
-	return ([]RawWallet)(nil)
+
+	wallets := make([]RawWallet, 0) // return [] instead of nil if empty
+	for _, wallet := range api.backend.KeystoreWallets() {
+		status, failure := wallet.Status()
+
+		raw := RawWallet{
+			URL:      wallet.URL().String(),
+			Status:   status,
+			Accounts: wallet.Accounts(),
+		}
+		if failure != nil {
+			raw.Failure = failure.Error()
+		}
+		wallets = append(wallets, raw)
+	}
+	return wallets
 }
@@ -0,0 +1,92 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package relay
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/evmos/v12/ethereum/eip712"
+	"github.com/evmos/evmos/v12/rpc/backend"
+)
+
+// ForwardRequestArgs defines the EIP-2771 forward request of
+// relay_sendForwardRequest, as signed by its From account.
+type ForwardRequestArgs struct {
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Nonce    hexutil.Uint64 `json:"nonce"`
+	Deadline hexutil.Uint64 `json:"deadline"`
+	Data     hexutil.Bytes  `json:"data"`
+}
+
+// ToForwardRequest converts the arguments into a forward request.
+func (args ForwardRequestArgs) ToForwardRequest() eip712.ForwardRequest {
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+
+	return eip712.ForwardRequest{
+		From:     args.From,
+		To:       args.To,
+		Value:    value,
+		Gas:      new(big.Int).SetUint64(uint64(args.Gas)),
+		Nonce:    new(big.Int).SetUint64(uint64(args.Nonce)),
+		Deadline: new(big.Int).SetUint64(uint64(args.Deadline)),
+		Data:     args.Data,
+	}
+}
+
+// PublicAPI is the relay_ prefixed set of APIs through which the node relays
+// the EIP-2771 forward requests to the forwarder precompile.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates an instance of the relay API.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("api", "relay"),
+		backend: backend,
+	}
+}
+
+// SendForwardRequest submits the signed forward request to the forwarder
+// precompile in a transaction of the relayer account of the node, which pays
+// its fees. Forward requests transferring value are not relayed. It returns the
+// hash of the relayer transaction.
+func (api *PublicAPI) SendForwardRequest(args ForwardRequestArgs, signature hexutil.Bytes) (common.Hash, error) {
+	api.logger.Debug("relay_sendForwardRequest", "from", args.From.Hex(), "to", args.To.Hex())
+	return api.backend.RelayForwardRequest(args.ToForwardRequest(), signature)
+}
+
+// GetNonce returns the nonce of the next forward request of the given account.
+func (api *PublicAPI) GetNonce(from common.Address) (hexutil.Uint64, error) {
+	api.logger.Debug("relay_getNonce", "from", from.Hex())
+
+	nonce, err := api.backend.GetForwardRequestNonce(from)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(nonce.Uint64()), nil
+}
@@ -18,21 +18,25 @@ package txpool
 import (
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/evmos/v12/rpc/backend"
 	"github.com/evmos/evmos/v12/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
 // NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -64,3 +68,13 @@ func (api *PublicAPI) Status() map[string]hexutil.Uint {
 		"queued":  hexutil.Uint(0),
 	}
 }
+
+// TransactionStatus returns the lifecycle status of a transaction submitted through
+// this node (pending, rejected, dropped, included, reverted or failed) together
+// with its state transitions and the underlying ABCI error codes and messages.
+// It returns null if the transaction was not submitted through this node or if
+// it is no longer tracked.
+func (api *PublicAPI) TransactionStatus(hash common.Hash) (*types.TrackedTx, error) {
+	api.logger.Debug("txpool_transactionStatus", "hash", hash.Hex())
+	return api.backend.GetTransactionStatus(hash)
+}
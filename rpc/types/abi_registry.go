@@ -0,0 +1,160 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// abiFileExt is the extension of the files of the ABI registry.
+const abiFileExt = ".json"
+
+// ABIRegistry is a node-local registry of contract ABIs and metadata. The ABIs
+// are registered either for a contract address or for a code hash, in which
+// case they apply to all the contracts with the same code. Each entry is stored
+// as a JSON file named after its key in the registry directory.
+type ABIRegistry struct {
+	dir string
+
+	mtx     sync.RWMutex
+	entries map[string]evmtypes.ContractABI
+}
+
+// NewABIRegistry opens the ABI registry stored in the given directory, creating
+// the directory if it doesn't exist.
+func NewABIRegistry(dir string) (*ABIRegistry, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ABIRegistry{
+		dir:     dir,
+		entries: make(map[string]evmtypes.ContractABI),
+	}
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != abiFileExt {
+			continue
+		}
+
+		bz, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var entry evmtypes.ContractABI
+		if err := json.Unmarshal(bz, &entry); err != nil {
+			return nil, fmt.Errorf("invalid ABI registry file %s: %w", file.Name(), err)
+		}
+
+		key, err := abiRegistryKey(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ABI registry file %s: %w", file.Name(), err)
+		}
+		r.entries[key] = entry
+	}
+
+	return r, nil
+}
+
+// Register validates and stores the ABI of a contract address or, if the
+// address is empty, of a code hash. It replaces the previous registration.
+func (r *ABIRegistry) Register(entry evmtypes.ContractABI) error {
+	key, err := abiRegistryKey(entry)
+	if err != nil {
+		return err
+	}
+
+	if _, err := evmtypes.ParseABI(entry.ABI); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	// write to a temporary file first so that a registration is never partially written
+	path := filepath.Join(r.dir, key+abiFileExt)
+	if err := os.WriteFile(path+".tmp", bz, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+
+	r.entries[key] = entry
+	return nil
+}
+
+// Get returns the ABI registered for the contract address or, if none, for the
+// code hash of the contract.
+func (r *ABIRegistry) Get(address common.Address, codeHash common.Hash) (evmtypes.ContractABI, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if entry, ok := r.entries[strings.ToLower(address.Hex())]; ok {
+		return entry, true
+	}
+
+	if codeHash == (common.Hash{}) {
+		return evmtypes.ContractABI{}, false
+	}
+
+	entry, ok := r.entries[codeHash.Hex()]
+	return entry, ok
+}
+
+// abiRegistryKey returns the key of a registry entry, which is the lower case
+// hex address of the contract or the hex code hash if the address is empty.
+func abiRegistryKey(entry evmtypes.ContractABI) (string, error) {
+	if entry.Address != "" {
+		if err := evmostypes.ValidateNonZeroAddress(entry.Address); err != nil {
+			return "", err
+		}
+		return strings.ToLower(common.HexToAddress(entry.Address).Hex()), nil
+	}
+
+	if entry.CodeHash == "" {
+		return "", errors.New("either the contract address or the code hash must be set")
+	}
+
+	bz, err := hexutil.Decode(entry.CodeHash)
+	if err != nil || len(bz) != common.HashLength {
+		return "", fmt.Errorf("invalid code hash %s", entry.CodeHash)
+	}
+	return common.BytesToHash(bz).Hex(), nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+const testABI = `[{"type": "function", "name": "ping", "inputs": [], "outputs": []}]`
+
+func TestABIRegistry(t *testing.T) {
+	dir := t.TempDir()
+	contract := common.HexToAddress("0x00000000000000000000000000000000000000Ab")
+	codeHash := common.Hash{1}
+
+	registry, err := NewABIRegistry(dir)
+	require.NoError(t, err)
+
+	_, found := registry.Get(contract, codeHash)
+	require.False(t, found)
+
+	// invalid entries
+	require.Error(t, registry.Register(evmtypes.ContractABI{ABI: testABI}))
+	require.Error(t, registry.Register(evmtypes.ContractABI{CodeHash: "0x01", ABI: testABI}))
+	require.Error(t, registry.Register(evmtypes.ContractABI{Address: contract.Hex(), ABI: "{"}))
+
+	byCodeHash := evmtypes.ContractABI{CodeHash: codeHash.Hex(), ABI: testABI, Metadata: "code hash"}
+	require.NoError(t, registry.Register(byCodeHash))
+
+	entry, found := registry.Get(contract, codeHash)
+	require.True(t, found)
+	require.Equal(t, byCodeHash, entry)
+
+	_, found = registry.Get(contract, common.Hash{})
+	require.False(t, found)
+
+	// the registration of the address takes precedence over the code hash
+	byAddress := evmtypes.ContractABI{Address: contract.Hex(), ABI: testABI, Metadata: "address"}
+	require.NoError(t, registry.Register(byAddress))
+
+	entry, found = registry.Get(contract, codeHash)
+	require.True(t, found)
+	require.Equal(t, byAddress, entry)
+
+	// the registrations are persisted
+	registry, err = NewABIRegistry(dir)
+	require.NoError(t, err)
+
+	entry, found = registry.Get(contract, common.Hash{})
+	require.True(t, found)
+	require.Equal(t, byAddress, entry)
+
+	entry, found = registry.Get(common.Address{1}, codeHash)
+	require.True(t, found)
+	require.Equal(t, byCodeHash, entry)
+}
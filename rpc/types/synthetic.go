@@ -0,0 +1,277 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// SyntheticNativeTokenAddress is the address used as the emitter of the synthetic
+// logs of the EVM denomination, following the convention used by wallets and
+// aggregators to refer to the native coin of a chain.
+var SyntheticNativeTokenAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// SyntheticTransferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event.
+var SyntheticTransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// syntheticTokenPrefix is the prefix of the preimage of the synthetic token addresses.
+const syntheticTokenPrefix = "synthetic/"
+
+// SyntheticTokenAddress returns the reserved address used as the emitter of the
+// synthetic logs of a Cosmos denomination, i.e. keccak256("synthetic/" + denom)[12:].
+// The synthetic logs of the coins of a token pair are never emitted by its ERC-20
+// contract, so that they aren't counted twice by the indexers of the contract,
+// which already see the Transfer logs of the coin conversions.
+func SyntheticTokenAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(syntheticTokenPrefix + denom)))
+}
+
+// SyntheticTokens maps the Cosmos denominations that are tracked by the
+// synthetic logs to the reserved address that emits their synthetic logs.
+type SyntheticTokens map[string]common.Address
+
+// SyntheticTokensQuerier queries the EVM denomination and the enabled x/erc20
+// token pairs, which define the tokens tracked by the synthetic logs. The tokens
+// of the last queried height are cached. It is safe for concurrent use.
+type SyntheticTokensQuerier struct {
+	evmClient   evmtypes.QueryClient
+	erc20Client erc20types.QueryClient
+
+	mu     sync.Mutex
+	tokens SyntheticTokens
+	height int64
+}
+
+// NewSyntheticTokensQuerier creates a new querier for the synthetic tokens.
+func NewSyntheticTokensQuerier(clientCtx client.Context) *SyntheticTokensQuerier {
+	return &SyntheticTokensQuerier{
+		evmClient:   evmtypes.NewQueryClient(clientCtx),
+		erc20Client: erc20types.NewQueryClient(clientCtx),
+	}
+}
+
+// Tokens returns the tracked tokens at the given height, so that the synthetic
+// logs of a block only track the token pairs that were enabled in that block.
+func (q *SyntheticTokensQuerier) Tokens(height int64) (SyntheticTokens, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.tokens != nil && q.height == height {
+		return q.tokens, nil
+	}
+
+	ctx := ContextWithHeight(height)
+	params, err := q.evmClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := SyntheticTokens{params.Params.EvmDenom: SyntheticNativeTokenAddress}
+
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := q.erc20Client.TokenPairs(ctx, &erc20types.QueryTokenPairsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pair := range res.TokenPairs {
+			if pair.Enabled {
+				tokens[pair.Denom] = SyntheticTokenAddress(pair.Denom)
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	q.tokens = tokens
+	q.height = height
+	return tokens, nil
+}
+
+// IsSyntheticTxCandidate returns true if the balance changes of a transaction
+// should be represented as synthetic logs, i.e. if the transaction succeeded and
+// it's not an Ethereum transaction, whose balance changes are observable from
+// the transaction itself.
+func IsSyntheticTxCandidate(res *abci.ResponseDeliverTx) bool {
+	if res.Code != abci.CodeTypeOK {
+		return false
+	}
+
+	for _, event := range res.Events {
+		if event.Type == evmtypes.EventTypeEthereumTx {
+			return false
+		}
+	}
+	return true
+}
+
+// SyntheticLogsFromEvents converts the bank events of a Cosmos transaction into
+// ERC-20 Transfer logs of the tracked tokens:
+//   - transfer events are converted into a transfer from the sender to the recipient
+//   - coinbase (mint) events are converted into a transfer from the zero address to the minter
+//   - burn events are converted into a transfer from the burner to the zero address
+//
+// Transfer events without a sender (eg: the outputs of a MsgMultiSend) are ignored,
+// as the sender can't be determined. Only the address, topics and data of the
+// returned logs are set.
+func SyntheticLogsFromEvents(events []abci.Event, tokens SyntheticTokens) []*ethtypes.Log {
+	var logs []*ethtypes.Log
+	for _, event := range events {
+		var from, to, amount string
+		switch event.Type {
+		case banktypes.EventTypeTransfer:
+			from = eventAttribute(event, banktypes.AttributeKeySender)
+			to = eventAttribute(event, banktypes.AttributeKeyRecipient)
+		case banktypes.EventTypeCoinMint:
+			to = eventAttribute(event, banktypes.AttributeKeyMinter)
+		case banktypes.EventTypeCoinBurn:
+			from = eventAttribute(event, banktypes.AttributeKeyBurner)
+		default:
+			continue
+		}
+		amount = eventAttribute(event, sdk.AttributeKeyAmount)
+
+		fromAddr, ok := syntheticAddress(from, event.Type == banktypes.EventTypeCoinMint)
+		if !ok {
+			continue
+		}
+		toAddr, ok := syntheticAddress(to, event.Type == banktypes.EventTypeCoinBurn)
+		if !ok {
+			continue
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			continue
+		}
+
+		for _, coin := range coins {
+			token, found := tokens[coin.Denom]
+			if !found || !coin.Amount.IsPositive() {
+				continue
+			}
+
+			logs = append(logs, &ethtypes.Log{
+				Address: token,
+				Topics: []common.Hash{
+					SyntheticTransferTopic,
+					common.BytesToHash(fromAddr.Bytes()),
+					common.BytesToHash(toAddr.Bytes()),
+				},
+				Data: common.BigToHash(coin.Amount.BigInt()).Bytes(),
+			})
+		}
+	}
+	return logs
+}
+
+// SyntheticTxHash returns the hash of the synthetic transaction that represents
+// the balance changes of a Cosmos transaction, which is the Cosmos transaction hash.
+func SyntheticTxHash(tx tmtypes.Tx) common.Hash {
+	return common.BytesToHash(tx.Hash())
+}
+
+// SyntheticLogsFromBlock returns the synthetic logs of all the transactions of a
+// block. The logs are indexed after the Ethereum logs of the block and their
+// transaction index is the index of the Cosmos transaction within the block.
+func SyntheticLogsFromBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx, tokens SyntheticTokens) []*ethtypes.Log {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	// synthetic logs are indexed after the ethereum logs of the block
+	var logIndex uint
+	for _, res := range txResults {
+		logIndex += countTxLogs(res.Events)
+	}
+
+	blockHash := common.BytesToHash(block.Hash())
+	var logs []*ethtypes.Log
+	for txIndex, res := range txResults {
+		if txIndex >= len(block.Txs) || !IsSyntheticTxCandidate(res) {
+			continue
+		}
+
+		txHash := SyntheticTxHash(block.Txs[txIndex])
+		for _, log := range SyntheticLogsFromEvents(res.Events, tokens) {
+			log.BlockNumber = uint64(block.Height) //#nosec G701 -- block heights are positive
+			log.BlockHash = blockHash
+			log.TxHash = txHash
+			log.TxIndex = uint(txIndex)
+			log.Index = logIndex
+			logIndex++
+
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+// syntheticAddress converts a bech32 address into a hex address. An empty
+// address is only accepted (as the zero address) if allowZero is true.
+func syntheticAddress(bech32 string, allowZero bool) (common.Address, bool) {
+	if bech32 == "" {
+		return common.Address{}, allowZero
+	}
+
+	addr, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(addr), true
+}
+
+// countTxLogs returns the number of ethereum logs emitted by a transaction.
+func countTxLogs(events []abci.Event) uint {
+	var count uint
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == evmtypes.AttributeKeyTxLog {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+	return ""
+}
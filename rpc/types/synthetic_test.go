@@ -0,0 +1,183 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+func TestSyntheticLogsFromEvents(t *testing.T) {
+	sender := common.BigToAddress(big.NewInt(1))
+	recipient := common.BigToAddress(big.NewInt(2))
+	token := SyntheticTokenAddress("ibc/ABC")
+	tokens := SyntheticTokens{
+		"aevmos":  SyntheticNativeTokenAddress,
+		"ibc/ABC": token,
+	}
+
+	bech32 := func(addr common.Address) []byte {
+		return []byte(sdk.AccAddress(addr.Bytes()).String())
+	}
+
+	testCases := []struct {
+		name    string
+		events  []abci.Event
+		expLogs []struct {
+			address  common.Address
+			from, to common.Address
+			amount   int64
+		}
+	}{
+		{
+			"transfer of tracked and untracked denoms",
+			[]abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: []byte("recipient"), Value: bech32(recipient)},
+					{Key: []byte("sender"), Value: bech32(sender)},
+					{Key: []byte("amount"), Value: []byte("10aevmos,5ibc/ABC,7stake")},
+				}},
+			},
+			[]struct {
+				address  common.Address
+				from, to common.Address
+				amount   int64
+			}{
+				{SyntheticNativeTokenAddress, sender, recipient, 10},
+				{token, sender, recipient, 5},
+			},
+		},
+		{
+			"mint and burn",
+			[]abci.Event{
+				{Type: "coinbase", Attributes: []abci.EventAttribute{
+					{Key: []byte("minter"), Value: bech32(recipient)},
+					{Key: []byte("amount"), Value: []byte("3ibc/ABC")},
+				}},
+				{Type: "burn", Attributes: []abci.EventAttribute{
+					{Key: []byte("burner"), Value: bech32(sender)},
+					{Key: []byte("amount"), Value: []byte("4aevmos")},
+				}},
+			},
+			[]struct {
+				address  common.Address
+				from, to common.Address
+				amount   int64
+			}{
+				{token, common.Address{}, recipient, 3},
+				{SyntheticNativeTokenAddress, sender, common.Address{}, 4},
+			},
+		},
+		{
+			"transfer without sender is ignored",
+			[]abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: []byte("recipient"), Value: bech32(recipient)},
+					{Key: []byte("amount"), Value: []byte("10aevmos")},
+				}},
+			},
+			nil,
+		},
+		{
+			"invalid address and amount are ignored",
+			[]abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: []byte("recipient"), Value: []byte("invalid")},
+					{Key: []byte("sender"), Value: bech32(sender)},
+					{Key: []byte("amount"), Value: []byte("10aevmos")},
+				}},
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: []byte("recipient"), Value: bech32(recipient)},
+					{Key: []byte("sender"), Value: bech32(sender)},
+					{Key: []byte("amount"), Value: []byte("invalid")},
+				}},
+			},
+			nil,
+		},
+		{
+			"unrelated events",
+			[]abci.Event{
+				{Type: "coin_spent", Attributes: []abci.EventAttribute{
+					{Key: []byte("spender"), Value: bech32(sender)},
+					{Key: []byte("amount"), Value: []byte("10aevmos")},
+				}},
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs := SyntheticLogsFromEvents(tc.events, tokens)
+			require.Len(t, logs, len(tc.expLogs))
+			for i, expLog := range tc.expLogs {
+				require.Equal(t, expLog.address, logs[i].Address)
+				require.Equal(t, []common.Hash{
+					SyntheticTransferTopic,
+					common.BytesToHash(expLog.from.Bytes()),
+					common.BytesToHash(expLog.to.Bytes()),
+				}, logs[i].Topics)
+				require.Equal(t, big.NewInt(expLog.amount), new(big.Int).SetBytes(logs[i].Data))
+			}
+		})
+	}
+}
+
+func TestSyntheticTokenAddress(t *testing.T) {
+	addr := SyntheticTokenAddress("ibc/ABC")
+	require.Equal(t, common.BytesToAddress(crypto.Keccak256([]byte("synthetic/ibc/ABC"))), addr)
+	require.Equal(t, addr, SyntheticTokenAddress("ibc/ABC"))
+	require.NotEqual(t, addr, SyntheticTokenAddress("ibc/ABD"))
+	require.NotEqual(t, SyntheticNativeTokenAddress, SyntheticTokenAddress("aevmos"))
+}
+
+func TestSyntheticLogsFromBlock(t *testing.T) {
+	sender := sdk.AccAddress(common.BigToAddress(big.NewInt(1)).Bytes()).String()
+	recipient := sdk.AccAddress(common.BigToAddress(big.NewInt(2)).Bytes()).String()
+	tokens := SyntheticTokens{"aevmos": SyntheticNativeTokenAddress}
+
+	transfer := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+		{Key: []byte("recipient"), Value: []byte(recipient)},
+		{Key: []byte("sender"), Value: []byte(sender)},
+		{Key: []byte("amount"), Value: []byte("10aevmos")},
+	}}
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Height: 10},
+		Data:   tmtypes.Data{Txs: []tmtypes.Tx{[]byte("eth"), []byte("failed"), []byte("cosmos")}},
+	}
+	txResults := []*abci.ResponseDeliverTx{
+		{
+			// ethereum tx with two logs, its transfers are not converted
+			Events: []abci.Event{
+				transfer,
+				{Type: evmtypes.EventTypeEthereumTx},
+				{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+					{Key: []byte(evmtypes.AttributeKeyTxLog), Value: []byte("{}")},
+					{Key: []byte(evmtypes.AttributeKeyTxLog), Value: []byte("{}")},
+				}},
+			},
+		},
+		{Code: 5, Events: []abci.Event{transfer}},
+		{Events: []abci.Event{transfer, transfer}},
+	}
+
+	logs := SyntheticLogsFromBlock(block, txResults, tokens)
+	require.Len(t, logs, 2)
+	for i, log := range logs {
+		require.Equal(t, uint64(10), log.BlockNumber)
+		require.Equal(t, common.BytesToHash(block.Hash()), log.BlockHash)
+		require.Equal(t, SyntheticTxHash(block.Txs[2]), log.TxHash)
+		require.Equal(t, uint(2), log.TxIndex)
+		require.Equal(t, uint(2+i), log.Index)
+	}
+
+	require.Empty(t, SyntheticLogsFromBlock(block, txResults, nil))
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// TxStatus defines the lifecycle state of an Ethereum transaction submitted
+// through the node's JSON-RPC server.
+type TxStatus string
+
+const (
+	// TxStatusPending is set when the transaction passed CheckTx and is in the mempool.
+	TxStatusPending TxStatus = "pending"
+	// TxStatusRejected is set when CheckTx (or the broadcast itself) failed.
+	TxStatusRejected TxStatus = "rejected"
+	// TxStatusDropped is set when the transaction left the mempool without being
+	// included in a block, either because it failed recheck or because it was evicted.
+	TxStatusDropped TxStatus = "dropped"
+	// TxStatusIncluded is set when the transaction was executed successfully in a block.
+	TxStatusIncluded TxStatus = "included"
+	// TxStatusReverted is set when the transaction was included in a block but the
+	// EVM execution failed (e.g. reverted or ran out of gas).
+	TxStatusReverted TxStatus = "reverted"
+	// TxStatusFailed is set when the transaction was included in a block but
+	// DeliverTx returned an error code.
+	TxStatusFailed TxStatus = "failed"
+)
+
+// IsFinal returns true if no further state transitions are expected for the status.
+func (s TxStatus) IsFinal() bool {
+	switch s {
+	case TxStatusRejected, TxStatusIncluded, TxStatusReverted, TxStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// TxStatusTransition records a single state change of a tracked transaction
+// together with the ABCI error information that caused it, if any.
+type TxStatusTransition struct {
+	Status    TxStatus        `json:"status"`
+	Time      time.Time       `json:"time"`
+	Height    *hexutil.Uint64 `json:"blockNumber,omitempty"`
+	Codespace string          `json:"codespace,omitempty"`
+	Code      uint32          `json:"code,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// TrackedTx defines the lifecycle information of a transaction submitted
+// through the node.
+type TrackedTx struct {
+	Hash        common.Hash          `json:"hash"`
+	CosmosHash  hexutil.Bytes        `json:"cosmosHash"`
+	Status      TxStatus             `json:"status"`
+	Transitions []TxStatusTransition `json:"transitions"`
+
+	// raw cosmos tx bytes, used to re-run CheckTx when the tx leaves the mempool
+	txBytes []byte
+}
+
+// TxBytes returns the encoded cosmos transaction that wraps the Ethereum transaction.
+func (t TrackedTx) TxBytes() []byte {
+	return t.txBytes
+}
+
+// TxStatusStore is a bounded, in-memory store of the transactions submitted
+// through the node. When the capacity is reached, the least recently used
+// entries are discarded. It is safe for concurrent use.
+type TxStatusStore struct {
+	mu    sync.Mutex
+	cache *lru.Cache
+}
+
+// NewTxStatusStore creates a new store that keeps track of up to size transactions.
+// A nil store is returned if size is 0, which disables the tracking.
+func NewTxStatusStore(size int) (*TxStatusStore, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxStatusStore{cache: cache}, nil
+}
+
+// Track starts tracking the given transaction with its initial status.
+// If the transaction is already tracked, a new transition is appended instead.
+func (s *TxStatusStore) Track(hash common.Hash, cosmosHash, txBytes []byte, transition TxStatusTransition) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.cache.Get(hash); ok {
+		tx := v.(*TrackedTx)
+		tx.transition(transition)
+		return
+	}
+
+	tx := &TrackedTx{
+		Hash:       hash,
+		CosmosHash: cosmosHash,
+		txBytes:    txBytes,
+	}
+	tx.transition(transition)
+	s.cache.Add(hash, tx)
+}
+
+// Update appends the given transition to a tracked transaction. It is a no-op
+// if the transaction is not tracked or if its status is unchanged.
+func (s *TxStatusStore) Update(hash common.Hash, transition TxStatusTransition) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.cache.Peek(hash)
+	if !ok {
+		return
+	}
+
+	tx := v.(*TrackedTx)
+	if tx.Status == transition.Status {
+		return
+	}
+	tx.transition(transition)
+}
+
+// Get returns a copy of the tracked transaction for the given hash.
+func (s *TxStatusStore) Get(hash common.Hash) (TrackedTx, bool) {
+	if s == nil {
+		return TrackedTx{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.cache.Get(hash)
+	if !ok {
+		return TrackedTx{}, false
+	}
+
+	tx := *v.(*TrackedTx)
+	tx.Transitions = append([]TxStatusTransition(nil), tx.Transitions...)
+	return tx, true
+}
+
+// Pending returns the hashes of the tracked transactions whose status is not final.
+func (s *TxStatusStore) Pending() []common.Hash {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var hashes []common.Hash
+	for _, key := range s.cache.Keys() {
+		v, ok := s.cache.Peek(key)
+		if ok && !v.(*TrackedTx).Status.IsFinal() {
+			hashes = append(hashes, key.(common.Hash))
+		}
+	}
+	return hashes
+}
+
+// Len returns the number of tracked transactions.
+func (s *TxStatusStore) Len() int {
+	if s == nil {
+		return 0
+	}
+	return s.cache.Len()
+}
+
+func (t *TrackedTx) transition(transition TxStatusTransition) {
+	if transition.Time.IsZero() {
+		transition.Time = time.Now().UTC()
+	}
+	t.Status = transition.Status
+	t.Transitions = append(t.Transitions, transition)
+}
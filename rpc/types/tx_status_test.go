@@ -0,0 +1,88 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxStatusStore(t *testing.T) {
+	hash := common.BigToHash(big.NewInt(1))
+	hash2 := common.BigToHash(big.NewInt(2))
+	hash3 := common.BigToHash(big.NewInt(3))
+
+	store, err := NewTxStatusStore(2)
+	require.NoError(t, err)
+
+	// untracked
+	_, found := store.Get(hash)
+	require.False(t, found)
+	store.Update(hash, TxStatusTransition{Status: TxStatusDropped})
+	require.Equal(t, 0, store.Len())
+
+	store.Track(hash, []byte{1}, []byte{2}, TxStatusTransition{Status: TxStatusPending})
+	tx, found := store.Get(hash)
+	require.True(t, found)
+	require.Equal(t, TxStatusPending, tx.Status)
+	require.Equal(t, []byte{2}, tx.TxBytes())
+	require.Len(t, tx.Transitions, 1)
+	require.False(t, tx.Transitions[0].Time.IsZero())
+
+	// same status is not recorded twice
+	store.Update(hash, TxStatusTransition{Status: TxStatusPending})
+	tx, _ = store.Get(hash)
+	require.Len(t, tx.Transitions, 1)
+
+	store.Update(hash, TxStatusTransition{Status: TxStatusDropped, Code: 32})
+	tx, _ = store.Get(hash)
+	require.Equal(t, TxStatusDropped, tx.Status)
+	require.Len(t, tx.Transitions, 2)
+	require.Equal(t, uint32(32), tx.Transitions[1].Code)
+
+	// re-submitting a tracked tx appends a transition
+	store.Track(hash, []byte{1}, []byte{2}, TxStatusTransition{Status: TxStatusPending})
+	tx, _ = store.Get(hash)
+	require.Equal(t, TxStatusPending, tx.Status)
+	require.Len(t, tx.Transitions, 3)
+
+	// returned value is a copy
+	tx.Transitions[0].Status = TxStatusFailed
+	tx, _ = store.Get(hash)
+	require.Equal(t, TxStatusPending, tx.Transitions[0].Status)
+
+	// least recently used tx is evicted
+	store.Track(hash2, nil, nil, TxStatusTransition{Status: TxStatusPending})
+	store.Track(hash3, nil, nil, TxStatusTransition{Status: TxStatusPending})
+	require.Equal(t, 2, store.Len())
+	_, found = store.Get(hash)
+	require.False(t, found)
+
+	// only the txs whose status is not final are pending
+	store.Update(hash3, TxStatusTransition{Status: TxStatusIncluded})
+	require.Equal(t, []common.Hash{hash2}, store.Pending())
+}
+
+func TestTxStatusStoreDisabled(t *testing.T) {
+	store, err := NewTxStatusStore(0)
+	require.NoError(t, err)
+	require.Nil(t, store)
+
+	hash := common.BigToHash(big.NewInt(1))
+	store.Track(hash, nil, nil, TxStatusTransition{Status: TxStatusPending})
+	store.Update(hash, TxStatusTransition{Status: TxStatusDropped})
+	_, found := store.Get(hash)
+	require.False(t, found)
+	require.Equal(t, 0, store.Len())
+	require.Empty(t, store.Pending())
+}
+
+func TestTxStatusIsFinal(t *testing.T) {
+	require.False(t, TxStatusPending.IsFinal())
+	require.False(t, TxStatusDropped.IsFinal())
+	require.True(t, TxStatusRejected.IsFinal())
+	require.True(t, TxStatusIncluded.IsFinal())
+	require.True(t, TxStatusReverted.IsFinal())
+	require.True(t, TxStatusFailed.IsFinal())
+}
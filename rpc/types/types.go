@@ -65,6 +65,9 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+	// Synthetic is set for the synthetic transactions, which represent the
+	// balance changes of a Cosmos transaction and are not executed by the EVM.
+	Synthetic bool `json:"synthetic,omitempty"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -63,7 +63,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 }
 
 // EthHeaderFromTendermint is an util function that returns an Ethereum Header
-// from a tendermint Header.
+// from a tendermint Header. The mix digest is the randomness of the block.
 func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFee *big.Int) *ethtypes.Header {
 	txHash := ethtypes.EmptyRootHash
 	if len(header.DataHash) == 0 {
@@ -85,7 +85,7 @@ func EthHeaderFromTendermint(header tmtypes.Header, bloom ethtypes.Bloom, baseFe
 		GasUsed:     0,
 		Time:        time,
 		Extra:       []byte{},
-		MixDigest:   common.Hash{},
+		MixDigest:   evmtypes.BlockRandomness(common.BytesToHash(header.Hash())),
 		Nonce:       ethtypes.BlockNonce{},
 		BaseFee:     baseFee,
 	}
@@ -111,7 +111,7 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 }
 
 // FormatBlock creates an ethereum block from a tendermint header and ethereum-formatted
-// transactions.
+// transactions. The mixHash is the randomness of the block, see evmtypes.BlockRandomness.
 func FormatBlock(
 	header tmtypes.Header, size int, gasLimit int64,
 	gasUsed *big.Int, transactions []interface{}, bloom ethtypes.Bloom,
@@ -133,7 +133,7 @@ func FormatBlock(
 		"logsBloom":        bloom,
 		"stateRoot":        hexutil.Bytes(header.AppHash),
 		"miner":            validatorAddr,
-		"mixHash":          common.Hash{},
+		"mixHash":          evmtypes.BlockRandomness(common.BytesToHash(header.Hash())),
 		"difficulty":       (*hexutil.Big)(big.NewInt(0)),
 		"extraData":        "0x",
 		"size":             hexutil.Uint64(size),
@@ -25,6 +25,8 @@ import (
 
 	"github.com/tendermint/tendermint/libs/strings"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -52,6 +54,12 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultParallelExecution is the default mode of the parallel execution of the eth txs of a block
+	DefaultParallelExecution = "off"
+
+	// DefaultParallelWorkers is the default number of eth txs executed concurrently (0 = number of CPUs)
+	DefaultParallelWorkers = 0
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -84,9 +92,17 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultTxStatusCap is the default number of submitted transactions tracked by the node
+	DefaultTxStatusCap = 10000
+
+	// DefaultStateTrieKeepRecent is the default number of recent heights whose state trie is kept
+	DefaultStateTrieKeepRecent = 128
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var evmTracers = []string{"json", "markdown", "struct", "access_list", "gas_profile"}
+
+var parallelExecutionModes = []string{"off", "on", "validate"}
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -105,6 +121,11 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// ParallelExecution defines the mode of the parallel execution of the eth txs of a block:
+	// 'off', 'on' or 'validate' to run both the parallel and sequential executions and compare them.
+	ParallelExecution string `mapstructure:"parallel-execution"`
+	// ParallelWorkers defines the maximum number of eth txs executed concurrently. Default: number of CPUs.
+	ParallelWorkers int `mapstructure:"parallel-workers"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -147,6 +168,39 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// TxStatusCap defines the max number of transactions submitted through the node whose
+	// lifecycle status is tracked (0 = disabled).
+	TxStatusCap int `mapstructure:"tx-status-cap"`
+	// SyntheticLogs defines if the balance changes of the Cosmos transactions are returned
+	// as synthetic ERC-20 Transfer logs of the EVM denom and the registered token pairs.
+	SyntheticLogs bool `mapstructure:"synthetic-logs"`
+	// SyntheticTxs defines if the custom indexer indexes the synthetic transactions that
+	// represent the balance changes of the Cosmos transactions. It requires the indexer.
+	SyntheticTxs bool `mapstructure:"synthetic-txs"`
+	// KeystoreDir defines the directory of the encrypted keystore used by the personal namespace.
+	// If empty, the keystore directory within the node's home directory is used.
+	KeystoreDir string `mapstructure:"keystore-dir"`
+	// ExternalSigner defines the endpoint (HTTP URL or IPC path) of a Clef-compatible external
+	// signer. If set, the signing methods of the eth and personal namespaces delegate to it.
+	ExternalSigner string `mapstructure:"external-signer"`
+	// ABIRegistryDir defines the directory of the node-local contract ABI registry used by the abi
+	// namespace and to decode traces. If empty, the registry directory within the node's home directory is used.
+	ABIRegistryDir string `mapstructure:"abi-registry-dir"`
+	// RelayerAddress defines the hex address of the node account that submits, and pays the fees of,
+	// the forward requests received through the relay namespace. If empty, relaying is disabled.
+	RelayerAddress string `mapstructure:"relayer-address"`
+	// RelayAllowedContracts defines the hex addresses of the contracts whose forward requests are
+	// relayed. If empty, no forward request is relayed.
+	RelayAllowedContracts []string `mapstructure:"relay-allowed-contracts"`
+	// RelayMaxGas defines the maximum gas of the relayed forward requests (0=unlimited).
+	RelayMaxGas uint64 `mapstructure:"relay-max-gas"`
+	// EnableStateTrie defines if the node maintains an Ethereum Merkle-Patricia trie of the EVM
+	// state, whose root is returned as the ethStateRoot block field and that is used to return
+	// Ethereum proofs in eth_getProof.
+	EnableStateTrie bool `mapstructure:"enable-state-trie"`
+	// StateTrieKeepRecent defines the number of recent heights whose state trie is kept, the
+	// proofs of the older heights are returned against the app hash.
+	StateTrieKeepRecent int `mapstructure:"state-trie-keep-recent"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -205,8 +259,10 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:            DefaultEVMTracer,
+		MaxTxGasWanted:    DefaultMaxTxGasWanted,
+		ParallelExecution: DefaultParallelExecution,
+		ParallelWorkers:   DefaultParallelWorkers,
 	}
 }
 
@@ -216,6 +272,14 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.ParallelExecution != "" && !strings.StringInSlice(c.ParallelExecution, parallelExecutionModes) {
+		return fmt.Errorf("invalid parallel execution mode %s, available modes: %v", c.ParallelExecution, parallelExecutionModes)
+	}
+
+	if c.ParallelWorkers < 0 {
+		return errors.New("parallel workers cannot be negative")
+	}
+
 	return nil
 }
 
@@ -226,7 +290,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "admin", "abi", "relay"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -250,6 +314,17 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		TxStatusCap:              DefaultTxStatusCap,
+		SyntheticLogs:            false,
+		SyntheticTxs:             false,
+		KeystoreDir:              "",
+		ExternalSigner:           "",
+		ABIRegistryDir:           "",
+		RelayerAddress:           "",
+		RelayAllowedContracts:    []string{},
+		RelayMaxGas:              0,
+		EnableStateTrie:          false,
+		StateTrieKeepRecent:      DefaultStateTrieKeepRecent,
 	}
 }
 
@@ -291,6 +366,28 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.TxStatusCap < 0 {
+		return errors.New("JSON-RPC tx status cap cannot be negative")
+	}
+
+	if c.EnableStateTrie && c.StateTrieKeepRecent < 1 {
+		return errors.New("JSON-RPC state trie keep recent must be positive")
+	}
+
+	if c.SyntheticTxs && !c.EnableIndexer {
+		return errors.New("JSON-RPC synthetic transactions require the custom indexer to be enabled")
+	}
+
+	if c.RelayerAddress != "" && !common.IsHexAddress(c.RelayerAddress) {
+		return fmt.Errorf("invalid JSON-RPC relayer address '%s'", c.RelayerAddress)
+	}
+
+	for _, contract := range c.RelayAllowedContracts {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("invalid JSON-RPC relay allowed contract '%s'", contract)
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -339,8 +436,10 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			Tracer:            v.GetString("evm.tracer"),
+			MaxTxGasWanted:    v.GetUint64("evm.max-tx-gas-wanted"),
+			ParallelExecution: v.GetString("evm.parallel-execution"),
+			ParallelWorkers:   v.GetInt("evm.parallel-workers"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -360,6 +459,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
+			TxStatusCap:              v.GetInt("json-rpc.tx-status-cap"),
+			SyntheticLogs:            v.GetBool("json-rpc.synthetic-logs"),
+			SyntheticTxs:             v.GetBool("json-rpc.synthetic-txs"),
+			KeystoreDir:              v.GetString("json-rpc.keystore-dir"),
+			ExternalSigner:           v.GetString("json-rpc.external-signer"),
+			ABIRegistryDir:           v.GetString("json-rpc.abi-registry-dir"),
+			RelayerAddress:           v.GetString("json-rpc.relayer-address"),
+			RelayAllowedContracts:    v.GetStringSlice("json-rpc.relay-allowed-contracts"),
+			RelayMaxGas:              v.GetUint64("json-rpc.relay-max-gas"),
+			EnableStateTrie:          v.GetBool("json-rpc.enable-state-trie"),
+			StateTrieKeepRecent:      v.GetInt("json-rpc.state-trie-keep-recent"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
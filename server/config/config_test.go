@@ -12,3 +12,18 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestEVMConfigValidate(t *testing.T) {
+	cfg := DefaultEVMConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.ParallelExecution = "validate"
+	require.NoError(t, cfg.Validate())
+
+	cfg.ParallelExecution = "parallel"
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultEVMConfig()
+	cfg.ParallelWorkers = -1
+	require.Error(t, cfg.Validate())
+}
@@ -25,12 +25,26 @@ const DefaultConfigTemplate = `
 
 # Tracer defines the 'vm.Tracer' type that the EVM will use when the node is run in
 # debug mode. To enable tracing use the '--evm.tracer' flag when starting your node.
-# Valid types are: json|struct|access_list|markdown
+# Valid types are: json|struct|access_list|markdown|gas_profile
+# The gas_profile tracer reports the gas used per contract, function selector and
+# opcode of every transaction as telemetry metrics.
 tracer = "{{ .EVM.Tracer }}"
 
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# ParallelExecution defines how the eth txs of a block are executed. Valid modes are:
+# off: the txs are executed sequentially.
+# on: the txs are executed speculatively in parallel at the beginning of the block, and the ones
+#     that read state written by a previous tx are re-executed in order. The results are identical
+#     to the sequential ones.
+# validate: the txs are executed both in parallel and sequentially, the sequential results are
+#     committed and the differences are logged.
+parallel-execution = "{{ .EVM.ParallelExecution }}"
+
+# ParallelWorkers defines the maximum number of eth txs executed concurrently (0 = number of CPUs).
+parallel-workers = {{ .EVM.ParallelWorkers }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -48,6 +62,9 @@ ws-address = "{{ .JSONRPC.WsAddress }}"
 
 # API defines a list of JSON-RPC namespaces that should be enabled
 # Example: "eth,txpool,personal,net,debug,web3"
+# NOTE: the private 'personal', 'miner', 'admin' and 'abi' namespaces give access to the node's keys,
+# peers and ABI registry, and should only be enabled on endpoints that are not publicly accessible.
+# The 'relay' namespace spends the funds of the relayer account on the fees of the relayed requests.
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
@@ -95,6 +112,60 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# TxStatusCap defines the max number of transactions submitted through this node whose lifecycle
+# status is tracked for the 'txpool_transactionStatus' query (0 = disabled).
+tx-status-cap = {{ .JSONRPC.TxStatusCap }}
+
+# SyntheticLogs enables the synthetic ERC-20 Transfer logs for the balance changes of the Cosmos
+# transactions (bank sends, rewards withdrawals, IBC receives, coin conversions, etc.) of the EVM
+# denom and the registered token pairs. Synthetic logs are returned by 'eth_getLogs' and log filters.
+# They are emitted by reserved addresses instead of the token contracts: 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE
+# for the EVM denom and keccak256("synthetic/" + denom)[12:] for the coins of the token pairs.
+synthetic-logs = {{ .JSONRPC.SyntheticLogs }}
+
+# SyntheticTxs enables the indexing of the synthetic transactions that contain the synthetic logs,
+# so that they can be queried by hash (the Cosmos transaction hash). Requires 'enable-indexer'.
+synthetic-txs = {{ .JSONRPC.SyntheticTxs }}
+
+# KeystoreDir defines the directory of the Web3 Secret Storage (v3) keystore where the accounts created
+# or imported through the 'personal' namespace are stored, encrypted with their password.
+# Keystore files created by geth can be copied to this directory. Defaults to '<home>/keystore'.
+keystore-dir = "{{ .JSONRPC.KeystoreDir }}"
+
+# ExternalSigner defines the endpoint (HTTP URL or IPC path) of a Clef-compatible external signer
+# (eg: 'http://localhost:8550' or '/path/to/clef.ipc'). If set, 'eth_sign', 'eth_signTypedData',
+# 'eth_sendTransaction' and the 'personal' signing methods delegate signing to the external signer,
+# which approves or rejects each request, and the node's keyring and keystore are not used. The node
+# fails to start if the external signer can't be reached.
+external-signer = "{{ .JSONRPC.ExternalSigner }}"
+
+# ABIRegistryDir defines the directory of the node-local contract ABI registry, where the ABIs registered
+# through the 'abi' namespace are stored. The registered ABIs, and the ABIs registered on chain, are used to
+# decode the calls and custom errors of the 'debug_traceTransaction' results. Defaults to '<home>/abi-registry'.
+abi-registry-dir = "{{ .JSONRPC.ABIRegistryDir }}"
+
+# RelayerAddress defines the hex address of the node account (of the keyring, the keystore or the external
+# signer) that submits the EIP-2771 forward requests received through the 'relay' namespace to the forwarder
+# precompile, paying their fees. If empty, the 'relay_sendForwardRequest' method is disabled.
+relayer-address = "{{ .JSONRPC.RelayerAddress }}"
+
+# RelayAllowedContracts defines the hex addresses of the contracts whose forward requests are relayed.
+# If empty, no forward request is relayed. The forward requests transferring value are never relayed.
+relay-allowed-contracts = [{{range $index, $elmt := .JSONRPC.RelayAllowedContracts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RelayMaxGas defines the maximum gas of the relayed forward requests (0=unlimited).
+relay-max-gas = {{ .JSONRPC.RelayMaxGas }}
+
+# EnableStateTrie enables the node-local Ethereum Merkle-Patricia trie of the EVM accounts and storage.
+# Its root is returned as the 'ethStateRoot' field of the blocks and 'eth_getProof' returns Ethereum
+# account and storage proofs against it. The trie is rebuilt from the latest state when enabled on
+# an existing node. It requires the app pruning to keep at least 100 recent heights.
+enable-state-trie = {{ .JSONRPC.EnableStateTrie }}
+
+# StateTrieKeepRecent defines the number of recent heights whose state trie is kept. The proofs of
+# the other heights are returned against the app hash.
+state-trie-keep-recent = {{ .JSONRPC.StateTrieKeepRecent }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
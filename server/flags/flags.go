@@ -70,12 +70,25 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCTxStatusCap              = "json-rpc.tx-status-cap"
+	JSONRPCSyntheticLogs            = "json-rpc.synthetic-logs"
+	JSONRPCSyntheticTxs             = "json-rpc.synthetic-txs"
+	JSONRPCKeystoreDir              = "json-rpc.keystore-dir"
+	JSONRPCExternalSigner           = "json-rpc.external-signer"
+	JSONRPCABIRegistryDir           = "json-rpc.abi-registry-dir"
+	JSONRPCRelayerAddress           = "json-rpc.relayer-address"
+	JSONRPCRelayAllowedContracts    = "json-rpc.relay-allowed-contracts"
+	JSONRPCRelayMaxGas              = "json-rpc.relay-max-gas"
+	JSONRPCEnableStateTrie          = "json-rpc.enable-state-trie"
+	JSONRPCStateTrieKeepRecent      = "json-rpc.state-trie-keep-recent"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer            = "evm.tracer"
+	EVMMaxTxGasWanted    = "evm.max-tx-gas-wanted"
+	EVMParallelExecution = "evm.parallel-execution"
+	EVMParallelWorkers   = "evm.parallel-workers"
 )
 
 // TLS flags
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -28,11 +29,17 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/evmos/v12/rpc"
+	"github.com/evmos/evmos/v12/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
 
 	"github.com/evmos/evmos/v12/server/config"
 	evmostypes "github.com/evmos/evmos/v12/types"
 )
 
+// txStatusRefreshInterval is the interval at which the status of the pending
+// transactions submitted through the node is refreshed.
+const txStatusRefreshInterval = time.Second
+
 // StartJSONRPC starts the JSON-RPC server
 func StartJSONRPC(ctx *server.Context,
 	clientCtx client.Context,
@@ -40,6 +47,7 @@ func StartJSONRPC(ctx *server.Context,
 	tmEndpoint string,
 	config *config.Config,
 	indexer evmostypes.EVMTxIndexer,
+	stateTrie backend.StateTrie,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -61,7 +69,14 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	// the transactions submitted through a namespace (eg: eth) are queried from
+	// another one (eg: txpool), so the store is shared by all the backends
+	txStatus, err := rpctypes.NewTxStatusStore(config.JSONRPC.TxStatusCap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, stateTrie, txStatus, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -118,6 +133,12 @@ func StartJSONRPC(ctx *server.Context,
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
+	// the transactions are tracked by the backends of all the namespaces, so
+	// their status is refreshed until the server is closed whatever the enabled
+	// namespaces
+	statusBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, stateTrie, txStatus)
+	go refreshTxStatuses(ctx.Logger, statusBackend, httpSrvDone)
+
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
 	// allocate separate WS connection to Tendermint
@@ -126,3 +147,22 @@ func StartJSONRPC(ctx *server.Context,
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// refreshTxStatuses refreshes the status of the pending transactions submitted
+// through the node, so that their transitions are recorded when they happen
+// rather than when they are queried, until done is closed.
+func refreshTxStatuses(logger log.Logger, evmBackend backend.EVMBackend, done <-chan struct{}) {
+	ticker := time.NewTicker(txStatusRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := evmBackend.RefreshTxStatuses(); err != nil {
+				logger.Debug("failed to refresh tx statuses", "error", err.Error())
+			}
+		}
+	}
+}
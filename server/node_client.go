@@ -0,0 +1,57 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package server
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/rpc/client/local"
+
+	"github.com/evmos/evmos/v12/rpc/namespaces/ethereum/admin"
+)
+
+// nodeClient is the Tendermint client of the in-process node. It extends the
+// local client with the removal of peers used by the admin namespace, which is
+// not exposed by the Tendermint RPC.
+type nodeClient struct {
+	*local.Local
+	sw *p2p.Switch
+}
+
+var _ admin.PeerRemover = nodeClient{}
+
+// newNodeClient creates a new client of the in-process node.
+func newNodeClient(tmNode *node.Node) nodeClient {
+	return nodeClient{
+		Local: local.New(tmNode),
+		sw:    tmNode.Switch(),
+	}
+}
+
+// RemovePeer implements the admin.PeerRemover interface. The peer is disconnected
+// gracefully, so it's not redialed. A peer configured as persistent stays in the
+// persistent peers of the node, as Tendermint can't remove them.
+func (c nodeClient) RemovePeer(_ context.Context, id p2p.ID) (bool, error) {
+	peer := c.sw.Peers().Get(id)
+	if peer == nil {
+		return false, nil
+	}
+
+	c.sw.StopPeerGracefully(peer)
+	return true, nil
+}
@@ -17,6 +17,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -24,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -37,17 +39,19 @@ import (
 
 	abciserver "github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/cometbft/commands"
+	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
-	"github.com/tendermint/tendermint/rpc/client/local"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 
 	errorsmod "cosmossdk.io/errors"
@@ -60,17 +64,30 @@ import (
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/evmos/evmos/v12/indexer"
+	"github.com/evmos/evmos/v12/rpc/backend"
 	ethdebug "github.com/evmos/evmos/v12/rpc/namespaces/ethereum/debug"
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
 	"github.com/evmos/evmos/v12/server/config"
 	srvflags "github.com/evmos/evmos/v12/server/flags"
+	"github.com/evmos/evmos/v12/statetrie"
 	evmostypes "github.com/evmos/evmos/v12/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
 )
 
 // DBOpener is a function to open `application.db`, potentially with customized options.
 type DBOpener func(opts types.AppOptions, rootDir string, backend dbm.BackendType) (dbm.DB, error)
 
+// BlockTxsApplication defines an Application that executes speculatively the
+// transactions of the blocks delivered by the node, read from its block store.
+type BlockTxsApplication interface {
+	types.Application
+	SetBlockTxs(txDecoder sdk.TxDecoder, blockTxs func(height int64) [][]byte)
+}
+
 // StartOptions defines options that can be customized in `StartCmd`
 type StartOptions struct {
 	AppCreator      types.AppCreator
@@ -209,10 +226,23 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Int(srvflags.JSONRPCTxStatusCap, config.DefaultTxStatusCap, "Sets the max number of submitted transactions whose lifecycle status is tracked (0=disabled)") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCSyntheticLogs, false, "Return the balance changes of Cosmos transactions as synthetic ERC-20 Transfer logs")
+	cmd.Flags().Bool(srvflags.JSONRPCSyntheticTxs, false, "Index the synthetic transactions of the synthetic logs (requires the custom tx indexer)")
+	cmd.Flags().String(srvflags.JSONRPCKeystoreDir, "", "Sets the directory of the encrypted keystore used by the personal namespace (default: <home>/keystore)") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
-
-	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCExternalSigner, "", "Sets the endpoint (HTTP URL or IPC path) of a Clef-compatible external signer used by the signing methods") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCABIRegistryDir, "", "Sets the directory of the node-local contract ABI registry (default: <home>/abi-registry)")                 //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCRelayerAddress, "", "Sets the hex address of the node account that relays the forward requests of the relay namespace")          //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRelayAllowedContracts, []string{}, "Sets the contracts whose forward requests are relayed (default: none)")                 //nolint:lll
+	cmd.Flags().Uint64(srvflags.JSONRPCRelayMaxGas, 0, "Sets the maximum gas of the relayed forward requests (0=unlimited)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableStateTrie, false, "Enable the node-local Ethereum state trie used for the state roots and proofs of json-rpc")
+	cmd.Flags().Int(srvflags.JSONRPCStateTrieKeepRecent, config.DefaultStateTrieKeepRecent, "Sets the number of recent heights whose state trie is kept")
+
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown|gas_profile)") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                             //nolint:lll
+	cmd.Flags().String(srvflags.EVMParallelExecution, config.DefaultParallelExecution, "the mode of the parallel execution of the eth txs of a block (off|on|validate)")                                 //nolint:lll
+	cmd.Flags().Int(srvflags.EVMParallelWorkers, config.DefaultParallelWorkers, "the maximum number of eth txs executed concurrently (0 = number of CPUs)")                                              //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -364,6 +394,25 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		gRPCOnly = ctx.Viper.GetBool(srvflags.GRPCOnly)
 	)
 
+	// the state trie must be registered within the application before Tendermint
+	// replays any block
+	var stateTrie backend.StateTrie
+	if config.JSONRPC.EnableStateTrie && !gRPCOnly {
+		service, err := startStateTrie(home, config.JSONRPC.StateTrieKeepRecent, app, ctx.Logger.With("module", "statetrie"))
+		if err != nil {
+			logger.Error("failed to start the state trie", "error", err.Error())
+			return err
+		}
+
+		defer func() {
+			if err := service.Close(); err != nil {
+				logger.Error("failed to close the state trie", "error", err.Error())
+			}
+		}()
+
+		stateTrie = service
+	}
+
 	if gRPCOnly {
 		logger.Info("starting node in query only mode; Tendermint is disabled")
 		config.GRPC.Enable = true
@@ -386,6 +435,23 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 			return err
 		}
 
+		// the blocks are saved before being delivered to the application
+		if a, ok := app.(BlockTxsApplication); ok {
+			blockStore := tmNode.BlockStore()
+			a.SetBlockTxs(clientCtx.TxConfig.TxDecoder(), func(height int64) [][]byte {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return nil
+				}
+
+				txs := make([][]byte, len(block.Txs))
+				for i, tx := range block.Txs {
+					txs[i] = tx
+				}
+				return txs
+			})
+		}
+
 		if err := tmNode.Start(); err != nil {
 			logger.Error("failed start tendermint server", "error", err.Error())
 			return err
@@ -402,7 +468,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// service if API or gRPC or JSONRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local tendermint RPC client.
 	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && tmNode != nil {
-		clientCtx = clientCtx.WithClient(local.New(tmNode))
+		clientCtx = clientCtx.WithClient(newNodeClient(tmNode))
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
@@ -432,7 +498,11 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		}
 
 		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIndexer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if config.JSONRPC.SyntheticTxs {
+			kvIndexer.EnableSyntheticTxs(rpctypes.NewSyntheticTokensQuerier(clientCtx))
+		}
+		idxer = kvIndexer
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
 		indexerService.SetLogger(idxLogger)
 
@@ -565,7 +635,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, stateTrie)
 		if err != nil {
 			return err
 		}
@@ -655,6 +725,42 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenStateTrieDB opens the database of the node-local Ethereum state trie
+func OpenStateTrieDB(rootDir string) (ethdb.Database, error) {
+	return rawdb.NewLevelDBDatabase(filepath.Join(rootDir, "data", "evmstatetrie.db"), 0, 0, "", false)
+}
+
+// startStateTrie registers the Ethereum state trie service within the application
+// and starts it.
+func startStateTrie(rootDir string, keepRecent int, app types.Application, logger log.Logger) (*statetrie.Service, error) {
+	stApp, ok := app.(statetrie.Application)
+	if !ok {
+		return nil, errors.New("the application doesn't support the Ethereum state trie")
+	}
+
+	db, err := OpenStateTrieDB(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	service := statetrie.NewService(
+		db,
+		keepRecent,
+		stApp.CommitMultiStore(),
+		stApp.AppCodec(),
+		stApp.GetKey(authtypes.StoreKey),
+		stApp.GetKey(banktypes.StoreKey),
+		stApp.GetKey(evmtypes.StoreKey),
+		logger,
+	)
+	stApp.SetStreamingService(service)
+
+	if err := service.Stream(&sync.WaitGroup{}); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -0,0 +1,122 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package statetrie
+
+import (
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// changeSet contains the accounts and storage slots written to the stores
+// since the last committed block. Only the keys are tracked: the values are
+// read from the committed stores, as the listeners are also notified of the
+// writes to the CheckTx state.
+type changeSet struct {
+	// accounts maps the written accounts to their written storage slots
+	accounts map[common.Address]map[common.Hash]struct{}
+	// params is set if the EVM params, and thus the EVM denom, were written
+	params bool
+}
+
+func newChangeSet() *changeSet {
+	return &changeSet{accounts: make(map[common.Address]map[common.Hash]struct{})}
+}
+
+func (cs *changeSet) addAccount(addr common.Address) map[common.Hash]struct{} {
+	slots, ok := cs.accounts[addr]
+	if !ok {
+		slots = make(map[common.Hash]struct{})
+		cs.accounts[addr] = slots
+	}
+	return slots
+}
+
+func (cs *changeSet) addSlot(addr common.Address, slot common.Hash) {
+	cs.addAccount(addr)[slot] = struct{}{}
+}
+
+// changeListener records the accounts and storage slots written to the auth,
+// bank and EVM stores.
+type changeListener struct {
+	mu      sync.Mutex
+	changes *changeSet
+
+	authKey storetypes.StoreKey
+	bankKey storetypes.StoreKey
+	evmKey  storetypes.StoreKey
+}
+
+var _ storetypes.WriteListener = &changeListener{}
+
+func newChangeListener(authKey, bankKey, evmKey storetypes.StoreKey) *changeListener {
+	return &changeListener{
+		changes: newChangeSet(),
+		authKey: authKey,
+		bankKey: bankKey,
+		evmKey:  evmKey,
+	}
+}
+
+// OnWrite implements storetypes.WriteListener.
+func (l *changeListener) OnWrite(storeKey storetypes.StoreKey, key, _ []byte, _ bool) error {
+	if len(key) == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch storeKey {
+	case l.authKey:
+		// prefix | address
+		if key[0] == authtypes.AddressStoreKeyPrefix[0] && len(key) == 1+common.AddressLength {
+			l.changes.addAccount(common.BytesToAddress(key[1:]))
+		}
+	case l.bankKey:
+		// prefix | address length | address | denom
+		if key[0] == banktypes.BalancesPrefix[0] && len(key) > 2+common.AddressLength && key[1] == common.AddressLength {
+			l.changes.addAccount(common.BytesToAddress(key[2 : 2+common.AddressLength]))
+		}
+	case l.evmKey:
+		switch {
+		// prefix | address | slot
+		case key[0] == evmtypes.KeyPrefixStorage[0] && len(key) == 1+common.AddressLength+common.HashLength:
+			l.changes.addSlot(
+				common.BytesToAddress(key[1:1+common.AddressLength]),
+				common.BytesToHash(key[1+common.AddressLength:]),
+			)
+		case key[0] == evmtypes.KeyPrefixParams[0] && len(key) == 1:
+			l.changes.params = true
+		}
+	}
+	return nil
+}
+
+// flush returns the recorded changes and resets the change set.
+func (l *changeListener) flush() *changeSet {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	changes := l.changes
+	l.changes = newChangeSet()
+	return changes
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package statetrie
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// account is the Ethereum representation of an account of the auth module.
+type account struct {
+	Nonce    uint64
+	Balance  *big.Int
+	CodeHash common.Hash
+}
+
+// stateReader reads the Ethereum accounts, code and storage from the
+// committed auth, bank and EVM stores of a height.
+type stateReader struct {
+	cdc   codec.Codec
+	auth  sdk.KVStore
+	bank  sdk.KVStore
+	evm   sdk.KVStore
+	denom string
+}
+
+func newStateReader(cdc codec.Codec, auth, bank, evm sdk.KVStore) (*stateReader, error) {
+	bz := evm.Get(evmtypes.KeyPrefixParams)
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("EVM params not found")
+	}
+
+	var params evmtypes.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return nil, err
+	}
+
+	return &stateReader{
+		cdc:   cdc,
+		auth:  auth,
+		bank:  bank,
+		evm:   evm,
+		denom: params.EvmDenom,
+	}, nil
+}
+
+// account returns the account of the given address, or nil if it doesn't exist.
+func (r *stateReader) account(addr common.Address) (*account, error) {
+	bz := r.auth.Get(authtypes.AddressStoreKey(addr.Bytes()))
+	if bz == nil {
+		return nil, nil
+	}
+	return r.decodeAccount(addr, bz)
+}
+
+func (r *stateReader) decodeAccount(addr common.Address, bz []byte) (*account, error) {
+	var acc authtypes.AccountI
+	if err := r.cdc.UnmarshalInterface(bz, &acc); err != nil {
+		return nil, err
+	}
+
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if ethAcc, ok := acc.(evmostypes.EthAccountI); ok {
+		codeHash = ethAcc.GetCodeHash()
+	}
+
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(addr.Bytes()), r.denom...)
+	balance, err := bankkeeper.UnmarshalBalanceCompat(r.cdc, r.bank.Get(balanceKey), r.denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account{
+		Nonce:    acc.GetSequence(),
+		Balance:  balance.Amount.BigInt(),
+		CodeHash: codeHash,
+	}, nil
+}
+
+// code returns the contract code of the given code hash.
+func (r *stateReader) code(codeHash common.Hash) []byte {
+	return r.evm.Get(append(evmtypes.KeyPrefixCode, codeHash.Bytes()...))
+}
+
+// state returns the value of the given storage slot.
+func (r *stateReader) state(addr common.Address, slot common.Hash) common.Hash {
+	return common.BytesToHash(r.evm.Get(evmtypes.StateKey(addr, slot.Bytes())))
+}
+
+// iterateAccounts iterates over all the accounts with an Ethereum address.
+func (r *stateReader) iterateAccounts(cb func(addr common.Address, acc *account) error) error {
+	iterator := sdk.KVStorePrefixIterator(r.auth, authtypes.AddressStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) != 1+common.AddressLength {
+			continue
+		}
+
+		addr := common.BytesToAddress(key[1:])
+		acc, err := r.decodeAccount(addr, iterator.Value())
+		if err != nil {
+			return err
+		}
+		if err := cb(addr, acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iterateStorage iterates over the storage of all the contracts.
+func (r *stateReader) iterateStorage(cb func(addr common.Address, slot, value common.Hash) error) error {
+	iterator := sdk.KVStorePrefixIterator(r.evm, evmtypes.KeyPrefixStorage)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) != 1+common.AddressLength+common.HashLength {
+			continue
+		}
+
+		addr := common.BytesToAddress(key[1 : 1+common.AddressLength])
+		slot := common.BytesToHash(key[1+common.AddressLength:])
+		if err := cb(addr, slot, common.BytesToHash(iterator.Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
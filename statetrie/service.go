@@ -0,0 +1,679 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package statetrie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/trie"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+const (
+	// flushInterval is the number of accounts and storage slots written to the
+	// trie before its nodes are committed when the trie is rebuilt.
+	flushInterval = 10_000
+	// dirtyLimit is the memory size of the trie nodes above which they're
+	// flushed to disk.
+	dirtyLimit = 256 * 1024 * 1024
+	// MinAppKeepRecent is the minimum number of recent versions kept by the
+	// application pruning, so that the trie can read the committed state of
+	// the blocks applied in the background.
+	MinAppKeepRecent = 100
+)
+
+// latestKey is the key of the height and root of the trie flushed to disk.
+var latestKey = []byte("statetrie-latest")
+
+// ErrHeightNotAvailable is returned when the state trie doesn't contain the
+// state of the requested height.
+var ErrHeightNotAvailable = errors.New("state trie not available for the given height")
+
+// errPruningLag is returned when the trie is too far behind the application to
+// read the state of the pending blocks before it's pruned.
+var errPruningLag = errors.New("the state trie fell behind the application pruning, increase pruning-keep-recent")
+
+// Application defines the methods of the application used to feed the state
+// trie with the committed blocks.
+type Application interface {
+	SetStreamingService(baseapp.StreamingService)
+	CommitMultiStore() storetypes.CommitMultiStore
+	AppCodec() codec.Codec
+	GetKey(storeKey string) *storetypes.KVStoreKey
+}
+
+// block is a committed block that is pending to be applied to the trie.
+type block struct {
+	height  int64
+	changes *changeSet
+}
+
+// stateRoot is the root of the trie after the execution of a block.
+type stateRoot struct {
+	height int64
+	root   common.Hash
+}
+
+// Service maintains an Ethereum Merkle-Patricia trie over the accounts and the
+// contract storage of the chain, so that it can serve Ethereum-compatible state
+// roots and account proofs. The trie is local to the node and is not part of
+// the consensus state.
+//
+// The Service is registered as a streaming service of the application: its
+// listeners record the accounts and storage slots written by each block, and
+// the trie is updated with their committed values in the background after the
+// block is committed. The trie is rebuilt from the committed stores when it is
+// behind the application, e.g. when the service is enabled on an existing node.
+//
+// Like the state of a go-ethereum full node, the tries of the recent blocks are
+// kept in memory and the older ones are garbage collected. Only the latest trie
+// is flushed to disk, when the service is closed or the trie is rebuilt, and
+// the nodes of the previous tries are removed from the disk on rebuilds.
+type Service struct {
+	logger     log.Logger
+	db         ethdb.Database
+	keepRecent int
+
+	cms      storetypes.CommitMultiStore
+	cdc      codec.Codec
+	authKey  storetypes.StoreKey
+	bankKey  storetypes.StoreKey
+	evmKey   storetypes.StoreKey
+	listener *changeListener
+
+	mu       sync.Mutex
+	pending  []block
+	disabled bool
+	notify   chan struct{}
+	quit     chan struct{}
+	wg       sync.WaitGroup
+
+	// rootsMu protects the trie database and the roots of the recent blocks,
+	// oldest first, which are read by the queries
+	rootsMu sync.RWMutex
+	stateDB state.Database
+	roots   []stateRoot
+
+	// height and root of the latest block applied to the trie, only accessed
+	// by the update goroutine once started
+	height int64
+	root   common.Hash
+}
+
+var _ baseapp.StreamingService = &Service{}
+
+// NewService creates a new state trie service that stores the trie on the
+// given database and reads the committed state from the given stores. The
+// tries of the given number of recent blocks are kept.
+func NewService(
+	db ethdb.Database,
+	keepRecent int,
+	cms storetypes.CommitMultiStore,
+	cdc codec.Codec,
+	authKey, bankKey, evmKey storetypes.StoreKey,
+	logger log.Logger,
+) *Service {
+	if keepRecent < 1 {
+		keepRecent = 1
+	}
+
+	return &Service{
+		logger:     logger,
+		db:         db,
+		keepRecent: keepRecent,
+		stateDB:    state.NewDatabase(db),
+		cms:        cms,
+		cdc:        cdc,
+		authKey:    authKey,
+		bankKey:    bankKey,
+		evmKey:     evmKey,
+		listener:   newChangeListener(authKey, bankKey, evmKey),
+		notify:     make(chan struct{}, 1),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Listeners implements baseapp.StreamingService.
+func (s *Service) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		s.authKey: {s.listener},
+		s.bankKey: {s.listener},
+		s.evmKey:  {s.listener},
+	}
+}
+
+// Stream implements baseapp.StreamingService. It starts the goroutine that
+// applies the committed blocks to the trie, after rebuilding the trie if it
+// isn't at the latest height of the application. It must be called before the
+// application processes any block. It fails if the application doesn't keep
+// at least MinAppKeepRecent versions.
+func (s *Service) Stream(wg *sync.WaitGroup) error {
+	pruning := s.cms.GetPruning()
+	if pruning.Strategy != pruningtypes.PruningNothing && pruning.KeepRecent < MinAppKeepRecent {
+		return fmt.Errorf(
+			"the state trie requires the application to keep at least %d recent versions, got %d",
+			MinAppKeepRecent, pruning.KeepRecent,
+		)
+	}
+
+	start := s.cms.LastCommitID().Version
+
+	wg.Add(1)
+	s.wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer s.wg.Done()
+		s.run(start)
+	}()
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (s *Service) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *Service) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *Service) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener. It queues the changes of the
+// committed block to be applied to the trie.
+func (s *Service) ListenCommit(context.Context, abci.ResponseCommit) error {
+	b := block{
+		height:  s.cms.LastCommitID().Version,
+		changes: s.listener.flush(),
+	}
+
+	s.mu.Lock()
+	if !s.disabled {
+		s.pending = append(s.pending, b)
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Close implements io.Closer. It stops the update goroutine, flushes the latest
+// trie to disk and closes the trie database.
+func (s *Service) Close() error {
+	close(s.quit)
+	s.wg.Wait()
+
+	if s.height > 0 {
+		if err := s.flush(); err != nil {
+			s.logger.Error("failed to flush the state trie", "height", s.height, "error", err.Error())
+		}
+	}
+	return s.db.Close()
+}
+
+// StateRoot returns the root of the state trie after the execution of the
+// block at the given height. Only the roots of the recent blocks are available.
+func (s *Service) StateRoot(height int64) (common.Hash, error) {
+	s.rootsMu.RLock()
+	defer s.rootsMu.RUnlock()
+
+	for _, r := range s.roots {
+		if r.height == height {
+			return r.root, nil
+		}
+	}
+	return common.Hash{}, ErrHeightNotAvailable
+}
+
+// GetProof returns the account and storage values of the given address with
+// their Merkle proofs against the state root of the given height.
+func (s *Service) GetProof(height int64, address common.Address, storageKeys []string) (*rpctypes.AccountResult, error) {
+	root, err := s.StateRoot(height)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rootsMu.RLock()
+	stateDB := s.stateDB
+	s.rootsMu.RUnlock()
+
+	res, err := getProof(stateDB, root, address, storageKeys)
+	var missingErr *trie.MissingNodeError
+	if errors.As(err, &missingErr) {
+		// the trie was garbage collected in the meantime
+		return nil, ErrHeightNotAvailable
+	}
+	return res, err
+}
+
+// getProof returns the account and storage values of the given address with
+// their Merkle proofs against the given root.
+func getProof(stateDB state.Database, root common.Hash, address common.Address, storageKeys []string) (*rpctypes.AccountResult, error) {
+	sdb, err := state.New(root, stateDB, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, err := sdb.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	storageHash := ethtypes.EmptyRootHash
+	storageTrie := sdb.StorageTrie(address)
+	if storageTrie != nil {
+		storageHash = storageTrie.Hash()
+	}
+
+	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
+	for i, key := range storageKeys {
+		slot := common.HexToHash(key)
+
+		var proof [][]byte
+		if storageTrie != nil {
+			proof, err = sdb.GetStorageProof(address, slot)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		storageProofs[i] = rpctypes.StorageResult{
+			Key:   key,
+			Value: (*hexutil.Big)(sdb.GetState(address, slot).Big()),
+			Proof: toHexSlice(proof),
+		}
+	}
+
+	if err := sdb.Error(); err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.AccountResult{
+		Address:      address,
+		AccountProof: toHexSlice(accountProof),
+		Balance:      (*hexutil.Big)(sdb.GetBalance(address)),
+		CodeHash:     sdb.GetCodeHash(address),
+		Nonce:        hexutil.Uint64(sdb.GetNonce(address)),
+		StorageHash:  storageHash,
+		StorageProof: storageProofs,
+	}, nil
+}
+
+// run initializes the trie at the given height and applies the committed
+// blocks until the service is closed.
+func (s *Service) run(start int64) {
+	if err := s.init(start); err != nil {
+		s.logger.Error("failed to initialize the state trie, disabling it", "height", start, "error", err.Error())
+		s.disable()
+		return
+	}
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-s.notify:
+		}
+
+		s.mu.Lock()
+		pending := s.pending
+		s.pending = nil
+		s.mu.Unlock()
+
+		if len(pending) == 0 {
+			continue
+		}
+
+		rebuilding, err := s.applyBlocks(pending)
+		switch {
+		case err == nil:
+		case rebuilding || errors.Is(err, errPruningLag):
+			// rebuilding the trie again on the next blocks would fail the same way
+			s.logger.Error("failed to update the state trie, disabling it", "height", s.height, "error", err.Error())
+			s.disable()
+			return
+		default:
+			s.logger.Error("failed to update the state trie", "height", s.height, "error", err.Error())
+			// rebuild the trie on the next block
+			s.height = -1
+		}
+	}
+}
+
+// disable stops recording the committed blocks and removes the recent roots,
+// so that the queries no longer use the trie.
+func (s *Service) disable() {
+	s.mu.Lock()
+	s.disabled = true
+	s.pending = nil
+	s.mu.Unlock()
+
+	s.rootsMu.Lock()
+	s.roots = nil
+	s.rootsMu.Unlock()
+	s.height = -1
+}
+
+// applyBlocks applies the changes of the committed blocks to the trie. The trie
+// is rebuilt at the latest height if it isn't at the height preceding a block,
+// or if the EVM denom may have changed. It returns true if the trie was being
+// rebuilt, and errPruningLag if the state of the blocks may be pruned by the
+// application before it's read.
+func (s *Service) applyBlocks(blocks []block) (bool, error) {
+	latest := blocks[len(blocks)-1].height
+
+	pruning := s.cms.GetPruning()
+	if pruning.Strategy != pruningtypes.PruningNothing && latest-blocks[0].height >= int64(pruning.KeepRecent)/2 { //#nosec G701 -- keep-recent is small
+		return false, errPruningLag
+	}
+
+	for _, b := range blocks {
+		switch {
+		case b.height <= s.height:
+			// the block is already included in a rebuilt trie
+			continue
+		case b.height != s.height+1 || b.changes.params:
+			return true, s.rebuild(latest)
+		}
+
+		if err := s.applyBlock(b); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// applyBlock applies the changes of a committed block to the trie.
+func (s *Service) applyBlock(b block) error {
+	reader, err := s.reader(b.height)
+	if err != nil {
+		return err
+	}
+
+	sdb, err := state.New(s.root, s.stateDB, nil)
+	if err != nil {
+		return err
+	}
+
+	for addr, slots := range b.changes.accounts {
+		acc, err := reader.account(addr)
+		if err != nil {
+			return err
+		}
+
+		if acc == nil {
+			// the account was deleted along with its storage
+			sdb.Suicide(addr)
+			continue
+		}
+
+		if err := setAccount(sdb, reader, addr, acc); err != nil {
+			return err
+		}
+		for slot := range slots {
+			sdb.SetState(addr, slot, reader.state(addr, slot))
+		}
+	}
+
+	return s.commit(sdb, b.height)
+}
+
+// init loads the trie flushed to disk, or rebuilds it if it isn't at the given
+// height of the application.
+func (s *Service) init(start int64) error {
+	bz, err := s.db.Get(latestKey)
+	if err == nil && len(bz) == 8+common.HashLength {
+		height := int64(sdk.BigEndianToUint64(bz[:8])) //#nosec G701 -- the height is always positive
+		root := common.BytesToHash(bz[8:])
+		if _, err := s.stateDB.OpenTrie(root); height == start && err == nil {
+			s.height, s.root = height, root
+			s.addRoot(stateRoot{height: height, root: root})
+			return nil
+		}
+	}
+
+	if start == 0 {
+		s.height, s.root = 0, ethtypes.EmptyRootHash
+		return nil
+	}
+	return s.rebuild(start)
+}
+
+// rebuild builds the trie from scratch with the committed state of the given
+// height, after removing the previous tries, and flushes it to disk.
+func (s *Service) rebuild(height int64) error {
+	s.logger.Info("rebuilding the state trie", "height", height)
+
+	if err := s.reset(); err != nil {
+		return err
+	}
+
+	reader, err := s.reader(height)
+	if err != nil {
+		return err
+	}
+
+	sdb, err := state.New(ethtypes.EmptyRootHash, s.stateDB, nil)
+	if err != nil {
+		return err
+	}
+
+	// commit the trie periodically to bound the memory usage, the nodes of the
+	// intermediate tries are garbage collected
+	triedb := s.stateDB.TrieDB()
+	intermediate := common.Hash{}
+	count := 0
+	flush := func() error {
+		count++
+		if count%flushInterval != 0 {
+			return nil
+		}
+
+		root, err := sdb.Commit(true)
+		if err != nil {
+			return err
+		}
+		triedb.Reference(root, common.Hash{})
+		if intermediate != (common.Hash{}) {
+			triedb.Dereference(intermediate)
+		}
+		intermediate = root
+
+		if nodes, _ := triedb.Size(); nodes > dirtyLimit {
+			if err := triedb.Cap(dirtyLimit - ethdb.IdealBatchSize); err != nil {
+				return err
+			}
+		}
+
+		sdb, err = state.New(root, s.stateDB, nil)
+		return err
+	}
+
+	err = reader.iterateAccounts(func(addr common.Address, acc *account) error {
+		if err := setAccount(sdb, reader, addr, acc); err != nil {
+			return err
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	err = reader.iterateStorage(func(addr common.Address, slot, value common.Hash) error {
+		if !sdb.Exist(addr) {
+			return nil
+		}
+		sdb.SetState(addr, slot, value)
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.commit(sdb, height); err != nil {
+		return err
+	}
+	if intermediate != (common.Hash{}) {
+		triedb.Dereference(intermediate)
+	}
+	return s.flush()
+}
+
+// reset removes the tries from the memory and the disk.
+func (s *Service) reset() error {
+	s.rootsMu.Lock()
+	defer s.rootsMu.Unlock()
+
+	s.roots = nil
+	s.height, s.root = -1, common.Hash{}
+
+	it := s.db.NewIterator(nil, nil)
+	defer it.Release()
+
+	batch := s.db.NewBatch()
+	for it.Next() {
+		if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+			return err
+		}
+		if batch.ValueSize() >= ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	// drop the nodes cached in memory along with the trie database
+	s.stateDB = state.NewDatabase(s.db)
+	return nil
+}
+
+// reader returns a reader of the committed state of the given height.
+func (s *Service) reader(height int64) (*stateReader, error) {
+	ms, err := s.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, err
+	}
+	return newStateReader(s.cdc, ms.GetKVStore(s.authKey), ms.GetKVStore(s.bankKey), ms.GetKVStore(s.evmKey))
+}
+
+// commit commits the trie in memory and records its root as the state root of
+// the given height. The tries of the blocks older than the recent ones are
+// garbage collected, and the trie nodes are flushed to disk if they exceed the
+// memory limit.
+func (s *Service) commit(sdb *state.StateDB, height int64) error {
+	root, err := sdb.Commit(true)
+	if err != nil {
+		return err
+	}
+
+	triedb := s.stateDB.TrieDB()
+	triedb.Reference(root, common.Hash{})
+	s.addRoot(stateRoot{height: height, root: root})
+
+	if nodes, _ := triedb.Size(); nodes > dirtyLimit {
+		if err := triedb.Cap(dirtyLimit - ethdb.IdealBatchSize); err != nil {
+			return err
+		}
+	}
+
+	s.height, s.root = height, root
+	return nil
+}
+
+// addRoot records the root of a block and garbage collects the tries of the
+// blocks that are no longer recent.
+func (s *Service) addRoot(r stateRoot) {
+	s.rootsMu.Lock()
+	s.roots = append(s.roots, r)
+	var removed []stateRoot
+	if len(s.roots) > s.keepRecent {
+		removed = s.roots[:len(s.roots)-s.keepRecent]
+		s.roots = append([]stateRoot(nil), s.roots[len(s.roots)-s.keepRecent:]...)
+	}
+	s.rootsMu.Unlock()
+
+	for _, old := range removed {
+		s.stateDB.TrieDB().Dereference(old.root)
+	}
+}
+
+// flush writes the latest trie to disk along with its height and root.
+func (s *Service) flush() error {
+	if err := s.stateDB.TrieDB().Commit(s.root, false, nil); err != nil {
+		return err
+	}
+
+	value := make([]byte, 0, 8+common.HashLength)
+	value = append(value, sdk.Uint64ToBigEndian(uint64(s.height))...) //#nosec G701 -- the height is always positive
+	value = append(value, s.root.Bytes()...)
+	return s.db.Put(latestKey, value)
+}
+
+// setAccount sets the nonce, balance and code of an account in the trie.
+func setAccount(sdb *state.StateDB, reader *stateReader, addr common.Address, acc *account) error {
+	sdb.SetNonce(addr, acc.Nonce)
+	sdb.SetBalance(addr, acc.Balance)
+
+	if acc.CodeHash == sdb.GetCodeHash(addr) {
+		return nil
+	}
+
+	code := reader.code(acc.CodeHash)
+	if len(code) == 0 && acc.CodeHash != common.BytesToHash(evmtypes.EmptyCodeHash) {
+		return fmt.Errorf("code %s of account %s not found", acc.CodeHash, addr)
+	}
+	sdb.SetCode(addr, code)
+	return nil
+}
+
+func toHexSlice(b [][]byte) []string {
+	r := make([]string, len(b))
+	for i := range b {
+		r[i] = hexutil.Encode(b[i])
+	}
+	return r
+}
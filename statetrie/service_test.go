@@ -0,0 +1,267 @@
+package statetrie_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/encoding"
+	"github.com/evmos/evmos/v12/statetrie"
+	evmostypes "github.com/evmos/evmos/v12/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+var _ statetrie.Application = (*app.Evmos)(nil)
+
+type testChain struct {
+	cdc     codec.Codec
+	cms     storetypes.CommitMultiStore
+	authKey *storetypes.KVStoreKey
+	bankKey *storetypes.KVStoreKey
+	evmKey  *storetypes.KVStoreKey
+}
+
+func newTestChain(t *testing.T) *testChain {
+	chain := &testChain{
+		cdc:     encoding.MakeConfig(app.ModuleBasics).Codec,
+		cms:     store.NewCommitMultiStore(dbm.NewMemDB()),
+		authKey: sdk.NewKVStoreKey(authtypes.StoreKey),
+		bankKey: sdk.NewKVStoreKey(banktypes.StoreKey),
+		evmKey:  sdk.NewKVStoreKey(evmtypes.StoreKey),
+	}
+	for _, key := range []storetypes.StoreKey{chain.authKey, chain.bankKey, chain.evmKey} {
+		chain.cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, chain.cms.LoadLatestVersion())
+	return chain
+}
+
+// newService creates a state trie service and registers its listeners like the
+// application does.
+func (chain *testChain) newService(t *testing.T) *statetrie.Service {
+	service := chain.startService(t, rawdb.NewMemoryDatabase(), 128)
+	t.Cleanup(func() { require.NoError(t, service.Close()) })
+	return service
+}
+
+// startService creates and starts a state trie service on the given database.
+func (chain *testChain) startService(t *testing.T, db ethdb.Database, keepRecent int) *statetrie.Service {
+	service := statetrie.NewService(db, keepRecent, chain.cms, chain.cdc, chain.authKey, chain.bankKey, chain.evmKey, log.NewNopLogger())
+	for key, listeners := range service.Listeners() {
+		chain.cms.AddListeners(key, listeners)
+	}
+	require.NoError(t, service.Stream(&sync.WaitGroup{}))
+	return service
+}
+
+// commit writes the block changes to the stores and commits them.
+func (chain *testChain) commit(t *testing.T, service *statetrie.Service, write func(ms sdk.MultiStore)) int64 {
+	ms := chain.cms.CacheMultiStore()
+	write(ms)
+	ms.Write()
+	height := chain.cms.Commit().Version
+	require.NoError(t, service.ListenCommit(context.Background(), abci.ResponseCommit{}))
+	return height
+}
+
+func (chain *testChain) setAccount(ms sdk.MultiStore, addr common.Address, nonce uint64, balance int64, code []byte) {
+	acc := &evmostypes.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(addr.Bytes(), nil, 0, nonce),
+		CodeHash:    crypto.Keccak256Hash(code).Hex(),
+	}
+	bz, err := chain.cdc.MarshalInterface(acc)
+	if err != nil {
+		panic(err)
+	}
+	ms.GetKVStore(chain.authKey).Set(authtypes.AddressStoreKey(addr.Bytes()), bz)
+
+	coin := sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, balance)
+	key := append(banktypes.CreateAccountBalancesPrefix(addr.Bytes()), evmtypes.DefaultEVMDenom...)
+	ms.GetKVStore(chain.bankKey).Set(key, chain.cdc.MustMarshal(&coin))
+
+	if len(code) > 0 {
+		ms.GetKVStore(chain.evmKey).Set(append(evmtypes.KeyPrefixCode, crypto.Keccak256(code)...), code)
+	}
+}
+
+func waitForRoot(t *testing.T, service *statetrie.Service, height int64) common.Hash {
+	var root common.Hash
+	require.Eventually(t, func() bool {
+		var err error
+		root, err = service.StateRoot(height)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return root
+}
+
+// verifyProof verifies a Merkle proof against the given root and returns the
+// proven value.
+func verifyProof(t *testing.T, root common.Hash, key []byte, proof []string) []byte {
+	proofDB := memorydb.New()
+	for _, node := range proof {
+		bz := hexutil.MustDecode(node)
+		require.NoError(t, proofDB.Put(crypto.Keccak256(bz), bz))
+	}
+	value, err := trie.VerifyProof(root, crypto.Keccak256(key), proofDB)
+	require.NoError(t, err)
+	return value
+}
+
+func TestService(t *testing.T) {
+	chain := newTestChain(t)
+	service := chain.newService(t)
+
+	eoa := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000def")
+	code := []byte{0x60, 0x00}
+	slot1 := common.HexToHash("0x01")
+	slot2 := common.HexToHash("0x02")
+
+	// genesis block
+	height := chain.commit(t, service, func(ms sdk.MultiStore) {
+		params := evmtypes.DefaultParams()
+		ms.GetKVStore(chain.evmKey).Set(evmtypes.KeyPrefixParams, chain.cdc.MustMarshal(&params))
+
+		chain.setAccount(ms, eoa, 1, 100, nil)
+		chain.setAccount(ms, contract, 1, 0, code)
+		ms.GetKVStore(chain.evmKey).Set(evmtypes.StateKey(contract, slot1.Bytes()), common.HexToHash("0x2a").Bytes())
+	})
+
+	// the expected state is computed with the go-ethereum state
+	expState, err := state.New(ethtypes.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	expState.SetNonce(eoa, 1)
+	expState.SetBalance(eoa, big.NewInt(100))
+	expState.SetNonce(contract, 1)
+	expState.SetCode(contract, code)
+	expState.SetState(contract, slot1, common.HexToHash("0x2a"))
+	expRoot, err := expState.Commit(true)
+	require.NoError(t, err)
+	require.Equal(t, expRoot, waitForRoot(t, service, height))
+
+	// the account is deleted and the contract storage is updated
+	height = chain.commit(t, service, func(ms sdk.MultiStore) {
+		ms.GetKVStore(chain.authKey).Delete(authtypes.AddressStoreKey(eoa.Bytes()))
+		ms.GetKVStore(chain.evmKey).Delete(evmtypes.StateKey(contract, slot1.Bytes()))
+		ms.GetKVStore(chain.evmKey).Set(evmtypes.StateKey(contract, slot2.Bytes()), common.HexToHash("0x07").Bytes())
+	})
+
+	expState.Suicide(eoa)
+	expState.SetState(contract, slot1, common.Hash{})
+	expState.SetState(contract, slot2, common.HexToHash("0x07"))
+	expRoot, err = expState.Commit(true)
+	require.NoError(t, err)
+	root := waitForRoot(t, service, height)
+	require.Equal(t, expRoot, root)
+
+	// the proofs are verified against the state root
+	res, err := service.GetProof(height, contract, []string{slot2.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), uint64(res.Nonce))
+	require.Equal(t, crypto.Keccak256Hash(code), res.CodeHash)
+
+	var acc ethtypes.StateAccount
+	require.NoError(t, rlp.DecodeBytes(verifyProof(t, root, contract.Bytes(), res.AccountProof), &acc))
+	require.Equal(t, res.StorageHash, acc.Root)
+	require.Equal(t, crypto.Keccak256(code), acc.CodeHash)
+
+	require.Len(t, res.StorageProof, 1)
+	require.Equal(t, int64(7), res.StorageProof[0].Value.ToInt().Int64())
+	var value []byte
+	require.NoError(t, rlp.DecodeBytes(verifyProof(t, acc.Root, slot2.Bytes(), res.StorageProof[0].Proof), &value))
+	require.Equal(t, []byte{0x07}, value)
+
+	// the deleted account doesn't exist in the trie
+	res, err = service.GetProof(height, eoa, nil)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.EmptyRootHash, res.StorageHash)
+	require.Nil(t, verifyProof(t, root, eoa.Bytes(), res.AccountProof))
+
+	_, err = service.GetProof(height+1, contract, nil)
+	require.ErrorIs(t, err, statetrie.ErrHeightNotAvailable)
+
+	// a new service rebuilds the trie from the committed state
+	rebuilt := chain.newService(t)
+	height = chain.commit(t, rebuilt, func(ms sdk.MultiStore) {})
+	require.Equal(t, root, waitForRoot(t, rebuilt, height))
+}
+
+func TestServiceRecentRoots(t *testing.T) {
+	chain := newTestChain(t)
+	dir := t.TempDir()
+	db, err := rawdb.NewLevelDBDatabase(dir, 0, 0, "", false)
+	require.NoError(t, err)
+	service := chain.startService(t, db, 2)
+
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	genesis := chain.commit(t, service, func(ms sdk.MultiStore) {
+		params := evmtypes.DefaultParams()
+		ms.GetKVStore(chain.evmKey).Set(evmtypes.KeyPrefixParams, chain.cdc.MustMarshal(&params))
+		chain.setAccount(ms, addr, 1, 100, nil)
+	})
+	waitForRoot(t, service, genesis)
+
+	var height int64
+	for i := int64(2); i <= 4; i++ {
+		balance := 100 * i
+		height = chain.commit(t, service, func(ms sdk.MultiStore) {
+			chain.setAccount(ms, addr, 1, balance, nil)
+		})
+		waitForRoot(t, service, height)
+	}
+
+	// only the tries of the recent blocks are kept
+	_, err = service.StateRoot(genesis)
+	require.ErrorIs(t, err, statetrie.ErrHeightNotAvailable)
+	_, err = service.GetProof(height-2, addr, nil)
+	require.ErrorIs(t, err, statetrie.ErrHeightNotAvailable)
+	res, err := service.GetProof(height-1, addr, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), res.Balance.ToInt().Int64())
+	root := waitForRoot(t, service, height)
+
+	// the latest trie is flushed to disk and loaded by a new service
+	require.NoError(t, service.Close())
+	db, err = rawdb.NewLevelDBDatabase(dir, 0, 0, "", false)
+	require.NoError(t, err)
+	reopened := chain.startService(t, db, 2)
+	t.Cleanup(func() { require.NoError(t, reopened.Close()) })
+	require.Equal(t, root, waitForRoot(t, reopened, height))
+
+	res, err = reopened.GetProof(height, addr, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(400), res.Balance.ToInt().Int64())
+}
+
+func TestServiceAppPruning(t *testing.T) {
+	chain := newTestChain(t)
+	chain.cms.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+
+	service := statetrie.NewService(rawdb.NewMemoryDatabase(), 128, chain.cms, chain.cdc, chain.authKey, chain.bankKey, chain.evmKey, log.NewNopLogger())
+	require.Error(t, service.Stream(&sync.WaitGroup{}))
+}
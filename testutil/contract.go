@@ -123,3 +123,32 @@ func CheckEthTxResponse(r abci.ResponseDeliverTx, cdc codec.Codec) (*evm.MsgEthe
 
 	return &res, nil
 }
+
+// ProxyContractCode returns the runtime code of a contract that calls the given
+// target with its own call input and value, and returns, or reverts with, the
+// output of the call. It allows testing the calls made to the target by another
+// contract.
+func ProxyContractCode(target common.Address) []byte {
+	return common.FromHex("366000600037" + // CALLDATACOPY(0, 0, CALLDATASIZE)
+		"6000600036600034" + // retSize, retOffset, CALLDATASIZE, argsOffset, CALLVALUE
+		"73" + common.Bytes2Hex(target.Bytes()) + // target
+		"5af1" + // CALL(GAS, ...)
+		"3d600060003e" + // RETURNDATACOPY(0, 0, RETURNDATASIZE)
+		"603257" + // JUMPI(50, success)
+		"3d6000fd" + // REVERT(0, RETURNDATASIZE)
+		"5b3d6000f3") // JUMPDEST RETURN(0, RETURNDATASIZE)
+}
+
+// DelegateProxyContractCode returns the runtime code of a contract that
+// delegates its calls to the given target, and returns, or reverts with, the
+// output of the DELEGATECALL.
+func DelegateProxyContractCode(target common.Address) []byte {
+	return common.FromHex("366000600037" + // CALLDATACOPY(0, 0, CALLDATASIZE)
+		"60006000366000" + // retSize, retOffset, CALLDATASIZE, argsOffset
+		"73" + common.Bytes2Hex(target.Bytes()) + // target
+		"5af4" + // DELEGATECALL(GAS, ...)
+		"3d600060003e" + // RETURNDATACOPY(0, 0, RETURNDATASIZE)
+		"603157" + // JUMPI(49, success)
+		"3d6000fd" + // REVERT(0, RETURNDATASIZE)
+		"5b3d6000f3") // JUMPDEST RETURN(0, RETURNDATASIZE)
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package tx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ErrClefRequestDenied is the error returned by the MockClef when a request is rejected.
+// It matches the error returned by Clef.
+var ErrClefRequestDenied = errors.New("request denied")
+
+// MockClefVersion is the external API version reported by the MockClef.
+const MockClefVersion = "6.1.0"
+
+// MockClef is an in-memory implementation of the account_* API of Clef, the
+// external signer of go-ethereum, to be used on testing. Its keys are kept in
+// memory and requests are either all approved or all rejected.
+type MockClef struct {
+	mu       sync.Mutex
+	keys     map[common.Address]*ecdsa.PrivateKey
+	addrs    []common.Address
+	approve  bool
+	requests []string
+}
+
+// NewMockClef creates a new MockClef that approves all the requests and signs
+// with the given keys.
+func NewMockClef(keys ...*ecdsa.PrivateKey) *MockClef {
+	m := &MockClef{
+		keys:    make(map[common.Address]*ecdsa.PrivateKey, len(keys)),
+		approve: true,
+	}
+	for _, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		m.keys[addr] = key
+		m.addrs = append(m.addrs, addr)
+	}
+	return m
+}
+
+// SetApprove defines whether the following requests are approved or rejected.
+func (m *MockClef) SetApprove(approve bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.approve = approve
+}
+
+// Requests returns the names of the methods that have been requested, approved or not.
+func (m *MockClef) Requests() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.requests...)
+}
+
+// Client returns an in-process RPC client connected to the MockClef.
+func (m *MockClef) Client() *rpc.Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", &mockClefAPI{m}); err != nil {
+		panic(err)
+	}
+	return rpc.DialInProc(server)
+}
+
+// request records the request and returns the key of the given account if the
+// request is approved.
+func (m *MockClef) request(method string, addr common.Address) (*ecdsa.PrivateKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = append(m.requests, method)
+	if !m.approve {
+		return nil, ErrClefRequestDenied
+	}
+
+	key, found := m.keys[addr]
+	if !found {
+		return nil, fmt.Errorf("account not found: %s", addr)
+	}
+	return key, nil
+}
+
+// mockClefAPI exposes the account_* methods of the MockClef.
+type mockClefAPI struct {
+	clef *MockClef
+}
+
+// signTransactionResult is the result of the account_signTransaction method.
+type signTransactionResult struct {
+	Raw hexutil.Bytes         `json:"raw"`
+	Tx  *ethtypes.Transaction `json:"tx"`
+}
+
+// Version implements account_version.
+func (api *mockClefAPI) Version(_ context.Context) (string, error) {
+	return MockClefVersion, nil
+}
+
+// List implements account_list.
+func (api *mockClefAPI) List(_ context.Context) ([]common.Address, error) {
+	api.clef.mu.Lock()
+	defer api.clef.mu.Unlock()
+
+	api.clef.requests = append(api.clef.requests, "account_list")
+	if !api.clef.approve {
+		return nil, ErrClefRequestDenied
+	}
+	return append([]common.Address{}, api.clef.addrs...), nil
+}
+
+// SignData implements account_signData. Only the text/plain content type (EIP-191
+// personal messages) is supported.
+func (api *mockClefAPI) SignData(
+	_ context.Context,
+	contentType string,
+	addr common.MixedcaseAddress,
+	data hexutil.Bytes,
+) (hexutil.Bytes, error) {
+	if contentType != accounts.MimetypeTextPlain {
+		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+	}
+
+	key, err := api.clef.request("account_signData", addr.Address())
+	if err != nil {
+		return nil, err
+	}
+	return signHash(key, accounts.TextHash(data))
+}
+
+// SignTypedData implements account_signTypedData.
+func (api *mockClefAPI) SignTypedData(
+	_ context.Context,
+	addr common.MixedcaseAddress,
+	typedData apitypes.TypedData,
+) (hexutil.Bytes, error) {
+	key, err := api.clef.request("account_signTypedData", addr.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return signHash(key, sigHash)
+}
+
+// SignTransaction implements account_signTransaction.
+func (api *mockClefAPI) SignTransaction(
+	_ context.Context,
+	args apitypes.SendTxArgs,
+	_ *string,
+) (*signTransactionResult, error) {
+	key, err := api.clef.request("account_signTransaction", args.From.Address())
+	if err != nil {
+		return nil, err
+	}
+	if args.ChainID == nil {
+		return nil, errors.New("chain id not specified")
+	}
+
+	signer := ethtypes.LatestSignerForChainID((*big.Int)(args.ChainID))
+	tx, err := ethtypes.SignTx(args.ToTransaction(), signer, key)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTransactionResult{Raw: raw, Tx: tx}, nil
+}
+
+// signHash signs the hash and returns the signature with V in the 27/28 form, as Clef does.
+func signHash(key *ecdsa.PrivateKey, hash []byte) (hexutil.Bytes, error) {
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
@@ -45,6 +45,9 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// synthetic is true if the result refers to a synthetic transaction, which
+	// represents the balance changes of a Cosmos transaction.
+	Synthetic bool `protobuf:"varint,8,opt,name=synthetic,proto3" json:"synthetic,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -92,26 +95,27 @@ func init() {
 func init() { proto.RegisterFile("ethermint/types/v1/indexer.proto", fileDescriptor_1197e10a8be8ed28) }
 
 var fileDescriptor_1197e10a8be8ed28 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0xbf, 0x6d, 0x9a, 0xdf, 0x82, 0x81, 0x80, 0xaa, 0x00, 0x52, 0xb0, 0x98, 0x32,
-	0x25, 0x8a, 0xd8, 0x3a, 0xb2, 0x20, 0x56, 0xab, 0x2c, 0x2c, 0x51, 0xda, 0x5c, 0x6c, 0x4b, 0x75,
-	0x5d, 0xd5, 0x37, 0x51, 0x78, 0x03, 0x46, 0x1e, 0x81, 0xc7, 0x61, 0xec, 0xc8, 0x88, 0x5a, 0xf1,
-	0x1e, 0xa8, 0x4e, 0x54, 0x16, 0xcb, 0x47, 0xdf, 0x77, 0x75, 0xa4, 0x43, 0x19, 0xa0, 0x84, 0x8d,
-	0x56, 0x2b, 0xcc, 0xf0, 0x75, 0x0d, 0x36, 0x6b, 0xf2, 0x4c, 0xad, 0x2a, 0x68, 0x61, 0x93, 0xae,
-	0x37, 0x06, 0x4d, 0x18, 0x1e, 0x8d, 0xd4, 0x19, 0x69, 0x93, 0x5f, 0x5d, 0x08, 0x23, 0x8c, 0xc3,
-	0xd9, 0xe1, 0xd7, 0x99, 0xb7, 0x3f, 0x84, 0x06, 0xb3, 0x96, 0x83, 0xad, 0x97, 0x18, 0x4e, 0xa8,
-	0x2f, 0x41, 0x09, 0x89, 0x11, 0x61, 0x24, 0x19, 0xf0, 0x3e, 0x85, 0x97, 0x34, 0xc0, 0xb6, 0x70,
-	0x15, 0xd1, 0x3f, 0x46, 0x92, 0x53, 0x3e, 0xc6, 0xf6, 0xf1, 0x10, 0xc3, 0x6b, 0xfa, 0x5f, 0x5b,
-	0xd1, 0xb3, 0x81, 0x63, 0x81, 0xb6, 0xa2, 0x83, 0x8c, 0x9e, 0x00, 0xca, 0xe2, 0x78, 0x3b, 0x64,
-	0x24, 0x19, 0x71, 0x0a, 0x28, 0x67, 0xfd, 0xf9, 0x84, 0xfa, 0x2f, 0xa5, 0x5a, 0x42, 0x15, 0x8d,
-	0x18, 0x49, 0x02, 0xde, 0xa7, 0x43, 0xa3, 0x28, 0x6d, 0x51, 0x5b, 0xa8, 0x22, 0x9f, 0x91, 0x64,
-	0xc8, 0xc7, 0xa2, 0xb4, 0x4f, 0x16, 0xaa, 0x30, 0xa5, 0xe7, 0x8b, 0x5a, 0xd7, 0xcb, 0x12, 0x55,
-	0x03, 0xc5, 0xd1, 0x1a, 0x3b, 0xeb, 0xec, 0x0f, 0x3d, 0x74, 0xfe, 0x74, 0xf8, 0xf6, 0x71, 0xe3,
-	0xdd, 0x4f, 0x3f, 0x77, 0x31, 0xd9, 0xee, 0x62, 0xf2, 0xbd, 0x8b, 0xc9, 0xfb, 0x3e, 0xf6, 0xb6,
-	0xfb, 0xd8, 0xfb, 0xda, 0xc7, 0xde, 0x33, 0x13, 0x0a, 0x65, 0x3d, 0x4f, 0x17, 0x46, 0x67, 0xd0,
-	0x68, 0x63, 0xfb, 0xb7, 0xc9, 0xf3, 0x6e, 0xde, 0xb9, 0xef, 0xa6, 0xba, 0xfb, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xe1, 0x81, 0x69, 0xf9, 0x78, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xfe, 0xa4, 0xa9, 0xf5, 0x7d, 0x03, 0x01, 0x55, 0xe1, 0x47, 0xc1, 0x62, 0xca,
+	0x94, 0xa8, 0xb0, 0x75, 0x64, 0x41, 0xac, 0x51, 0x59, 0x58, 0xa2, 0xb4, 0x39, 0xd8, 0x96, 0x9a,
+	0xba, 0xaa, 0x4f, 0xa2, 0x74, 0x66, 0x61, 0xe4, 0x12, 0xb8, 0x1c, 0xc6, 0x8e, 0x8c, 0xa8, 0xbd,
+	0x11, 0x54, 0xd7, 0x4a, 0x17, 0xcb, 0xef, 0x79, 0x9e, 0xa3, 0x57, 0x3a, 0x94, 0x01, 0x0a, 0x58,
+	0x97, 0x72, 0x89, 0x09, 0x6e, 0x56, 0xa0, 0x93, 0x7a, 0x9c, 0xc8, 0x65, 0x01, 0x0d, 0xac, 0xe3,
+	0xd5, 0x5a, 0xa1, 0xf2, 0xfd, 0xd6, 0x88, 0x8d, 0x11, 0xd7, 0xe3, 0xab, 0x0b, 0xae, 0xb8, 0x32,
+	0x38, 0x39, 0xfc, 0x8e, 0xe6, 0xdd, 0x7b, 0x87, 0x7a, 0xd3, 0x26, 0x05, 0x5d, 0x2d, 0xd0, 0x1f,
+	0x51, 0x57, 0x80, 0xe4, 0x02, 0x03, 0xc2, 0x48, 0xd4, 0x4d, 0x6d, 0xf2, 0x2f, 0xa9, 0x87, 0x4d,
+	0x66, 0x2a, 0x82, 0x0e, 0x23, 0xd1, 0xff, 0x74, 0x80, 0xcd, 0xf3, 0x21, 0xfa, 0xd7, 0x74, 0x58,
+	0x6a, 0x6e, 0x59, 0xd7, 0x30, 0xaf, 0xd4, 0xfc, 0x08, 0x19, 0xfd, 0x07, 0x28, 0xb2, 0x76, 0xb7,
+	0xc7, 0x48, 0xd4, 0x4f, 0x29, 0xa0, 0x98, 0xda, 0xf5, 0x11, 0x75, 0xdf, 0x72, 0xb9, 0x80, 0x22,
+	0xe8, 0x33, 0x12, 0x79, 0xa9, 0x4d, 0x87, 0x46, 0x9e, 0xeb, 0xac, 0xd2, 0x50, 0x04, 0x2e, 0x23,
+	0x51, 0x2f, 0x1d, 0xf0, 0x5c, 0xbf, 0x68, 0x28, 0xfc, 0x98, 0x9e, 0xcf, 0xab, 0xb2, 0x5a, 0xe4,
+	0x28, 0x6b, 0xc8, 0x5a, 0x6b, 0x60, 0xac, 0xb3, 0x13, 0x7a, 0xb2, 0xfe, 0x0d, 0x1d, 0xea, 0xcd,
+	0x12, 0x05, 0xa0, 0x9c, 0x07, 0x9e, 0x69, 0x39, 0x0d, 0x26, 0xbd, 0x8f, 0xaf, 0x5b, 0xe7, 0x71,
+	0xf2, 0xbd, 0x0b, 0xc9, 0x76, 0x17, 0x92, 0xdf, 0x5d, 0x48, 0x3e, 0xf7, 0xa1, 0xb3, 0xdd, 0x87,
+	0xce, 0xcf, 0x3e, 0x74, 0x5e, 0x19, 0x97, 0x28, 0xaa, 0x59, 0x3c, 0x57, 0x65, 0x02, 0x75, 0xa9,
+	0xb4, 0x7d, 0xeb, 0xf1, 0xfd, 0xf1, 0xf8, 0x33, 0xd7, 0x1c, 0xf2, 0xe1, 0x6f, 0x00, 0x40, 0x18,
+	0x5f, 0xfd, 0x96, 0x01, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -134,6 +138,16 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Synthetic {
+		i--
+		if m.Synthetic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -216,6 +230,9 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	if m.Synthetic {
+		n += 2
+	}
 	return n
 }
 
@@ -390,6 +407,26 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synthetic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synthetic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
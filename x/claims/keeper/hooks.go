@@ -23,6 +23,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -108,6 +109,12 @@ func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// PreTxProcessing implements EvmHooks.PreTxProcessing. The module doesn't
+// process transactions before their execution.
+func (h Hooks) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 // PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
 // the module keeper
 func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
@@ -151,6 +151,9 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// FlagPrecompile defines the flag to register the coins of a proposal as ERC20 precompiles
+const FlagPrecompile = "precompile"
+
 // NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
 //
 //nolint:staticcheck
@@ -218,9 +221,19 @@ Where metadata.json contains (example):
 				return err
 			}
 
+			precompile, err := cmd.Flags().GetBool(FlagPrecompile)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
 
-			content := types.NewRegisterCoinProposal(title, description, metadata...)
+			content := &types.RegisterCoinProposal{
+				Title:       title,
+				Description: description,
+				Metadata:    metadata,
+				Precompile:  precompile,
+			}
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -238,6 +251,7 @@ Where metadata.json contains (example):
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().Bool(FlagPrecompile, false, "expose the coins through an ERC20 precompile over their bank balances instead of deploying an ERC20 contract")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
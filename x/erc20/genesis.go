@@ -21,6 +21,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v12/x/erc20/keeper"
 	"github.com/evmos/evmos/v12/x/erc20/types"
@@ -50,6 +51,16 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, allowance := range data.Allowances {
+		k.SetAllowance(
+			ctx,
+			common.HexToAddress(allowance.Erc20Address),
+			common.HexToAddress(allowance.Owner),
+			common.HexToAddress(allowance.Spender),
+			allowance.Value.BigInt(),
+		)
+	}
 }
 
 // ExportGenesis export module status
@@ -57,5 +68,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		TokenPairs: k.GetTokenPairs(ctx),
+		Allowances: k.GetAllowances(ctx),
 	}
 }
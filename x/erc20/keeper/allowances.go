@@ -0,0 +1,80 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// GetAllowance returns the amount of tokens of the ERC20 precompile that the
+// spender is allowed to transfer on behalf of the owner.
+func (k Keeper) GetAllowance(ctx sdk.Context, erc20, owner, spender common.Address) *big.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	bz := store.Get(types.AllowanceKey(erc20, owner, spender))
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetAllowance sets the amount of tokens of the ERC20 precompile that the
+// spender is allowed to transfer on behalf of the owner. A zero value deletes
+// the allowance.
+func (k Keeper) SetAllowance(ctx sdk.Context, erc20, owner, spender common.Address, value *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	key := types.AllowanceKey(erc20, owner, spender)
+	if value.Sign() == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, value.Bytes())
+}
+
+// GetAllowances returns all the allowances of the ERC20 precompiles.
+func (k Keeper) GetAllowances(ctx sdk.Context) []types.Allowance {
+	allowances := []types.Allowance{}
+
+	k.IterateAllowances(ctx, func(allowance types.Allowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+
+	return allowances
+}
+
+// IterateAllowances iterates over all the stored allowances
+func (k Keeper) IterateAllowances(ctx sdk.Context, cb func(allowance types.Allowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAllowance)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixAllowance):]
+		allowance := types.NewAllowance(
+			common.BytesToAddress(key[:common.AddressLength]),
+			common.BytesToAddress(key[common.AddressLength:2*common.AddressLength]),
+			common.BytesToAddress(key[2*common.AddressLength:]),
+			new(big.Int).SetBytes(iterator.Value()),
+		)
+
+		if cb(allowance) {
+			break
+		}
+	}
+}
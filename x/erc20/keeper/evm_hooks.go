@@ -42,6 +42,12 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// PreTxProcessing implements EvmHooks.PreTxProcessing. The module doesn't
+// process transactions before their execution.
+func (h Hooks) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 // PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
 // the module keeper
 func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
@@ -116,7 +122,8 @@ func (k Keeper) PostTxProcessing(
 		}
 
 		pair, found := k.GetTokenPair(ctx, id)
-		if !found {
+		// the transfers of the precompiles are already performed on the bank balances
+		if !found || pair.IsPrecompile() {
 			continue
 		}
 
@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// GetTokenPairERC20 returns the ERC-20 contract of the token pair of the given
+// denomination. It's used by the EVM to consult the price oracles of the fee
+// denominations.
+func (k Keeper) GetTokenPairERC20(ctx sdk.Context, denom string) (common.Address, bool) {
+	pair, found := k.GetTokenPair(ctx, k.GetDenomMap(ctx, denom))
+	if !found {
+		return common.Address{}, false
+	}
+	return pair.GetERC20Contract(), true
+}
+
+// ConvertERC20ToCoin converts the ERC-20 tokens of the sender to the given coin
+// of the token pair. It's used by the EVM to pay the fees of the Ethereum
+// transactions with the ERC-20 tokens of the fee denominations.
+func (k Keeper) ConvertERC20ToCoin(ctx sdk.Context, sender common.Address, coin sdk.Coin) error {
+	pair, found := k.GetTokenPair(ctx, k.GetDenomMap(ctx, coin.Denom))
+	if !found {
+		return errorsmod.Wrapf(types.ErrTokenPairNotFound, "coin '%s' not registered", coin.Denom)
+	}
+
+	_, err := k.ConvertERC20(sdk.WrapSDKContext(ctx), &types.MsgConvertERC20{
+		ContractAddress: pair.Erc20Address,
+		Amount:          coin.Amount,
+		Receiver:        sdk.AccAddress(sender.Bytes()).String(),
+		Sender:          sender.Hex(),
+	})
+	return err
+}
@@ -115,6 +115,11 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	if pair.IsPrecompile() {
+		// no-op: the received coins are already available on the ERC20 precompile
+		return ack
+	}
+
 	// Instead of converting just the received coins, convert the whole user balance
 	// which includes the received coins.
 	balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
@@ -205,6 +210,11 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 		return nil
 	}
 
+	if pair, _ := k.GetTokenPair(ctx, k.GetDenomMap(ctx, coin.Denom)); pair.IsPrecompile() {
+		// no-op, the refunded coins are already available on the ERC20 precompile
+		return nil
+	}
+
 	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(sender), sender)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated the
@@ -39,6 +39,10 @@ type Keeper struct {
 	evmKeeper     types.EVMKeeper
 	stakingKeeper types.StakingKeeper
 	claimsKeeper  types.ClaimsKeeper
+
+	// block-scoped cache of the precompile token pairs, shared by the copies
+	// of the keeper
+	precompileCache *precompileCache
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -66,6 +70,8 @@ func NewKeeper(
 		evmKeeper:     evmKeeper,
 		stakingKeeper: sk,
 		claimsKeeper:  ck,
+
+		precompileCache: newPrecompileCache(),
 	}
 }
 
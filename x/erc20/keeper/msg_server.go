@@ -51,6 +51,13 @@ func (k Keeper) ConvertCoin(
 		return nil, err
 	}
 
+	// Precompile token pairs share the bank balances, there's nothing to convert
+	if pair.IsPrecompile() {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairPrecompile, "coin '%s' doesn't require a conversion", pair.Denom,
+		)
+	}
+
 	// Remove token pair if contract is suicided
 	erc20 := common.HexToAddress(pair.Erc20Address)
 	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
@@ -93,6 +100,13 @@ func (k Keeper) ConvertERC20(
 		return nil, err
 	}
 
+	// Precompile token pairs share the bank balances, there's nothing to convert
+	if pair.IsPrecompile() {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairPrecompile, "coin '%s' doesn't require a conversion", pair.Denom,
+		)
+	}
+
 	// Remove token pair if contract is suicided
 	erc20 := common.HexToAddress(pair.Erc20Address)
 	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
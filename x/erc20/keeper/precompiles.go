@@ -0,0 +1,164 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/erc20/types"
+)
+
+// precompileCacheSize is the maximum number of addresses held by the
+// precompile cache.
+const precompileCacheSize = 1 << 12
+
+// precompileCache is a block-scoped cache of the token pairs exposed by an
+// ERC20 precompile, and of the addresses without one, looked up by ERC20
+// address. The EVM looks up every called address, so that the index doesn't
+// need to be read more than once per block for each address.
+//
+// Once the token pair of an address is written during the block, the address
+// is marked as dirty and isn't cached anymore until the next block. So the
+// entries remain valid for the queries at the height of the block, once it's
+// committed. The cache isn't used by the CheckTx state. The precompile token
+// pairs are only written by the governance proposals and the genesis, so the
+// reads skipped don't need to be recorded by the speculative executions of the
+// EVM txs.
+type precompileCache struct {
+	mtx     sync.Mutex
+	height  int64
+	entries map[common.Address]precompileCacheEntry
+	dirty   map[common.Address]struct{}
+}
+
+// precompileCacheEntry is the token pair exposed by the ERC20 precompile of an
+// address, if found.
+type precompileCacheEntry struct {
+	pair  types.TokenPair
+	found bool
+}
+
+// newPrecompileCache creates a new empty precompile cache.
+func newPrecompileCache() *precompileCache {
+	return &precompileCache{
+		entries: make(map[common.Address]precompileCacheEntry),
+		dirty:   make(map[common.Address]struct{}),
+	}
+}
+
+// enabled returns true if the cache can be used with the given context. It
+// must be called with the mutex held.
+func (c *precompileCache) enabled(ctx sdk.Context) bool {
+	if c == nil || ctx.IsCheckTx() || ctx.BlockHeight() < c.height {
+		return false
+	}
+
+	if ctx.BlockHeight() > c.height {
+		c.height = ctx.BlockHeight()
+		c.entries = make(map[common.Address]precompileCacheEntry)
+		c.dirty = make(map[common.Address]struct{})
+	}
+	return true
+}
+
+// get returns the cached entry of the address.
+func (c *precompileCache) get(ctx sdk.Context, address common.Address) (precompileCacheEntry, bool) {
+	if c == nil {
+		return precompileCacheEntry{}, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.enabled(ctx) {
+		return precompileCacheEntry{}, false
+	}
+	entry, ok := c.entries[address]
+	return entry, ok
+}
+
+// set caches the entry of the address, unless its token pair has been written
+// during the block.
+func (c *precompileCache) set(ctx sdk.Context, address common.Address, entry precompileCacheEntry) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.enabled(ctx) || len(c.entries) >= precompileCacheSize {
+		return
+	}
+	if _, ok := c.dirty[address]; ok {
+		return
+	}
+
+	c.entries[address] = entry
+}
+
+// invalidate marks the token pair of the address as written during the block.
+func (c *precompileCache) invalidate(ctx sdk.Context, address common.Address) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.enabled(ctx) {
+		return
+	}
+
+	delete(c.entries, address)
+	c.dirty[address] = struct{}{}
+}
+
+// GetPrecompileTokenPair returns the token pair exposed by the ERC20 precompile
+// at the given address, if any. Only the index of the precompile token pairs is
+// read, and the result is cached for the rest of the block.
+func (k Keeper) GetPrecompileTokenPair(ctx sdk.Context, erc20 common.Address) (types.TokenPair, bool) {
+	if entry, ok := k.precompileCache.get(ctx, erc20); ok {
+		return entry.pair, entry.found
+	}
+
+	var entry precompileCacheEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileTokenPair)
+	if id := store.Get(erc20.Bytes()); len(id) != 0 {
+		entry.pair, entry.found = k.GetTokenPair(ctx, id)
+	}
+
+	k.precompileCache.set(ctx, erc20, entry)
+	return entry.pair, entry.found
+}
+
+// setPrecompileMap indexes the token pair id of the ERC20 precompile at the
+// given address.
+func (k Keeper) setPrecompileMap(ctx sdk.Context, erc20 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileTokenPair)
+	store.Set(erc20.Bytes(), id)
+}
+
+// deletePrecompileMap deletes the token pair id of the ERC20 precompile at the
+// given address.
+func (k Keeper) deletePrecompileMap(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileTokenPair)
+	store.Delete(erc20.Bytes())
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestGetPrecompileTokenPair() {
+	suite.SetupTest()
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	pair := types.NewPrecompileTokenPair("acoin")
+	erc20 := pair.GetERC20Contract()
+	contractPair := types.NewTokenPair(utiltx.GenerateAddress(), evmtypes.DefaultEVMDenom, types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(ctx, contractPair)
+
+	// token pairs with a deployed contract aren't indexed
+	_, found := suite.app.Erc20Keeper.GetPrecompileTokenPair(ctx, contractPair.GetERC20Contract())
+	suite.Require().False(found)
+
+	// the address without a precompile is cached, then invalidated by the
+	// registration of the token pair during the block
+	_, found = suite.app.Erc20Keeper.GetPrecompileTokenPair(ctx, erc20)
+	suite.Require().False(found)
+
+	suite.app.Erc20Keeper.SetTokenPair(ctx, pair)
+	p, found := suite.app.Erc20Keeper.GetPrecompileTokenPair(ctx, erc20)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, p)
+
+	// the update of the token pair is visible during the block
+	pair.Enabled = false
+	suite.app.Erc20Keeper.SetTokenPair(ctx, pair)
+	p, found = suite.app.Erc20Keeper.GetPrecompileTokenPair(ctx, erc20)
+	suite.Require().True(found)
+	suite.Require().False(p.Enabled)
+
+	// the cached token pair is used by the next blocks until it's deleted
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	p, found = suite.app.Erc20Keeper.GetPrecompileTokenPair(ctx, erc20)
+	suite.Require().True(found)
+	suite.Require().Equal(pair, p)
+
+	suite.app.Erc20Keeper.DeleteTokenPair(ctx, pair)
+	_, found = suite.app.Erc20Keeper.GetPrecompileTokenPair(ctx, erc20)
+	suite.Require().False(found)
+}
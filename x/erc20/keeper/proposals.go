@@ -32,39 +32,86 @@ func (k Keeper) RegisterCoin(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
 ) (*types.TokenPair, error) {
+	if err := k.validateCoinRegistration(ctx, coinMetadata); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.DeployERC20Contract(ctx, coinMetadata)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			err, "failed to create wrapped coin denom metadata for ERC20",
+		)
+	}
+
+	pair := types.NewTokenPair(addr, coinMetadata.Base, types.OWNER_MODULE)
+	k.SetTokenPair(ctx, pair)
+	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
+	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+
+	return &pair, nil
+}
+
+// RegisterCoinPrecompile creates the token pair for the existing cosmos coin,
+// which is exposed by an ERC20 precompile over its bank balances at a
+// deterministic address. No contract is deployed and the coin doesn't need to
+// be converted.
+func (k Keeper) RegisterCoinPrecompile(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+) (*types.TokenPair, error) {
+	if err := k.validateCoinRegistration(ctx, coinMetadata); err != nil {
+		return nil, err
+	}
+
+	pair := types.NewPrecompileTokenPair(coinMetadata.Base)
+	addr := pair.GetERC20Contract()
+
+	if k.IsERC20Registered(ctx, addr) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", pair.Erc20Address,
+		)
+	}
+
+	if acc := k.evmKeeper.GetAccountWithoutBalance(ctx, addr); acc != nil && acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "contract already deployed at the precompile address %s", pair.Erc20Address,
+		)
+	}
+
+	k.SetTokenPair(ctx, pair)
+	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
+	k.SetERC20Map(ctx, addr, pair.GetID())
+
+	return &pair, nil
+}
+
+// validateCoinRegistration checks that the cosmos coin can be registered as a
+// token pair
+func (k Keeper) validateCoinRegistration(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+) error {
 	// Check if denomination is already registered
 	if k.IsDenomRegistered(ctx, coinMetadata.Name) {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", coinMetadata.Name,
 		)
 	}
 
 	// Check if the coin exists by ensuring the supply is set
 	if !k.bankKeeper.HasSupply(ctx, coinMetadata.Base) {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			errortypes.ErrInvalidCoins, "base denomination '%s' cannot have a supply of 0", coinMetadata.Base,
 		)
 	}
 
 	if err := k.verifyMetadata(ctx, coinMetadata); err != nil {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			types.ErrInternalTokenPair, "coin metadata is invalid %s", coinMetadata.Name,
 		)
 	}
 
-	addr, err := k.DeployERC20Contract(ctx, coinMetadata)
-	if err != nil {
-		return nil, errorsmod.Wrap(
-			err, "failed to create wrapped coin denom metadata for ERC20",
-		)
-	}
-
-	pair := types.NewTokenPair(addr, coinMetadata.Base, types.OWNER_MODULE)
-	k.SetTokenPair(ctx, pair)
-	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
-	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
-
-	return &pair, nil
+	return nil
 }
 
 // RegisterERC20 creates a Cosmos coin and registers the token pair between the
@@ -79,12 +79,19 @@ func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool)
 	return tokenPair, true
 }
 
-// SetTokenPair stores a token pair
+// SetTokenPair stores a token pair, and indexes it if it's exposed by an ERC20
+// precompile
 func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
 	key := tokenPair.GetID()
 	bz := k.cdc.MustMarshal(&tokenPair)
 	store.Set(key, bz)
+
+	erc20 := tokenPair.GetERC20Contract()
+	if tokenPair.IsPrecompile() {
+		k.setPrecompileMap(ctx, erc20, key)
+	}
+	k.precompileCache.invalidate(ctx, erc20)
 }
 
 // DeleteTokenPair removes a token pair.
@@ -93,6 +100,8 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteTokenPair(ctx, id)
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
+	k.deletePrecompileMap(ctx, tokenPair.GetERC20Contract())
+	k.precompileCache.invalidate(ctx, tokenPair.GetERC20Contract())
 }
 
 // deleteTokenPair deletes the token pair for the given id
@@ -59,7 +59,15 @@ func handleRegisterCoinProposal(
 	p *types.RegisterCoinProposal,
 ) error {
 	for _, metadata := range p.Metadata {
-		pair, err := k.RegisterCoin(ctx, metadata)
+		var (
+			pair *types.TokenPair
+			err  error
+		)
+		if p.Precompile {
+			pair, err = k.RegisterCoinPrecompile(ctx, metadata)
+		} else {
+			pair, err = k.RegisterCoin(ctx, metadata)
+		}
 		if err != nil {
 			return err
 		}
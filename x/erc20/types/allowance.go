@@ -0,0 +1,52 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// NewAllowance returns an instance of Allowance
+func NewAllowance(erc20, owner, spender common.Address, value *big.Int) Allowance {
+	return Allowance{
+		Erc20Address: erc20.String(),
+		Owner:        owner.String(),
+		Spender:      spender.String(),
+		Value:        sdk.NewIntFromBigInt(value),
+	}
+}
+
+// Validate performs a stateless validation of an Allowance
+func (a Allowance) Validate() error {
+	if err := evmostypes.ValidateAddress(a.Erc20Address); err != nil {
+		return err
+	}
+	if err := evmostypes.ValidateAddress(a.Owner); err != nil {
+		return err
+	}
+	if err := evmostypes.ValidateAddress(a.Spender); err != nil {
+		return err
+	}
+	if a.Value.IsNil() || !a.Value.IsPositive() {
+		return fmt.Errorf("allowance value must be positive: %s", a.Value)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -37,18 +38,23 @@ const (
 	OWNER_MODULE Owner = 1
 	// OWNER_EXTERNAL - erc20 is owned by an external account.
 	OWNER_EXTERNAL Owner = 2
+	// OWNER_PRECOMPILE - erc20 is a precompiled contract over the bank balances of
+	// the native Cosmos coin, which doesn't require conversions.
+	OWNER_PRECOMPILE Owner = 3
 )
 
 var Owner_name = map[int32]string{
 	0: "OWNER_UNSPECIFIED",
 	1: "OWNER_MODULE",
 	2: "OWNER_EXTERNAL",
+	3: "OWNER_PRECOMPILE",
 }
 
 var Owner_value = map[string]int32{
 	"OWNER_UNSPECIFIED": 0,
 	"OWNER_MODULE":      1,
 	"OWNER_EXTERNAL":    2,
+	"OWNER_PRECOMPILE":  3,
 }
 
 func (x Owner) String() string {
@@ -69,7 +75,8 @@ type TokenPair struct {
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	// enabled defines the token mapping enable status
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
+	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address,
+	// 3 precompile)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
 }
 
@@ -148,6 +155,9 @@ type RegisterCoinProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata slice of the native Cosmos coins
 	Metadata []types.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	// precompile defines if the coins are exposed by an ERC20 precompiled contract
+	// over their bank balances instead of a deployed ERC20 contract
+	Precompile bool `protobuf:"varint,4,opt,name=precompile,proto3" json:"precompile,omitempty"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
@@ -209,6 +219,13 @@ func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
 	return nil
 }
 
+func (m *RegisterCoinProposal) GetPrecompile() bool {
+	if m != nil {
+		return m.Precompile
+	}
+	return false
+}
+
 // RegisterERC20Proposal is a gov Content type to register a token pair for an
 // ERC20 token
 type RegisterERC20Proposal struct {
@@ -350,6 +367,78 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 	return ""
 }
 
+// Allowance defines the amount of tokens of an ERC20 precompile that the spender
+// is allowed to transfer on behalf of the owner.
+type Allowance struct {
+	// erc20_address is the hex address of the ERC20 precompile
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// owner is the hex address of the owner of the tokens
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the account allowed to spend the tokens
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// value is the amount of tokens allowed to be spent
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{4}
+}
+
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
+func (m *Allowance) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *Allowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Allowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
 // ProposalMetadata is used to parse a slice of denom metadata and generate
 // the RegisterCoinProposal content.
 type ProposalMetadata struct {
@@ -361,7 +450,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
@@ -408,45 +497,52 @@ func init() {
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
 	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "evmos.erc20.v1.ToggleTokenConversionProposal")
+	proto.RegisterType((*Allowance)(nil), "evmos.erc20.v1.Allowance")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0x6c, 0xbb, 0xba, 0x9d, 0xdd, 0x0d, 0x71, 0x68, 0x21, 0x14, 0x36, 0x1b, 0x2a, 0x48,
-	0xf0, 0x90, 0x34, 0xf5, 0x26, 0x82, 0x6c, 0xbb, 0x11, 0x56, 0x76, 0xdb, 0x92, 0xed, 0xa2, 0x78,
-	0x29, 0x93, 0x64, 0x88, 0x61, 0xdb, 0x4c, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2, 0x5d,
-	0xd0, 0x3f, 0x66, 0x8f, 0x7b, 0xf4, 0x24, 0xd2, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0xb8, 0x1e,
-	0xdd, 0x4b, 0x78, 0xdf, 0xf7, 0x7e, 0xe4, 0x9b, 0xef, 0xf1, 0x60, 0x97, 0x14, 0x4b, 0xca, 0x3d,
-	0xc2, 0xe2, 0x41, 0xdf, 0x2b, 0x7c, 0x15, 0xb8, 0x2b, 0x46, 0x05, 0x45, 0xba, 0xcc, 0xb9, 0x8a,
-	0x2a, 0xfc, 0xae, 0x15, 0x53, 0x5e, 0x16, 0x47, 0x38, 0xbf, 0xf4, 0x0a, 0x3f, 0x22, 0x02, 0xfb,
-	0x12, 0xa8, 0xfa, 0x6e, 0x3b, 0xa5, 0x29, 0x95, 0xa1, 0x57, 0x46, 0x8a, 0xed, 0x7d, 0x03, 0xb0,
-	0x35, 0xa3, 0x97, 0x24, 0x9f, 0xe2, 0x8c, 0xa1, 0xfb, 0x70, 0x5f, 0xce, 0x9b, 0xe3, 0x24, 0x61,
-	0x84, 0x73, 0x13, 0xd8, 0xc0, 0x69, 0x85, 0x7b, 0x92, 0x3c, 0x52, 0x1c, 0x6a, 0xc3, 0xed, 0x84,
-	0xe4, 0x74, 0x69, 0x6e, 0xc9, 0xa4, 0x02, 0xc8, 0x84, 0x77, 0x49, 0x8e, 0xa3, 0x05, 0x49, 0xcc,
-	0x86, 0x0d, 0x9c, 0x9d, 0xb0, 0x86, 0xe8, 0x09, 0xd4, 0x63, 0x9a, 0x0b, 0x86, 0x63, 0x31, 0xa7,
-	0x6f, 0x73, 0xc2, 0xcc, 0xa6, 0x0d, 0x1c, 0x7d, 0xd0, 0x71, 0x6f, 0xbe, 0xc0, 0x9d, 0x94, 0xc9,
-	0x70, 0xbf, 0x2e, 0x96, 0xf0, 0x71, 0xf3, 0xd7, 0x97, 0x43, 0xd0, 0xfb, 0x0c, 0x60, 0x3b, 0x24,
-	0x69, 0xc6, 0x05, 0x61, 0x23, 0x9a, 0xe5, 0x53, 0x46, 0x57, 0x94, 0xe3, 0x45, 0x29, 0x46, 0x64,
-	0x62, 0x41, 0x2a, 0xa5, 0x0a, 0x20, 0x1b, 0xee, 0x26, 0x84, 0xc7, 0x2c, 0x5b, 0x89, 0x8c, 0xe6,
-	0x95, 0xd0, 0xbf, 0x29, 0xf4, 0x14, 0xee, 0x2c, 0x89, 0xc0, 0x09, 0x16, 0xd8, 0x6c, 0xd8, 0x0d,
-	0x67, 0x77, 0x70, 0xe0, 0x2a, 0x03, 0x5d, 0xe9, 0x59, 0x65, 0xa0, 0x7b, 0x56, 0x15, 0x0d, 0x9b,
-	0x57, 0x3f, 0x0e, 0xb5, 0xf0, 0x4f, 0x93, 0xd4, 0xa5, 0xf5, 0x3e, 0xc0, 0x4e, 0x2d, 0x2b, 0x08,
-	0x47, 0x83, 0xfe, 0xad, 0x75, 0x3d, 0x80, 0xba, 0xf4, 0xa3, 0x5a, 0x00, 0xe1, 0x52, 0x5d, 0x2b,
-	0xfc, 0x87, 0xad, 0x7e, 0xcf, 0xe1, 0xc1, 0x8c, 0xa6, 0xe9, 0x82, 0xc8, 0x15, 0x8e, 0x68, 0x5e,
-	0x10, 0xc6, 0x33, 0x7a, 0x7b, 0x7b, 0xca, 0xbe, 0x72, 0xa4, 0xdc, 0x65, 0xd9, 0x57, 0x82, 0x6a,
-	0x17, 0xe7, 0xd0, 0xa8, 0xe7, 0xd7, 0xee, 0xdc, 0xb0, 0x13, 0xfc, 0x87, 0x9d, 0x0f, 0x9f, 0xc3,
-	0x6d, 0xb9, 0x6f, 0xd4, 0x81, 0xf7, 0x26, 0x2f, 0xc6, 0x41, 0x38, 0xbf, 0x18, 0x9f, 0x4f, 0x83,
-	0xd1, 0xc9, 0xb3, 0x93, 0xe0, 0xd8, 0xd0, 0x90, 0x01, 0xf7, 0x14, 0x7d, 0x36, 0x39, 0xbe, 0x38,
-	0x0d, 0x0c, 0x80, 0x10, 0xd4, 0x15, 0x13, 0xbc, 0x9c, 0x05, 0xe1, 0xf8, 0xe8, 0xd4, 0xd8, 0xea,
-	0x36, 0x3f, 0x7e, 0xb5, 0xb4, 0xe1, 0xf0, 0x6a, 0x6d, 0x81, 0xeb, 0xb5, 0x05, 0x7e, 0xae, 0x2d,
-	0xf0, 0x69, 0x63, 0x69, 0xd7, 0x1b, 0x4b, 0xfb, 0xbe, 0xb1, 0xb4, 0x57, 0x4e, 0x9a, 0x89, 0xd7,
-	0x6f, 0x22, 0x37, 0xa6, 0x4b, 0xaf, 0x3a, 0x2d, 0xf9, 0x2d, 0xfc, 0xbe, 0xf7, 0xae, 0x3a, 0x33,
-	0xf1, 0x7e, 0x45, 0x78, 0x74, 0x47, 0x9e, 0xc7, 0xa3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d,
-	0xcc, 0xf9, 0x4c, 0x82, 0x03, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6b, 0xdb, 0x3c,
+	0x18, 0xb6, 0x9a, 0xf4, 0xfb, 0x1a, 0xb5, 0x0d, 0x9e, 0x48, 0xc1, 0x04, 0xea, 0x86, 0x0c, 0x4a,
+	0x18, 0xcc, 0x6e, 0xb2, 0xdb, 0x18, 0x8c, 0x26, 0xf5, 0x20, 0xd0, 0x36, 0x41, 0x6d, 0xd9, 0xd8,
+	0xa5, 0x53, 0x6c, 0x91, 0x99, 0x3a, 0x92, 0x91, 0x54, 0x77, 0x3b, 0xec, 0xbe, 0xe3, 0x7e, 0xc2,
+	0x60, 0x63, 0xff, 0x60, 0xff, 0xa1, 0xc7, 0x1e, 0xc7, 0x0e, 0x65, 0xb4, 0x97, 0xfd, 0x8c, 0x61,
+	0x49, 0x19, 0xed, 0x4e, 0x63, 0xbd, 0xd8, 0x7a, 0x1e, 0xbd, 0xaf, 0xf4, 0xea, 0x79, 0x1f, 0x09,
+	0x36, 0x69, 0x31, 0xe3, 0x32, 0xa4, 0x22, 0xee, 0x6d, 0x85, 0x45, 0xd7, 0x0c, 0x82, 0x5c, 0x70,
+	0xc5, 0x51, 0x5d, 0xcf, 0x05, 0x86, 0x2a, 0xba, 0x4d, 0x3f, 0xe6, 0xb2, 0x0c, 0x9e, 0x10, 0x76,
+	0x12, 0x16, 0xdd, 0x09, 0x55, 0xa4, 0xab, 0x81, 0x89, 0x6f, 0x36, 0xa6, 0x7c, 0xca, 0xf5, 0x30,
+	0x2c, 0x47, 0x86, 0x6d, 0x7f, 0x06, 0xb0, 0x76, 0xc8, 0x4f, 0x28, 0x1b, 0x93, 0x54, 0xa0, 0xfb,
+	0x70, 0x55, 0xaf, 0x77, 0x4c, 0x92, 0x44, 0x50, 0x29, 0x3d, 0xd0, 0x02, 0x9d, 0x1a, 0x5e, 0xd1,
+	0xe4, 0xb6, 0xe1, 0x50, 0x03, 0x2e, 0x26, 0x94, 0xf1, 0x99, 0xb7, 0xa0, 0x27, 0x0d, 0x40, 0x1e,
+	0xfc, 0x9f, 0x32, 0x32, 0xc9, 0x68, 0xe2, 0x55, 0x5a, 0xa0, 0xb3, 0x84, 0xe7, 0x10, 0x3d, 0x81,
+	0xf5, 0x98, 0x33, 0x25, 0x48, 0xac, 0x8e, 0xf9, 0x19, 0xa3, 0xc2, 0xab, 0xb6, 0x40, 0xa7, 0xde,
+	0x5b, 0x0b, 0x6e, 0x9f, 0x20, 0x18, 0x95, 0x93, 0x78, 0x75, 0x1e, 0xac, 0xe1, 0xe3, 0xea, 0xcf,
+	0x8f, 0x1b, 0xa0, 0xfd, 0x15, 0xc0, 0x06, 0xa6, 0xd3, 0x54, 0x2a, 0x2a, 0x06, 0x3c, 0x65, 0x63,
+	0xc1, 0x73, 0x2e, 0x49, 0x56, 0x16, 0xa3, 0x52, 0x95, 0x51, 0x5b, 0xa9, 0x01, 0xa8, 0x05, 0x97,
+	0x13, 0x2a, 0x63, 0x91, 0xe6, 0x2a, 0xe5, 0xcc, 0x16, 0x7a, 0x93, 0x42, 0x4f, 0xe1, 0xd2, 0x8c,
+	0x2a, 0x92, 0x10, 0x45, 0xbc, 0x4a, 0xab, 0xd2, 0x59, 0xee, 0xad, 0x07, 0x46, 0xc0, 0x40, 0x6b,
+	0x66, 0x05, 0x0c, 0xf6, 0x6c, 0x50, 0xbf, 0x7a, 0x7e, 0xb9, 0xe1, 0xe0, 0xdf, 0x49, 0xc8, 0x87,
+	0x30, 0x17, 0x34, 0xe6, 0xb3, 0x3c, 0xcd, 0xa8, 0x3e, 0xd1, 0x12, 0xbe, 0xc1, 0xe8, 0xba, 0x9d,
+	0xf6, 0x3b, 0xb8, 0x36, 0x2f, 0x3b, 0xc2, 0x83, 0xde, 0xd6, 0x9d, 0xeb, 0xde, 0x84, 0x75, 0xad,
+	0x97, 0x6d, 0x10, 0x95, 0xba, 0xfa, 0x1a, 0xfe, 0x83, 0xb5, 0xdb, 0x4b, 0xb8, 0x7e, 0xc8, 0xa7,
+	0xd3, 0x8c, 0xea, 0x16, 0x0f, 0x38, 0x2b, 0xa8, 0x90, 0x29, 0xbf, 0xbb, 0x7c, 0x65, 0x5e, 0xb9,
+	0xa4, 0x57, 0xb1, 0x79, 0x25, 0xb0, 0xbd, 0xfa, 0x02, 0x60, 0x6d, 0x3b, 0xcb, 0xf8, 0x19, 0x61,
+	0x31, 0xfd, 0x6b, 0x4b, 0x19, 0x67, 0x58, 0x4b, 0x69, 0x50, 0x5a, 0x4a, 0xe6, 0x94, 0x25, 0x54,
+	0xd8, 0x6d, 0xe6, 0x10, 0xed, 0xc0, 0xc5, 0x82, 0x64, 0xa7, 0x46, 0xf7, 0x5a, 0x3f, 0x28, 0x7b,
+	0xf3, 0xfd, 0x72, 0x63, 0x73, 0x9a, 0xaa, 0xd7, 0xa7, 0x93, 0x20, 0xe6, 0xb3, 0xd0, 0xde, 0x06,
+	0xf3, 0x7b, 0x28, 0x93, 0x93, 0x50, 0xbd, 0xcd, 0xa9, 0x0c, 0x86, 0x4c, 0x61, 0x93, 0xdc, 0x3e,
+	0x80, 0xee, 0x5c, 0x88, 0x79, 0x9b, 0x6f, 0xf9, 0x02, 0xfc, 0x83, 0x2f, 0x1e, 0xbc, 0x82, 0x8b,
+	0xda, 0xb8, 0x68, 0x0d, 0xde, 0x1b, 0x3d, 0xdf, 0x8f, 0xf0, 0xf1, 0xd1, 0xfe, 0xc1, 0x38, 0x1a,
+	0x0c, 0x9f, 0x0d, 0xa3, 0x1d, 0xd7, 0x41, 0x2e, 0x5c, 0x31, 0xf4, 0xde, 0x68, 0xe7, 0x68, 0x37,
+	0x72, 0x01, 0x42, 0xb0, 0x6e, 0x98, 0xe8, 0xc5, 0x61, 0x84, 0xf7, 0xb7, 0x77, 0xdd, 0x05, 0xd4,
+	0x80, 0xae, 0xe1, 0xc6, 0x38, 0x1a, 0x8c, 0xf6, 0xc6, 0xc3, 0xdd, 0xc8, 0xad, 0x34, 0xab, 0xef,
+	0x3f, 0xf9, 0x4e, 0xbf, 0x7f, 0x7e, 0xe5, 0x83, 0x8b, 0x2b, 0x1f, 0xfc, 0xb8, 0xf2, 0xc1, 0x87,
+	0x6b, 0xdf, 0xb9, 0xb8, 0xf6, 0x9d, 0x6f, 0xd7, 0xbe, 0xf3, 0xb2, 0x73, 0xe3, 0xfc, 0xf6, 0xe5,
+	0xd0, 0xdf, 0xa2, 0xdb, 0x0b, 0xdf, 0xd8, 0x57, 0x44, 0xab, 0x30, 0xf9, 0x4f, 0xdf, 0xfe, 0x47,
+	0xbf, 0x06, 0x00, 0xe7, 0x18, 0xc3, 0x9f, 0x61, 0x04, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -586,6 +682,16 @@ func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Precompile {
+		i--
+		if m.Precompile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Metadata) > 0 {
 		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -707,6 +813,60 @@ func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -799,6 +959,9 @@ func (m *RegisterCoinProposal) Size() (n int) {
 			n += 1 + l + sovErc20(uint64(l))
 		}
 	}
+	if m.Precompile {
+		n += 2
+	}
 	return n
 }
 
@@ -846,6 +1009,29 @@ func (m *ToggleTokenConversionProposal) Size() (n int) {
 	return n
 }
 
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
 func (m *ProposalMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1150,6 +1336,26 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Precompile = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1466,6 +1672,187 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
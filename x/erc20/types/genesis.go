@@ -16,7 +16,11 @@
 
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
@@ -39,6 +43,7 @@ func DefaultGenesisState() *GenesisState {
 func (gs GenesisState) Validate() error {
 	seenErc20 := make(map[string]bool)
 	seenDenom := make(map[string]bool)
+	precompiles := make(map[string]bool)
 
 	for _, b := range gs.TokenPairs {
 		if seenErc20[b.Erc20Address] {
@@ -54,6 +59,27 @@ func (gs GenesisState) Validate() error {
 
 		seenErc20[b.Erc20Address] = true
 		seenDenom[b.Denom] = true
+		if b.IsPrecompile() {
+			precompiles[b.GetERC20Contract().String()] = true
+		}
+	}
+
+	seenAllowance := make(map[string]bool)
+	for _, a := range gs.Allowances {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		erc20 := common.HexToAddress(a.Erc20Address).String()
+		if !precompiles[erc20] {
+			return fmt.Errorf("allowance of a token that is not an ERC20 precompile on genesis: '%s'", a.Erc20Address)
+		}
+
+		key := erc20 + common.HexToAddress(a.Owner).String() + common.HexToAddress(a.Spender).String()
+		if seenAllowance[key] {
+			return fmt.Errorf("allowance duplicated on genesis: '%s' '%s' '%s'", a.Erc20Address, a.Owner, a.Spender)
+		}
+		seenAllowance[key] = true
 	}
 
 	return gs.Params.Validate()
@@ -32,6 +32,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// allowances is a slice of the allowances of the ERC20 precompiles at genesis
+	Allowances []Allowance `protobuf:"bytes,3,rep,name=allowances,proto3" json:"allowances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +88,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -155,26 +164,28 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x8e, 0x60, 0xb2, 0xcc,
-	0xd0, 0x40, 0xbf, 0x02, 0x1a, 0xa6, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xc5, 0x52, 0x8e, 0x9d, 0x01, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x93, 0xf6, 0xa3, 0x7c, 0x4c, 0x5a, 0xc5, 0x20, 0x12, 0x8b, 0xa4, 0xb5, 0xab, 0xae,
+	0x66, 0x6c, 0x74, 0xe3, 0x4a, 0x0d, 0x14, 0xdd, 0x08, 0xa5, 0x8a, 0x0b, 0x37, 0x65, 0x5a, 0xc6,
+	0x34, 0xb4, 0xc9, 0x0d, 0x99, 0x71, 0xd4, 0xb7, 0xf0, 0xad, 0xec, 0xb2, 0x4b, 0x57, 0x45, 0x92,
+	0x17, 0x91, 0xcc, 0x24, 0x88, 0xc5, 0xcd, 0x70, 0xe7, 0x9c, 0xdf, 0xb9, 0xf3, 0xe7, 0xa2, 0x23,
+	0x26, 0x23, 0xe0, 0x84, 0xa5, 0x33, 0xef, 0x84, 0xc8, 0x01, 0x09, 0x58, 0xcc, 0x78, 0xc8, 0x71,
+	0x92, 0x82, 0x00, 0x7b, 0x47, 0xb9, 0x58, 0xb9, 0x58, 0x0e, 0xda, 0xed, 0x2d, 0x5a, 0x1b, 0x8a,
+	0x6d, 0xef, 0x07, 0x10, 0x80, 0x2a, 0x49, 0x51, 0x69, 0xb5, 0xf7, 0x61, 0xa2, 0xe6, 0xb5, 0xee,
+	0x79, 0x27, 0xa8, 0x60, 0xf6, 0x19, 0x6a, 0x24, 0x34, 0xa5, 0x11, 0x77, 0xcc, 0xae, 0xd9, 0xb7,
+	0xbc, 0x03, 0xfc, 0xfb, 0x0c, 0x3c, 0x52, 0xae, 0xff, 0x6f, 0xb5, 0xe9, 0x18, 0xe3, 0x92, 0xb5,
+	0x2f, 0x91, 0x25, 0x60, 0xc1, 0xe2, 0x49, 0x42, 0xc3, 0x94, 0x3b, 0xb5, 0x6e, 0xbd, 0x6f, 0x79,
+	0x87, 0xdb, 0xd1, 0xfb, 0x02, 0x19, 0xd1, 0x30, 0x2d, 0xd3, 0x48, 0x54, 0x02, 0xb7, 0x2f, 0x10,
+	0xa2, 0xcb, 0x25, 0xbc, 0xd0, 0x78, 0xc6, 0xb8, 0x53, 0xff, 0xbb, 0xc1, 0x55, 0x45, 0x54, 0x0d,
+	0x7e, 0x22, 0xbd, 0x27, 0xd4, 0xd0, 0x57, 0xb3, 0x8f, 0x51, 0x93, 0xc5, 0x74, 0xba, 0x64, 0x13,
+	0x15, 0x54, 0x0f, 0xf9, 0x3f, 0xb6, 0xb4, 0x36, 0x2c, 0x24, 0xfb, 0x1c, 0xed, 0x56, 0x88, 0x8c,
+	0x26, 0x73, 0x80, 0x85, 0x53, 0x2b, 0x28, 0x7f, 0x2f, 0xdb, 0x74, 0x5a, 0x43, 0x4d, 0x3e, 0xdc,
+	0xde, 0x00, 0x2c, 0xc6, 0xad, 0x32, 0x28, 0xa3, 0x62, 0xeb, 0xfb, 0xab, 0xcc, 0x35, 0xd7, 0x99,
+	0x6b, 0x7e, 0x65, 0xae, 0xf9, 0x9e, 0xbb, 0xc6, 0x3a, 0x77, 0x8d, 0xcf, 0xdc, 0x35, 0x1e, 0xfb,
+	0x41, 0x28, 0xe6, 0xcf, 0x53, 0x3c, 0x83, 0x88, 0x94, 0x83, 0x50, 0xab, 0x1c, 0x78, 0xe4, 0xb5,
+	0x1c, 0x8a, 0x78, 0x4b, 0x18, 0x9f, 0x36, 0xd4, 0xe7, 0x9f, 0x7e, 0x0f, 0x00, 0x29, 0x5e, 0x43,
+	0xdd, 0xde, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +208,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -293,6 +318,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -415,6 +446,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
 	"github.com/evmos/evmos/v12/x/erc20/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -20,6 +22,10 @@ func TestGenesisTestSuite(t *testing.T) {
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	newGen := types.NewGenesisState(types.DefaultParams(), []types.TokenPair{})
+	precompilePair := types.NewPrecompileTokenPair("acoin")
+	owner := utiltx.GenerateAddress()
+	spender := utiltx.GenerateAddress()
+	allowance := types.NewAllowance(precompilePair.GetERC20Contract(), owner, spender, big.NewInt(100))
 
 	testCases := []struct {
 		name     string
@@ -129,6 +135,46 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with precompile allowances",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{precompilePair},
+				Allowances: []types.Allowance{allowance},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - allowance of a contract token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					types.NewTokenPair(precompilePair.GetERC20Contract(), "acoin", types.OWNER_MODULE),
+				},
+				Allowances: []types.Allowance{allowance},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated allowance",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{precompilePair},
+				Allowances: []types.Allowance{allowance, allowance},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero allowance",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{precompilePair},
+				Allowances: []types.Allowance{
+					types.NewAllowance(precompilePair.GetERC20Contract(), owner, spender, big.NewInt(0)),
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
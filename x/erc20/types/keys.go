@@ -45,6 +45,8 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixAllowance
+	prefixPrecompileTokenPair
 )
 
 // KVStore key prefixes
@@ -52,4 +54,17 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixAllowance        = []byte{prefixAllowance}
+	// KeyPrefixPrecompileTokenPair indexes the token pairs exposed by an ERC20
+	// precompile by their ERC20 address
+	KeyPrefixPrecompileTokenPair = []byte{prefixPrecompileTokenPair}
 )
+
+// AllowanceKey returns the key of the allowance of the spender over the tokens
+// of the owner for the given ERC20 precompile.
+func AllowanceKey(erc20, owner, spender common.Address) []byte {
+	key := make([]byte, 0, 3*common.AddressLength)
+	key = append(key, erc20.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return append(key, spender.Bytes()...)
+}
@@ -18,6 +18,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v12/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -33,6 +34,19 @@ func NewTokenPair(erc20Address common.Address, denom string, contractOwner Owner
 	}
 }
 
+// NewPrecompileTokenPair returns an instance of TokenPair for a native Cosmos
+// coin exposed by an ERC20 precompile at its deterministic address.
+func NewPrecompileTokenPair(denom string) TokenPair {
+	return NewTokenPair(PrecompileAddress(denom), denom, OWNER_PRECOMPILE)
+}
+
+// PrecompileAddress returns the deterministic address of the ERC20 precompile
+// of the given denomination, which is derived from the module name and the
+// denomination as for module accounts.
+func PrecompileAddress(denom string) common.Address {
+	return common.BytesToAddress(address.Module(ModuleName, []byte(denom)))
+}
+
 // GetID returns the SHA256 hash of the ERC20 address and denomination
 func (tp TokenPair) GetID() []byte {
 	id := tp.Erc20Address + "|" + tp.Denom
@@ -64,3 +78,9 @@ func (tp TokenPair) IsNativeCoin() bool {
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// IsPrecompile returns true if the ERC20 is a precompiled contract over the bank
+// balances of the native Cosmos coin
+func (tp TokenPair) IsPrecompile() bool {
+	return tp.ContractOwner == OWNER_PRECOMPILE
+}
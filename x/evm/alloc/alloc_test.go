@@ -0,0 +1,174 @@
+package alloc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/encoding"
+	evmostypes "github.com/evmos/evmos/v12/types"
+	"github.com/evmos/evmos/v12/x/evm/alloc"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+type AllocTestSuite struct {
+	suite.Suite
+
+	encCfg      params.EncodingConfig
+	dir         string
+	genesisFile string
+	gethGenesis core.Genesis
+}
+
+func TestAllocTestSuite(t *testing.T) {
+	suite.Run(t, new(AllocTestSuite))
+}
+
+func (suite *AllocTestSuite) SetupTest() {
+	suite.encCfg = encoding.MakeConfig(app.ModuleBasics)
+	suite.dir = suite.T().TempDir()
+
+	appState, err := json.Marshal(app.NewDefaultGenesisState())
+	suite.Require().NoError(err)
+
+	genDoc, err := json.Marshal(map[string]json.RawMessage{
+		"chain_id":         json.RawMessage(`"evmos_9000-1"`),
+		"genesis_time":     json.RawMessage(`"2023-01-01T00:00:00Z"`),
+		"consensus_params": json.RawMessage(`{"block":{"max_bytes":"22020096","max_gas":"40000000"}}`),
+		"app_state":        appState,
+	})
+	suite.Require().NoError(err)
+
+	suite.genesisFile = filepath.Join(suite.dir, "genesis.json")
+	suite.Require().NoError(os.WriteFile(suite.genesisFile, genDoc, 0o600))
+
+	chainConfig := evmtypes.DefaultChainConfig()
+	chainConfig.CancunBlock = nil
+
+	suite.gethGenesis = core.Genesis{
+		Config:     chainConfig.EthereumConfig(big.NewInt(9000)),
+		GasLimit:   40000000,
+		Difficulty: new(big.Int),
+		Alloc: core.GenesisAlloc{
+			common.HexToAddress("0x1000000000000000000000000000000000000001"): {
+				Code:    common.FromHex("0x6000"),
+				Storage: map[common.Hash]common.Hash{{1}: {2}, {3}: {4}},
+				Balance: big.NewInt(5),
+				Nonce:   1,
+			},
+			common.HexToAddress("0x2000000000000000000000000000000000000002"): {
+				Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil),
+				Nonce:   3,
+			},
+			common.HexToAddress("0x3000000000000000000000000000000000000003"): {
+				Balance: big.NewInt(7),
+			},
+		},
+	}
+}
+
+func (suite *AllocTestSuite) writeGethGenesis(genesis core.Genesis) string {
+	bz, err := json.Marshal(genesis)
+	suite.Require().NoError(err)
+
+	path := filepath.Join(suite.dir, "geth.json")
+	suite.Require().NoError(os.WriteFile(path, bz, 0o600))
+	return path
+}
+
+func (suite *AllocTestSuite) importGenesis() {
+	var buf bytes.Buffer
+	summary, err := alloc.Import(suite.encCfg.Codec, suite.genesisFile, suite.writeGethGenesis(suite.gethGenesis), &buf)
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, summary.Accounts)
+	suite.Require().Equal(1, summary.Contracts)
+	suite.Require().Equal("evmos_9000-1", summary.ChainID)
+	suite.Require().Equal(big.NewInt(9000), summary.ChainConfig.ChainID)
+
+	suite.Require().NoError(os.WriteFile(suite.genesisFile, buf.Bytes(), 0o600))
+}
+
+func (suite *AllocTestSuite) TestImport() {
+	suite.importGenesis()
+
+	bz, err := os.ReadFile(suite.genesisFile)
+	suite.Require().NoError(err)
+
+	var genDoc struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	suite.Require().NoError(json.Unmarshal(bz, &genDoc))
+	suite.Require().NoError(app.ModuleBasics.ValidateGenesis(suite.encCfg.Codec, suite.encCfg.TxConfig, genDoc.AppState))
+
+	var authGenState authtypes.GenesisState
+	suite.encCfg.Codec.MustUnmarshalJSON(genDoc.AppState[authtypes.ModuleName], &authGenState)
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 3)
+
+	for _, account := range accounts {
+		ethAccount, ok := account.(*evmostypes.EthAccount)
+		suite.Require().True(ok)
+
+		expected := suite.gethGenesis.Alloc[ethAccount.EthAddress()]
+		suite.Require().Equal(expected.Nonce, ethAccount.GetSequence())
+		suite.Require().Equal(common.BytesToHash(evmtypes.EmptyCodeHash) == ethAccount.GetCodeHash(), len(expected.Code) == 0)
+	}
+
+	var evmGenState evmtypes.GenesisState
+	suite.encCfg.Codec.MustUnmarshalJSON(genDoc.AppState[evmtypes.ModuleName], &evmGenState)
+	suite.Require().Len(evmGenState.Accounts, 1)
+	suite.Require().Equal("6000", evmGenState.Accounts[0].Code)
+	suite.Require().Len(evmGenState.Accounts[0].Storage, 2)
+	suite.Require().Nil(evmGenState.Params.ChainConfig.CancunBlock)
+}
+
+func (suite *AllocTestSuite) TestImportExistingAccount() {
+	suite.importGenesis()
+
+	_, err := alloc.Import(suite.encCfg.Codec, suite.genesisFile, suite.writeGethGenesis(suite.gethGenesis), &bytes.Buffer{})
+	suite.Require().ErrorContains(err, "already exists")
+}
+
+func (suite *AllocTestSuite) TestImportInvalidAddress() {
+	path := filepath.Join(suite.dir, "geth.json")
+	suite.Require().NoError(os.WriteFile(path, []byte(`{"alloc":{"0x12":{"balance":"0x1"}}}`), 0o600))
+
+	_, err := alloc.Import(suite.encCfg.Codec, suite.genesisFile, path, &bytes.Buffer{})
+	suite.Require().ErrorContains(err, "invalid alloc address")
+}
+
+func (suite *AllocTestSuite) TestExport() {
+	suite.importGenesis()
+
+	var buf bytes.Buffer
+	suite.Require().NoError(alloc.Export(suite.encCfg.Codec, suite.genesisFile, &buf))
+
+	var exported core.Genesis
+	suite.Require().NoError(json.Unmarshal(buf.Bytes(), &exported))
+
+	suite.Require().Equal(suite.gethGenesis.Config, exported.Config)
+	suite.Require().Equal(uint64(40000000), exported.GasLimit)
+	suite.Require().NotNil(exported.BaseFee)
+	suite.Require().Len(exported.Alloc, len(suite.gethGenesis.Alloc))
+
+	for address, expected := range suite.gethGenesis.Alloc {
+		account, ok := exported.Alloc[address]
+		suite.Require().True(ok, address.Hex())
+		suite.Require().Equal(expected.Balance, account.Balance)
+		suite.Require().Equal(expected.Nonce, account.Nonce)
+		suite.Require().Equal(expected.Code, account.Code)
+		suite.Require().Equal(expected.Storage, account.Storage)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package alloc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+)
+
+// cosmosHeader holds the fields of the Cosmos genesis that are mapped to the
+// header of the geth genesis.
+type cosmosHeader struct {
+	chainID     string
+	genesisTime time.Time
+	maxGas      json.RawMessage
+	evmParams   evmtypes.Params
+	feemarket   feemarkettypes.Params
+}
+
+// Export converts the Cosmos genesis file into a geth genesis and writes it to
+// w. The alloc is built from the x/evm accounts (code and storage), the account
+// sequences (nonces) and the bank balances of the EVM denomination. The x/evm
+// accounts are streamed from the file, so only the nonces and the balances are
+// kept in memory.
+func Export(cdc codec.JSONCodec, genesisFile string, w io.Writer) error {
+	header, err := readCosmosHeader(cdc, genesisFile)
+	if err != nil {
+		return err
+	}
+
+	nonces, balances, err := readNoncesAndBalances(cdc, genesisFile, header.evmParams.EvmDenom)
+	if err != nil {
+		return err
+	}
+
+	gethHeader, err := header.gethHeader()
+	if err != nil {
+		return err
+	}
+
+	return writeObject(w, gethHeader, map[string]valueWriter{
+		"alloc": func(w io.Writer) error {
+			return writeAlloc(cdc, genesisFile, nonces, balances, w)
+		},
+	})
+}
+
+// writeAlloc writes the geth alloc. The accounts of the x/evm genesis are
+// written as they are read, followed by the remaining accounts that only hold a
+// nonce or a balance.
+func writeAlloc(
+	cdc codec.JSONCodec,
+	genesisFile string,
+	nonces map[common.Address]uint64,
+	balances map[common.Address]*big.Int,
+	w io.Writer,
+) error {
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+
+	alloc := &listWriter{w: w}
+	writeAccount := func(address common.Address, code []byte, storage map[common.Hash]common.Hash) error {
+		balance, ok := balances[address]
+		if !ok {
+			balance = new(big.Int)
+		}
+
+		bz, err := json.Marshal(core.GenesisAccount{
+			Code:    code,
+			Storage: storage,
+			Balance: balance,
+			Nonce:   nonces[address],
+		})
+		if err != nil {
+			return err
+		}
+
+		delete(balances, address)
+		delete(nonces, address)
+
+		if err := alloc.member(address.Hex()); err != nil {
+			return err
+		}
+		_, err = w.Write(bz)
+		return err
+	}
+
+	if err := walkFile(genesisFile, map[string]handler{
+		"app_state/evm/accounts": eachElement(func(raw json.RawMessage) error {
+			var account evmtypes.GenesisAccount
+			if err := cdc.UnmarshalJSON(raw, &account); err != nil {
+				return err
+			}
+
+			if err := account.Validate(); err != nil {
+				return err
+			}
+
+			var storage map[common.Hash]common.Hash
+			if len(account.Storage) > 0 {
+				storage = make(map[common.Hash]common.Hash, len(account.Storage))
+				for _, state := range account.Storage {
+					storage[common.HexToHash(state.Key)] = common.HexToHash(state.Value)
+				}
+			}
+
+			return writeAccount(common.HexToAddress(account.Address), common.FromHex(account.Code), storage)
+		}),
+	}); err != nil {
+		return fmt.Errorf("failed to export evm accounts: %w", err)
+	}
+
+	// accounts without code or storage, sorted for a deterministic output
+	remaining := make([]common.Address, 0, len(balances)+len(nonces))
+	for address := range balances {
+		remaining = append(remaining, address)
+	}
+	for address := range nonces {
+		if _, ok := balances[address]; !ok {
+			remaining = append(remaining, address)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return bytes.Compare(remaining[i].Bytes(), remaining[j].Bytes()) < 0
+	})
+
+	for _, address := range remaining {
+		if err := writeAccount(address, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "}")
+	return err
+}
+
+// readCosmosHeader reads the chain-id, genesis time, block gas limit and the
+// x/evm and x/feemarket params from the genesis file.
+func readCosmosHeader(cdc codec.JSONCodec, genesisFile string) (*cosmosHeader, error) {
+	var (
+		header                     cosmosHeader
+		evmParams, feemarketParams json.RawMessage
+	)
+
+	if err := walkFile(genesisFile, map[string]handler{
+		"chain_id":                       decodeInto(&header.chainID),
+		"genesis_time":                   decodeInto(&header.genesisTime),
+		"consensus_params/block/max_gas": decodeInto(&header.maxGas),
+		"app_state/evm/params":           decodeInto(&evmParams),
+		"app_state/feemarket/params":     decodeInto(&feemarketParams),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to read genesis file: %w", err)
+	}
+
+	if len(evmParams) == 0 {
+		return nil, fmt.Errorf("genesis file doesn't contain the %s params", evmtypes.ModuleName)
+	}
+
+	if err := cdc.UnmarshalJSON(evmParams, &header.evmParams); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s params: %w", evmtypes.ModuleName, err)
+	}
+
+	header.feemarket = feemarkettypes.DefaultParams()
+	if len(feemarketParams) > 0 {
+		if err := cdc.UnmarshalJSON(feemarketParams, &header.feemarket); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s params: %w", feemarkettypes.ModuleName, err)
+		}
+	}
+
+	return &header, nil
+}
+
+// gethHeader returns the JSON encoded fields of the geth genesis, except for
+// the alloc.
+func (h cosmosHeader) gethHeader() (map[string]json.RawMessage, error) {
+	chainID, err := evmostypes.ParseChainID(h.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := h.evmParams.ChainConfig.EthereumConfig(chainID)
+
+	gasLimit := params.MaxGasLimit
+	if maxGas, err := strconv.ParseInt(string(bytes.Trim(h.maxGas, `"`)), 10, 64); err == nil && maxGas > 0 {
+		gasLimit = uint64(maxGas)
+	}
+
+	genesis := core.Genesis{
+		Config:     cfg,
+		GasLimit:   gasLimit,
+		Difficulty: new(big.Int),
+	}
+
+	if !h.genesisTime.IsZero() {
+		genesis.Timestamp = uint64(h.genesisTime.Unix())
+	}
+
+	if cfg.IsLondon(common.Big0) && !h.feemarket.NoBaseFee && !h.feemarket.BaseFee.IsNil() {
+		genesis.BaseFee = h.feemarket.BaseFee.BigInt()
+	}
+
+	bz, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	delete(fields, "alloc")
+	return fields, nil
+}
+
+// readNoncesAndBalances returns the non-zero account sequences and the
+// non-zero balances of the EVM denomination, indexed by address.
+func readNoncesAndBalances(
+	cdc codec.JSONCodec,
+	genesisFile, evmDenom string,
+) (map[common.Address]uint64, map[common.Address]*big.Int, error) {
+	nonces := make(map[common.Address]uint64)
+	balances := make(map[common.Address]*big.Int)
+
+	if err := walkFile(genesisFile, map[string]handler{
+		"app_state/auth/accounts": eachElement(func(raw json.RawMessage) error {
+			var account authtypes.AccountI
+			if err := cdc.UnmarshalInterfaceJSON(raw, &account); err != nil {
+				return err
+			}
+
+			if seq := account.GetSequence(); seq > 0 {
+				nonces[common.BytesToAddress(account.GetAddress())] = seq
+			}
+			return nil
+		}),
+		"app_state/bank/balances": eachElement(func(raw json.RawMessage) error {
+			var balance banktypes.Balance
+			if err := cdc.UnmarshalJSON(raw, &balance); err != nil {
+				return err
+			}
+
+			amount := balance.Coins.AmountOf(evmDenom)
+			if !amount.IsPositive() {
+				return nil
+			}
+
+			address, err := sdk.AccAddressFromBech32(balance.Address)
+			if err != nil {
+				return err
+			}
+
+			balances[common.BytesToAddress(address)] = amount.BigInt()
+			return nil
+		}),
+	}); err != nil {
+		return nil, nil, fmt.Errorf("failed to read accounts and balances: %w", err)
+	}
+
+	return nonces, balances, nil
+}
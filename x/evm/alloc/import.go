@@ -0,0 +1,294 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package alloc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// Summary describes the accounts imported from a geth genesis.
+type Summary struct {
+	// ChainID is the chain-id of the Cosmos genesis.
+	ChainID string
+	// Accounts is the number of imported accounts.
+	Accounts int
+	// Contracts is the number of imported accounts with code or storage.
+	Contracts int
+	// Balance is the total balance of the imported accounts.
+	Balance *big.Int
+	// ChainConfig is the chain configuration of the geth genesis, if any.
+	ChainConfig *params.ChainConfig
+}
+
+// Import merges the accounts of the geth genesis file into the Cosmos genesis
+// file and writes the result to w. Each alloc entry becomes an EthAccount, a
+// bank balance of the EVM denomination and, if it has code or storage, an x/evm
+// genesis account. The chain config of the geth genesis, if present, replaces
+// the x/evm chain config.
+//
+// The Cosmos genesis is loaded in memory, while the geth genesis is streamed
+// once per target module so that large allocs are never fully held in memory.
+func Import(cdc codec.JSONCodec, genesisFile, gethFile string, w io.Writer) (*Summary, error) {
+	bz, err := os.ReadFile(filepath.Clean(genesisFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var genDoc map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &genDoc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis file: %w", err)
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc["app_state"], &appState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis app state: %w", err)
+	}
+
+	var (
+		authGenState authtypes.GenesisState
+		bankGenState banktypes.GenesisState
+		evmGenState  evmtypes.GenesisState
+	)
+
+	for module, state := range map[string]codec.ProtoMarshaler{
+		authtypes.ModuleName: &authGenState,
+		banktypes.ModuleName: &bankGenState,
+		evmtypes.ModuleName:  &evmGenState,
+	} {
+		if len(appState[module]) == 0 {
+			return nil, fmt.Errorf("genesis app state doesn't contain the %s module", module)
+		}
+
+		if err := cdc.UnmarshalJSON(appState[module], state); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", module, err)
+		}
+	}
+
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[common.Address]bool, len(accounts))
+	accountNumber := uint64(0)
+	for _, account := range accounts {
+		existing[common.BytesToAddress(account.GetAddress())] = true
+		if account.GetAccountNumber() >= accountNumber {
+			accountNumber = account.GetAccountNumber() + 1
+		}
+	}
+
+	summary := &Summary{Balance: new(big.Int)}
+	if err := json.Unmarshal(genDoc["chain_id"], &summary.ChainID); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis chain-id: %w", err)
+	}
+
+	if err := walkFile(gethFile, map[string]handler{
+		"config": decodeInto(&summary.ChainConfig),
+		"alloc": eachAccount(func(address common.Address, account core.GenesisAccount) error {
+			if existing[address] {
+				return fmt.Errorf("account %s already exists in the genesis file", address)
+			}
+
+			if account.Balance.Sign() < 0 {
+				return fmt.Errorf("account %s has a negative balance", address)
+			}
+
+			summary.Accounts++
+			if len(account.Code) > 0 || len(account.Storage) > 0 {
+				summary.Contracts++
+			}
+			summary.Balance.Add(summary.Balance, account.Balance)
+			return nil
+		}),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to read geth genesis: %w", err)
+	}
+
+	evmDenom := evmGenState.Params.EvmDenom
+	if summary.ChainConfig != nil {
+		evmGenState.Params.ChainConfig = evmtypes.NewChainConfigFromEthereum(summary.ChainConfig)
+	}
+
+	if err := evmGenState.Params.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s params: %w", evmtypes.ModuleName, err)
+	}
+
+	// the supply is only tracked in genesis when it's already set, otherwise
+	// it's computed from the balances on InitGenesis
+	if len(bankGenState.Supply) > 0 && summary.Balance.Sign() > 0 {
+		bankGenState.Supply = bankGenState.Supply.Add(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(summary.Balance)))
+	}
+
+	authFields, err := marshalFields(cdc, &authGenState)
+	if err != nil {
+		return nil, err
+	}
+	bankFields, err := marshalFields(cdc, &bankGenState)
+	if err != nil {
+		return nil, err
+	}
+	evmFields, err := marshalFields(cdc, &evmGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	appStateWriters := map[string]valueWriter{
+		authtypes.ModuleName: func(w io.Writer) error {
+			return writeObject(w, authFields, map[string]valueWriter{
+				"accounts": appendArray(authFields["accounts"], func(emit func([]byte) error) error {
+					return forEachAccount(gethFile, func(address common.Address, account core.GenesisAccount) error {
+						ethAccount := &evmostypes.EthAccount{
+							BaseAccount: authtypes.NewBaseAccount(address.Bytes(), nil, accountNumber, account.Nonce),
+							CodeHash:    crypto.Keccak256Hash(account.Code).Hex(),
+						}
+						accountNumber++
+
+						bz, err := cdc.MarshalInterfaceJSON(ethAccount)
+						if err != nil {
+							return err
+						}
+						return emit(bz)
+					})
+				}),
+			})
+		},
+		banktypes.ModuleName: func(w io.Writer) error {
+			return writeObject(w, bankFields, map[string]valueWriter{
+				"balances": appendArray(bankFields["balances"], func(emit func([]byte) error) error {
+					return forEachAccount(gethFile, func(address common.Address, account core.GenesisAccount) error {
+						if account.Balance.Sign() == 0 {
+							return nil
+						}
+
+						bz, err := cdc.MarshalJSON(&banktypes.Balance{
+							Address: sdk.AccAddress(address.Bytes()).String(),
+							Coins:   sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(account.Balance))),
+						})
+						if err != nil {
+							return err
+						}
+						return emit(bz)
+					})
+				}),
+			})
+		},
+		evmtypes.ModuleName: func(w io.Writer) error {
+			return writeObject(w, evmFields, map[string]valueWriter{
+				"accounts": appendArray(evmFields["accounts"], func(emit func([]byte) error) error {
+					return forEachAccount(gethFile, func(address common.Address, account core.GenesisAccount) error {
+						if len(account.Code) == 0 && len(account.Storage) == 0 {
+							return nil
+						}
+
+						bz, err := cdc.MarshalJSON(&evmtypes.GenesisAccount{
+							Address: address.Hex(),
+							Code:    common.Bytes2Hex(account.Code),
+							Storage: newStorage(account.Storage),
+						})
+						if err != nil {
+							return err
+						}
+						return emit(bz)
+					})
+				}),
+			})
+		},
+	}
+
+	if err := writeObject(w, genDoc, map[string]valueWriter{
+		"app_state": func(w io.Writer) error {
+			return writeObject(w, appState, appStateWriters)
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// eachAccount returns a handler that decodes each member of a geth alloc.
+func eachAccount(fn func(address common.Address, account core.GenesisAccount) error) handler {
+	return eachMember(func(key string, raw json.RawMessage) error {
+		if !common.IsHexAddress(key) {
+			return fmt.Errorf("invalid alloc address %q", key)
+		}
+
+		var account core.GenesisAccount
+		if err := json.Unmarshal(raw, &account); err != nil {
+			return fmt.Errorf("invalid alloc account %s: %w", key, err)
+		}
+
+		return fn(common.HexToAddress(key), account)
+	})
+}
+
+// forEachAccount streams the alloc of the geth genesis file.
+func forEachAccount(gethFile string, fn func(address common.Address, account core.GenesisAccount) error) error {
+	return walkFile(gethFile, map[string]handler{
+		"alloc": eachAccount(fn),
+	})
+}
+
+// newStorage returns the x/evm storage of a geth alloc account, sorted by key.
+func newStorage(storage map[common.Hash]common.Hash) evmtypes.Storage {
+	states := make(evmtypes.Storage, 0, len(storage))
+	for key, value := range storage {
+		states = append(states, evmtypes.NewState(key, value))
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return bytes.Compare(common.HexToHash(states[i].Key).Bytes(), common.HexToHash(states[j].Key).Bytes()) < 0
+	})
+
+	return states
+}
+
+// marshalFields returns the JSON encoded fields of the module genesis state.
+func marshalFields(cdc codec.JSONCodec, state codec.ProtoMarshaler) (map[string]json.RawMessage, error) {
+	bz, err := cdc.MarshalJSON(state)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,342 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package alloc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// handler consumes the JSON value the decoder is positioned at.
+type handler func(dec *json.Decoder) error
+
+// walkFile streams the JSON file at path. See walk.
+func walkFile(path string, handlers map[string]handler) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return walk(bufio.NewReader(f), handlers)
+}
+
+// walk streams the JSON value read from r and calls the handler registered for
+// each path, where a path is the sequence of object keys joined by "/". Values
+// that are neither handled nor on the way to a handled path are skipped token by
+// token, so they are never held in memory.
+func walk(r io.Reader, handlers map[string]handler) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return walkValue(dec, "", handlers)
+}
+
+func walkValue(dec *json.Decoder, path string, handlers map[string]handler) error {
+	if h, ok := handlers[path]; ok && path != "" {
+		return h(dec)
+	}
+
+	if !hasHandlerUnder(handlers, path) {
+		return skipValue(dec)
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return skipRest(dec, tok)
+	}
+
+	for dec.More() {
+		key, err := objectKey(dec)
+		if err != nil {
+			return err
+		}
+
+		if path != "" {
+			key = path + "/" + key
+		}
+
+		if err := walkValue(dec, key, handlers); err != nil {
+			return err
+		}
+	}
+
+	// consume the closing delimiter
+	_, err = dec.Token()
+	return err
+}
+
+func hasHandlerUnder(handlers map[string]handler, path string) bool {
+	if path == "" {
+		return true
+	}
+
+	for p := range handlers {
+		if strings.HasPrefix(p, path+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func objectKey(dec *json.Decoder) (string, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("expected JSON object key, got %v", tok)
+	}
+
+	return key, nil
+}
+
+// skipValue discards the next JSON value of the decoder.
+func skipValue(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	return skipRest(dec, tok)
+}
+
+// skipRest discards the remainder of the JSON value whose first token is tok.
+func skipRest(dec *json.Decoder, tok json.Token) error {
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return nil
+	}
+
+	if delim == '}' || delim == ']' {
+		return fmt.Errorf("unexpected JSON delimiter %s", delim)
+	}
+
+	for depth := 1; depth > 0; {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+
+	return nil
+}
+
+// decodeInto returns a handler that decodes the value into v.
+func decodeInto(v interface{}) handler {
+	return func(dec *json.Decoder) error {
+		return dec.Decode(v)
+	}
+}
+
+// eachElement returns a handler that calls fn with the raw bytes of each
+// element of a JSON array. A null value is treated as an empty array.
+func eachElement(fn func(raw json.RawMessage) error) handler {
+	return func(dec *json.Decoder) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if tok == nil {
+			return nil
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("expected JSON array, got %v", tok)
+		}
+
+		for dec.More() {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+
+			if err := fn(raw); err != nil {
+				return err
+			}
+		}
+
+		_, err = dec.Token()
+		return err
+	}
+}
+
+// eachMember returns a handler that calls fn with the key and the raw bytes of
+// each member of a JSON object. A null value is treated as an empty object.
+func eachMember(fn func(key string, raw json.RawMessage) error) handler {
+	return func(dec *json.Decoder) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if tok == nil {
+			return nil
+		}
+
+		if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+			return fmt.Errorf("expected JSON object, got %v", tok)
+		}
+
+		for dec.More() {
+			key, err := objectKey(dec)
+			if err != nil {
+				return err
+			}
+
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+
+			if err := fn(key, raw); err != nil {
+				return err
+			}
+		}
+
+		_, err = dec.Token()
+		return err
+	}
+}
+
+// listWriter writes the comma separated members of a JSON object or the
+// elements of a JSON array.
+type listWriter struct {
+	w io.Writer
+	n int
+}
+
+// element writes a raw array element.
+func (lw *listWriter) element(raw []byte) error {
+	if lw.n > 0 {
+		if _, err := io.WriteString(lw.w, ","); err != nil {
+			return err
+		}
+	}
+	lw.n++
+
+	_, err := lw.w.Write(raw)
+	return err
+}
+
+// member writes the key of an object member. The value must be written next.
+func (lw *listWriter) member(key string) error {
+	bz, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+
+	return lw.element(append(bz, ':'))
+}
+
+// valueWriter writes a JSON value to w.
+type valueWriter func(w io.Writer) error
+
+// appender streams additional array elements through emit.
+type appender func(emit func(raw []byte) error) error
+
+// writeObject writes a JSON object with sorted keys. The members are taken from
+// fields, unless a writer is registered for the key.
+func writeObject(w io.Writer, fields map[string]json.RawMessage, writers map[string]valueWriter) error {
+	keys := make([]string, 0, len(fields)+len(writers))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	for key := range writers {
+		if _, ok := fields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
+	}
+
+	obj := &listWriter{w: w}
+	for _, key := range keys {
+		if err := obj.member(key); err != nil {
+			return err
+		}
+
+		write, ok := writers[key]
+		if !ok {
+			if _, err := w.Write(fields[key]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := write(w); err != nil {
+			return fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+
+	_, err := io.WriteString(w, "}")
+	return err
+}
+
+// appendArray returns a writer for the elements of the existing JSON array
+// followed by the elements streamed by fn.
+func appendArray(existing json.RawMessage, fn appender) valueWriter {
+	return func(w io.Writer) error {
+		var elements []json.RawMessage
+		if len(existing) > 0 {
+			if err := json.Unmarshal(existing, &elements); err != nil {
+				return err
+			}
+		}
+
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+
+		arr := &listWriter{w: w}
+		for _, element := range elements {
+			if err := arr.element(element); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(arr.element); err != nil {
+			return err
+		}
+
+		_, err := io.WriteString(w, "]")
+		return err
+	}
+}
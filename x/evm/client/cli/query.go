@@ -16,7 +16,9 @@
 package cli
 
 import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -38,6 +40,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetStorageCmd(),
 		GetCodeCmd(),
+		GetCodeHashUsageCmd(),
+		GetContractABICmd(),
+		GetDecodeCallCmd(),
+		GetDecodeErrorCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -120,6 +126,149 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCodeHashUsageCmd queries the number of accounts that reference a code hash
+func GetCodeHashUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-hash-usage CODE_HASH",
+		Short: "Gets the number of accounts that reference a contract code hash",
+		Long:  "Gets the number of accounts that reference a contract code hash and the size of the stored code. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCodeHashUsageRequest{
+				CodeHash: args[0],
+			}
+
+			res, err := queryClient.CodeHashUsage(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetContractABICmd queries the ABI registered for a contract
+func GetContractABICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-abi ADDRESS",
+		Short: "Gets the ABI and metadata registered for a contract",
+		Long:  "Gets the ABI and metadata registered on chain for a contract. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryContractABIRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.ContractABI(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDecodeCallCmd decodes the input of a contract call
+func GetDecodeCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-call ADDRESS CALL_DATA",
+		Short: "Decodes the method and arguments of a contract call",
+		Long:  "Decodes the method and arguments of a contract call with the ABI registered on chain for the contract, or with the ABI file provided with --abi.", //nolint:lll
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractABI, err := getContractABI(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			input, err := hexutil.Decode(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid call data")
+			}
+
+			call, err := types.DecodeCall(contractABI, input, nil)
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, call)
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "JSON ABI file of the contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDecodeErrorCmd decodes the revert data of a contract call
+func GetDecodeErrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-error ADDRESS REVERT_DATA",
+		Short: "Decodes the revert reason or custom error of a contract call",
+		Long:  "Decodes the revert reason, panic code or custom error of a contract call with the ABI registered on chain for the contract, or with the ABI file provided with --abi.", //nolint:lll
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractABI, err := getContractABI(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			data, err := hexutil.Decode(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid revert data")
+			}
+
+			decodedErr, err := types.DecodeError(contractABI, data)
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, decodedErr)
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "JSON ABI file of the contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries the fee market params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +23,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -40,7 +42,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewRegisterContractABICmd(),
+	)
 	return cmd
 }
 
@@ -124,3 +129,64 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewRegisterContractABICmd registers the ABI and metadata of a contract
+// deployed by the sender.
+func NewRegisterContractABICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-contract-abi CONTRACT ABI_FILE",
+		Short: "Register the ABI of a contract deployed by the sender",
+		Long: `Register the JSON ABI of a contract deployed by the sender. The deployment is proved by the nonce of the
+sender when the contract was created. The ABIs of the contracts created by other contracts can only be registered
+through governance.`,
+		Example: fmt.Sprintf(
+			"$ %s tx %s register-contract-abi 0x... ./Token.abi.json --nonce 4 --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			abiJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := cmd.Flags().GetUint64(flagNonce)
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterContractABI{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: contract,
+				ABI:      string(abiJSON),
+				Metadata: metadata,
+				Nonce:    nonce,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagNonce, 0, "nonce of the sender when the contract was created with CREATE")
+	cmd.Flags().String(flagMetadata, "", "metadata of the contract, e.g. the Solidity metadata JSON or the source repository")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
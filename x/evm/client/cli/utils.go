@@ -16,14 +16,28 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rpctypes "github.com/evmos/evmos/v12/rpc/types"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+const (
+	flagABI      = "abi"
+	flagMetadata = "metadata"
+	flagNonce    = "nonce"
 )
 
 func accountToHex(addr string) (string, error) {
@@ -60,3 +74,44 @@ func formatKeyToHash(key string) string {
 
 	return ethkey.Hex()
 }
+
+// getContractABI returns the ABI of the file set with the abi flag or, if not
+// set, the ABI registered on chain for the contract.
+func getContractABI(cmd *cobra.Command, clientCtx client.Context, contract string) (abi.ABI, error) {
+	abiFile, err := cmd.Flags().GetString(flagABI)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	if abiFile != "" {
+		bz, err := os.ReadFile(abiFile)
+		if err != nil {
+			return abi.ABI{}, err
+		}
+		return types.ParseABI(string(bz))
+	}
+
+	address, err := accountToHex(contract)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	res, err := types.NewQueryClient(clientCtx).ContractABI(
+		rpctypes.ContextWithHeight(clientCtx.Height),
+		&types.QueryContractABIRequest{Address: address},
+	)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	return types.ParseABI(res.ContractABI.ABI)
+}
+
+// printJSON prints the JSON encoding of a value that isn't a proto message.
+func printJSON(clientCtx client.Context, v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(bz)
+}
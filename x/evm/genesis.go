@@ -39,6 +39,12 @@ func InitGenesis(
 ) []abci.ValidatorUpdate {
 	k.WithChainID(ctx)
 
+	// the contract ABIs are set first, as they define the events of the event
+	// bridges of the params
+	for _, contractABI := range data.ContractABIs {
+		k.SetContractABI(ctx, contractABI)
+	}
+
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
@@ -78,6 +84,10 @@ func InitGenesis(
 
 		k.SetCode(ctx, codeHash.Bytes(), code)
 
+		if len(code) != 0 {
+			k.SetCodeRefCount(ctx, codeHash, k.GetCodeRefCount(ctx, codeHash)+1)
+		}
+
 		for _, storage := range account.Storage {
 			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
 		}
@@ -110,8 +120,15 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	var contractABIs []types.ContractABI
+	k.IterateContractABIs(ctx, func(contractABI types.ContractABI) bool {
+		contractABIs = append(contractABIs, contractABI)
+		return false
+	})
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:     ethGenAccounts,
+		Params:       k.GetParams(ctx),
+		ContractABIs: contractABIs,
 	}
 }
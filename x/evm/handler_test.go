@@ -709,6 +709,63 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 	}
 }
 
+func (suite *EvmTestSuite) TestPreTxProcessingHooks() {
+	testCases := []struct {
+		msg    string
+		hooks  func() types.EvmHooks
+		expErr bool
+	}{
+		{
+			"failure hook",
+			func() types.EvmHooks { return &PreFailureHook{} },
+			true,
+		},
+		{
+			"isolated failure hook, revert on error",
+			func() types.EvmHooks {
+				return keeper.NewIsolatedEvmHook(suite.app.EvmKeeper, &PreFailureHook{}, keeper.EvmHookOptions{RevertOnError: true})
+			},
+			true,
+		},
+		{
+			"isolated failure hook, log and continue",
+			func() types.EvmHooks {
+				return keeper.NewIsolatedEvmHook(suite.app.EvmKeeper, &PreFailureHook{}, keeper.EvmHookOptions{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			k := suite.app.EvmKeeper.CleanHooks()
+			k.SetHooks(tc.hooks())
+
+			to := utiltx.GenerateAddress()
+			nonce := k.GetNonce(suite.ctx, suite.from)
+			tx := types.NewTx(&types.EvmTxArgs{
+				ChainID:  suite.chainID,
+				Nonce:    nonce,
+				To:       &to,
+				Amount:   big.NewInt(0),
+				GasPrice: big.NewInt(0),
+				GasLimit: 21000,
+			})
+			suite.SignTx(tx)
+
+			res, err := k.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			if tc.expErr {
+				suite.Require().ErrorContains(err, types.ErrPreTxProcessing.Error())
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed())
+		})
+	}
+}
+
 func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 	intrinsicGas := uint64(134180)
 	testCases := []struct {
@@ -767,6 +824,10 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 // DummyHook implements EvmHooks interface
 type DummyHook struct{}
 
+func (dh *DummyHook) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 func (dh *DummyHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
 	return nil
 }
@@ -774,6 +835,21 @@ func (dh *DummyHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes
 // FailureHook implements EvmHooks interface
 type FailureHook struct{}
 
+func (dh *FailureHook) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 func (dh *FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
 	return errors.New("mock error")
 }
+
+// PreFailureHook implements EvmHooks interface
+type PreFailureHook struct{}
+
+func (dh *PreFailureHook) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return errors.New("mock error")
+}
+
+func (dh *PreFailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
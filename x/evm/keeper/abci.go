@@ -16,6 +16,8 @@
 package keeper
 
 import (
+	"math/big"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,9 +25,22 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper. It also
+// records the randomness of the block once the PREVRANDAO opcode is enabled, so
+// that the queries executed on the block state return the same randomness as
+// its transactions, records the rates of the fee denominations priced by an
+// oracle, and executes speculatively the Ethereum transactions of the block
+// when the parallel execution is enabled.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	chainConfig := k.GetParams(ctx).ChainConfig
+	if chainConfig.IsPrevRandao(big.NewInt(ctx.BlockHeight())) {
+		k.SetBlockRandomness(ctx, k.BlockRandomness(ctx))
+	}
+
+	k.UpdateFeeDenomRates(ctx)
+	k.PrepareParallelExecution(ctx)
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
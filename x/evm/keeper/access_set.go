@@ -0,0 +1,517 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// keyRange is the range of keys [start, end) read by an iterator, along with
+// the keys and values within the range when it was first read. A nil start or
+// end defines an unbounded range.
+type keyRange struct {
+	start, end []byte
+	pairs      []kv.Pair
+}
+
+// readRange returns the range of keys of the store.
+func readRange(store storetypes.KVStore, start, end []byte) keyRange {
+	r := keyRange{
+		start: bytes.Clone(start),
+		end:   bytes.Clone(end),
+	}
+
+	it := store.Iterator(start, end)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		r.pairs = append(r.pairs, kv.Pair{
+			Key:   bytes.Clone(it.Key()),
+			Value: bytes.Clone(it.Value()),
+		})
+	}
+	return r
+}
+
+// unchanged returns true if the keys and values within the range are the same
+// in the store.
+func (r keyRange) unchanged(store storetypes.KVStore) bool {
+	it := store.Iterator(r.start, r.end)
+	defer it.Close()
+
+	i := 0
+	for ; it.Valid(); it.Next() {
+		if i == len(r.pairs) ||
+			!bytes.Equal(it.Key(), r.pairs[i].Key) ||
+			!bytes.Equal(it.Value(), r.pairs[i].Value) {
+			return false
+		}
+		i++
+	}
+	return i == len(r.pairs)
+}
+
+// accessSet records the keys read and written on the stores of a multistore
+// during an execution. The values read are the ones of the keys before being
+// written by the execution, and the written values are the final ones, a nil
+// value meaning that the key is absent or deleted.
+//
+// The accessSet of a branch of the multistore records its reads on the one of
+// the parent as well, as they affect the execution even if the branch is
+// discarded, and its writes once the branch is written.
+type accessSet struct {
+	parent *accessSet
+	keys   map[string]storetypes.StoreKey
+	reads  map[string]map[string][]byte
+	ranges map[string][]keyRange
+	writes map[string]map[string][]byte
+}
+
+// newAccessSet returns an empty accessSet.
+func newAccessSet() *accessSet {
+	return &accessSet{
+		keys:   make(map[string]storetypes.StoreKey),
+		reads:  make(map[string]map[string][]byte),
+		ranges: make(map[string][]keyRange),
+		writes: make(map[string]map[string][]byte),
+	}
+}
+
+// branch returns an empty accessSet for a branch of the multistore.
+func (as *accessSet) branch() *accessSet {
+	child := newAccessSet()
+	child.parent = as
+	return child
+}
+
+func (as *accessSet) recordRead(storeKey storetypes.StoreKey, key, value []byte) {
+	name := storeKey.Name()
+	if _, found := as.writes[name][string(key)]; found {
+		// the value doesn't depend on the state read anymore
+		return
+	}
+	if as.parent != nil {
+		as.parent.recordRead(storeKey, key, value)
+	}
+	if _, found := as.reads[name][string(key)]; found {
+		return
+	}
+
+	as.keys[name] = storeKey
+	if as.reads[name] == nil {
+		as.reads[name] = make(map[string][]byte)
+	}
+	as.reads[name][string(key)] = bytes.Clone(value)
+}
+
+// recordRange records the range of keys of the parent store read by an
+// iterator. The iterators of a branch always read the store of the parent, so
+// only the range of the store at the root of the branches is recorded.
+func (as *accessSet) recordRange(storeKey storetypes.StoreKey, parent storetypes.KVStore, start, end []byte) {
+	if as.parent != nil {
+		return
+	}
+
+	name := storeKey.Name()
+	for _, r := range as.ranges[name] {
+		if bytes.Equal(r.start, start) && bytes.Equal(r.end, end) {
+			return
+		}
+	}
+
+	as.keys[name] = storeKey
+	as.ranges[name] = append(as.ranges[name], readRange(parent, start, end))
+}
+
+func (as *accessSet) recordWrite(storeKey storetypes.StoreKey, key, value []byte) {
+	name := storeKey.Name()
+	as.keys[name] = storeKey
+	if as.writes[name] == nil {
+		as.writes[name] = make(map[string][]byte)
+	}
+	as.writes[name][string(key)] = bytes.Clone(value)
+}
+
+// unchanged returns true if the values of the keys and the ranges of keys read
+// are the current ones of the multistore.
+func (as *accessSet) unchanged(current storetypes.MultiStore) bool {
+	for name, ranges := range as.ranges {
+		store := current.GetKVStore(as.keys[name])
+		for _, r := range ranges {
+			if !r.unchanged(store) {
+				return false
+			}
+		}
+	}
+
+	for name, reads := range as.reads {
+		store := current.GetKVStore(as.keys[name])
+		for key, value := range reads {
+			currentValue := store.Get([]byte(key))
+			if (value == nil) != (currentValue == nil) || !bytes.Equal(value, currentValue) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// commit records the writes on the accessSet of the parent, once the branch is
+// written.
+func (as *accessSet) commit() {
+	if as.parent == nil {
+		return
+	}
+
+	for name, written := range as.writes {
+		for key, value := range written {
+			as.parent.recordWrite(as.keys[name], []byte(key), value)
+		}
+	}
+}
+
+// writesEqual returns true if both AccessSets wrote the same values on the same
+// keys.
+func (as *accessSet) writesEqual(other *accessSet) bool {
+	if len(as.writes) != len(other.writes) {
+		return false
+	}
+	for name, written := range as.writes {
+		otherWritten, found := other.writes[name]
+		if !found || len(written) != len(otherWritten) {
+			return false
+		}
+		for key, value := range written {
+			otherValue, found := otherWritten[key]
+			if !found || (value == nil) != (otherValue == nil) || !bytes.Equal(value, otherValue) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// applyWrites writes the recorded values on the stores of the multistore, in
+// the order of the store names and keys.
+func (as *accessSet) applyWrites(ms storetypes.MultiStore) {
+	names := make([]string, 0, len(as.writes))
+	for name := range as.writes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		store := ms.GetKVStore(as.keys[name])
+		written := as.writes[name]
+
+		keys := make([]string, 0, len(written))
+		for key := range written {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if value := written[key]; value == nil {
+				store.Delete([]byte(key))
+			} else {
+				store.Set([]byte(key), value)
+			}
+		}
+	}
+}
+
+var (
+	_ storetypes.KVStore         = &trackedStore{}
+	_ storetypes.CacheMultiStore = &trackedMultiStore{}
+	_ storetypes.KVStore         = lockedStore{}
+	_ storetypes.Iterator        = lockedIterator{}
+)
+
+// trackedStore is a branch of a KVStore that records the keys read and written
+// on its accessSet.
+type trackedStore struct {
+	parent   storetypes.KVStore
+	cache    *cachekv.Store
+	storeKey storetypes.StoreKey
+	access   *accessSet
+}
+
+func (s *trackedStore) GetStoreType() storetypes.StoreType {
+	return s.cache.GetStoreType()
+}
+
+func (s *trackedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *trackedStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+func (s *trackedStore) Get(key []byte) []byte {
+	value := s.cache.Get(key)
+	s.access.recordRead(s.storeKey, key, value)
+	return value
+}
+
+func (s *trackedStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *trackedStore) Set(key, value []byte) {
+	s.cache.Set(key, value)
+	s.access.recordWrite(s.storeKey, key, value)
+}
+
+func (s *trackedStore) Delete(key []byte) {
+	s.cache.Delete(key)
+	s.access.recordWrite(s.storeKey, key, nil)
+}
+
+func (s *trackedStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.access.recordRange(s.storeKey, s.parent, start, end)
+	return s.cache.Iterator(start, end)
+}
+
+func (s *trackedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.access.recordRange(s.storeKey, s.parent, start, end)
+	return s.cache.ReverseIterator(start, end)
+}
+
+// trackedMultiStore is a branch of a MultiStore whose stores record the keys
+// read and written on its accessSet. The stores are branched lazily, on first
+// access, and are written to the parent in the order of their names.
+type trackedMultiStore struct {
+	parent storetypes.MultiStore
+	access *accessSet
+	stores map[storetypes.StoreKey]*trackedStore
+}
+
+// newTrackedMultiStore branches the MultiStore, recording the accesses on the
+// given accessSet.
+func newTrackedMultiStore(parent storetypes.MultiStore, access *accessSet) *trackedMultiStore {
+	return &trackedMultiStore{
+		parent: parent,
+		access: access,
+		stores: make(map[storetypes.StoreKey]*trackedStore),
+	}
+}
+
+func (ms *trackedMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (ms *trackedMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *trackedMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore branches the multistore, recording the accesses of the
+// branch on a branch of its accessSet.
+func (ms *trackedMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newTrackedMultiStore(ms, ms.access.branch())
+}
+
+func (ms *trackedMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, fmt.Errorf("cannot branch a tracked multistore at a given version")
+}
+
+func (ms *trackedMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *trackedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, found := ms.stores[key]
+	if !found {
+		parent := ms.parent.GetKVStore(key)
+		store = &trackedStore{
+			parent:   parent,
+			cache:    cachekv.NewStore(parent),
+			storeKey: key,
+			access:   ms.access,
+		}
+		ms.stores[key] = store
+	}
+	return store
+}
+
+func (ms *trackedMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (ms *trackedMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *trackedMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *trackedMultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
+
+// Write writes the branched stores to the parent.
+func (ms *trackedMultiStore) Write() {
+	keys := make([]storetypes.StoreKey, 0, len(ms.stores))
+	for key := range ms.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+
+	for _, key := range keys {
+		ms.stores[key].cache.Write()
+	}
+	ms.access.commit()
+}
+
+// lockedMultiStore is a read-only view of a MultiStore that can be shared by
+// concurrent executions, as all the accesses to its stores are serialized.
+type lockedMultiStore struct {
+	storetypes.MultiStore
+	mtx *sync.Mutex
+}
+
+func newLockedMultiStore(ms storetypes.MultiStore) lockedMultiStore {
+	return lockedMultiStore{
+		MultiStore: ms,
+		mtx:        &sync.Mutex{},
+	}
+}
+
+func (ms lockedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	return lockedStore{
+		parent: ms.MultiStore.GetKVStore(key),
+		mtx:    ms.mtx,
+	}
+}
+
+func (ms lockedMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// lockedStore is a read-only KVStore whose accesses are serialized.
+type lockedStore struct {
+	parent storetypes.KVStore
+	mtx    *sync.Mutex
+}
+
+func (s lockedStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s lockedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s lockedStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+func (s lockedStore) Get(key []byte) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.parent.Get(key)
+}
+
+func (s lockedStore) Has(key []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.parent.Has(key)
+}
+
+func (s lockedStore) Set(_, _ []byte) {
+	panic("cannot write on a locked store")
+}
+
+func (s lockedStore) Delete(_ []byte) {
+	panic("cannot write on a locked store")
+}
+
+func (s lockedStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return lockedIterator{parent: s.parent.Iterator(start, end), mtx: s.mtx}
+}
+
+func (s lockedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return lockedIterator{parent: s.parent.ReverseIterator(start, end), mtx: s.mtx}
+}
+
+// lockedIterator is an iterator of a lockedStore.
+type lockedIterator struct {
+	parent storetypes.Iterator
+	mtx    *sync.Mutex
+}
+
+func (it lockedIterator) Domain() (start, end []byte) {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.parent.Domain()
+}
+
+func (it lockedIterator) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.parent.Valid()
+}
+
+func (it lockedIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	it.parent.Next()
+}
+
+func (it lockedIterator) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.parent.Key()
+}
+
+func (it lockedIterator) Value() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.parent.Value()
+}
+
+func (it lockedIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.parent.Error()
+}
+
+func (it lockedIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.parent.Close()
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// GetCodeRefCount returns the number of accounts that reference the code hash.
+func (k *Keeper) GetCodeRefCount(ctx sdk.Context, codeHash common.Hash) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeRefCount)
+
+	bz := store.Get(codeHash.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetCodeRefCount sets the number of accounts that reference the code hash,
+// the count is deleted when it's zero.
+func (k *Keeper) SetCodeRefCount(ctx sdk.Context, codeHash common.Hash, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeRefCount)
+
+	if count == 0 {
+		store.Delete(codeHash.Bytes())
+		return
+	}
+
+	store.Set(codeHash.Bytes(), sdk.Uint64ToBigEndian(count))
+}
+
+// IterateCodeRefCounts iterates over the reference counts of all the code
+// hashes, callback returns true to break early.
+func (k *Keeper) IterateCodeRefCounts(ctx sdk.Context, cb func(codeHash common.Hash, count uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeRefCount)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(common.BytesToHash(iterator.Key()), sdk.BigEndianToUint64(iterator.Value())) {
+			return
+		}
+	}
+}
+
+// updateCodeRefCounts moves the reference of an account from its previous code
+// hash to the new one. The code of the previous hash is deleted when it's no
+// longer referenced by any account.
+func (k *Keeper) updateCodeRefCounts(ctx sdk.Context, prevCodeHash, codeHash common.Hash) {
+	if prevCodeHash == codeHash {
+		return
+	}
+
+	if types.IsContractCodeHash(codeHash) {
+		k.SetCodeRefCount(ctx, codeHash, k.GetCodeRefCount(ctx, codeHash)+1)
+	}
+
+	if !types.IsContractCodeHash(prevCodeHash) {
+		return
+	}
+
+	count := k.GetCodeRefCount(ctx, prevCodeHash)
+	switch {
+	case count > 1:
+		k.SetCodeRefCount(ctx, prevCodeHash, count-1)
+	case count == 1:
+		k.SetCodeRefCount(ctx, prevCodeHash, 0)
+		k.SetCode(ctx, prevCodeHash.Bytes(), nil)
+	default:
+		// the code is not tracked, keep it to be safe
+		k.Logger(ctx).Error("missing code reference count", "code-hash", prevCodeHash.Hex())
+	}
+}
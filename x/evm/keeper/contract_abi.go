@@ -0,0 +1,64 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// GetContractABI returns the ABI and metadata registered for the contract.
+func (k *Keeper) GetContractABI(ctx sdk.Context, address common.Address) (types.ContractABI, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractABI)
+
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return types.ContractABI{}, false
+	}
+
+	var contractABI types.ContractABI
+	k.cdc.MustUnmarshal(bz, &contractABI)
+	return contractABI, true
+}
+
+// SetContractABI registers the ABI and metadata of a contract, replacing the
+// previous registration.
+func (k *Keeper) SetContractABI(ctx sdk.Context, contractABI types.ContractABI) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractABI)
+	address := common.HexToAddress(contractABI.Address)
+	store.Set(address.Bytes(), k.cdc.MustMarshal(&contractABI))
+}
+
+// IterateContractABIs iterates over the registered contract ABIs, callback
+// returns true to break early.
+func (k *Keeper) IterateContractABIs(ctx sdk.Context, cb func(contractABI types.ContractABI) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractABI)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var contractABI types.ContractABI
+		k.cdc.MustUnmarshal(iterator.Value(), &contractABI)
+
+		if cb(contractABI) {
+			break
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterContractABI() {
+	var (
+		contractAddr common.Address
+		nonce        uint64
+	)
+	sender := sdk.AccAddress(suite.address.Bytes()).String()
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgRegisterContractABI
+		expPass  bool
+	}{
+		{
+			"deployer with the creation nonce",
+			func() *types.MsgRegisterContractABI {
+				return &types.MsgRegisterContractABI{Sender: sender, Contract: contractAddr.Hex(), ABI: transferEventABI, Nonce: nonce}
+			},
+			true,
+		},
+		{
+			"deployer with a wrong nonce",
+			func() *types.MsgRegisterContractABI {
+				return &types.MsgRegisterContractABI{Sender: sender, Contract: contractAddr.Hex(), ABI: transferEventABI, Nonce: nonce + 1}
+			},
+			false,
+		},
+		{
+			"not the deployer",
+			func() *types.MsgRegisterContractABI {
+				other := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+				return &types.MsgRegisterContractABI{Sender: other, Contract: contractAddr.Hex(), ABI: transferEventABI, Nonce: nonce}
+			},
+			false,
+		},
+		{
+			"authority",
+			func() *types.MsgRegisterContractABI {
+				authority := suite.app.EvmKeeper.GetAuthority().String()
+				return &types.MsgRegisterContractABI{Sender: authority, Contract: contractAddr.Hex(), ABI: transferEventABI}
+			},
+			true,
+		},
+		{
+			"authority with an account that isn't a contract",
+			func() *types.MsgRegisterContractABI {
+				authority := suite.app.EvmKeeper.GetAuthority().String()
+				return &types.MsgRegisterContractABI{Sender: authority, Contract: suite.address.Hex(), ABI: transferEventABI}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			nonce = suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1e18))
+
+			msg := tc.malleate()
+			_, err := suite.app.EvmKeeper.RegisterContractABI(sdk.WrapSDKContext(suite.ctx), msg)
+
+			res, queryErr := suite.queryClient.ContractABI(suite.ctx, &types.QueryContractABIRequest{Address: msg.Contract})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Error(queryErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NoError(queryErr)
+
+			codeHash := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, contractAddr).CodeHash
+			suite.Require().Equal(types.ContractABI{
+				Address:  contractAddr.Hex(),
+				CodeHash: common.BytesToHash(codeHash).Hex(),
+				ABI:      transferEventABI,
+			}, res.ContractABI)
+		})
+	}
+}
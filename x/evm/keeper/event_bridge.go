@@ -0,0 +1,172 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// abiCacheSize is the maximum number of parsed ABIs held by the ABI cache.
+const abiCacheSize = 256
+
+// abiCache holds the parsed contract ABIs of the event bridges, so that an ABI
+// is parsed once and not for every bridged log. The ABIs are keyed by the hash
+// of their JSON, so a cached ABI doesn't depend on the state it was read from
+// and a registration replacing the ABI of a contract is parsed again.
+type abiCache struct {
+	mtx     sync.Mutex
+	entries map[common.Hash]abi.ABI
+}
+
+// parse returns the parsed JSON ABI, parsing it if it isn't cached. The cache
+// is cleared when it's full.
+func (c *abiCache) parse(abiJSON string) (abi.ABI, error) {
+	key := crypto.Keccak256Hash([]byte(abiJSON))
+
+	c.mtx.Lock()
+	contractABI, ok := c.entries[key]
+	c.mtx.Unlock()
+	if ok {
+		return contractABI, nil
+	}
+
+	contractABI, err := types.ParseABI(abiJSON)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.entries == nil || len(c.entries) >= abiCacheSize {
+		c.entries = make(map[common.Hash]abi.ABI)
+	}
+	c.entries[key] = contractABI
+	return contractABI, nil
+}
+
+// bridgedEvent returns the event of the event bridge defined in the ABI
+// registered for its contract.
+func (k Keeper) bridgedEvent(ctx sdk.Context, bridge types.EventBridge) (abi.Event, error) {
+	contractABI, found := k.GetContractABI(ctx, common.HexToAddress(bridge.Contract))
+	if !found {
+		return abi.Event{}, errorsmod.Wrapf(types.ErrInvalidEventBridge, "no ABI registered for contract %s", bridge.Contract)
+	}
+	return k.abiEvent(bridge, contractABI.ABI)
+}
+
+// abiEvent returns the event of the event bridge defined in the JSON ABI of
+// its contract.
+func (k Keeper) abiEvent(bridge types.EventBridge, abiJSON string) (abi.Event, error) {
+	contractABI, err := k.abiCache.parse(abiJSON)
+	if err != nil {
+		return abi.Event{}, errorsmod.Wrapf(types.ErrInvalidEventBridge, "ABI of contract %s: %s", bridge.Contract, err)
+	}
+
+	event, err := bridge.ABIEvent(contractABI)
+	if err != nil {
+		return abi.Event{}, errorsmod.Wrapf(types.ErrInvalidEventBridge, "contract %s: %s", bridge.Contract, err)
+	}
+	return event, nil
+}
+
+// ValidateEventBridges checks that the events of the event bridges are defined
+// in the ABIs registered for their contracts.
+func (k Keeper) ValidateEventBridges(ctx sdk.Context, bridges []types.EventBridge) error {
+	for _, bridge := range bridges {
+		if _, err := k.bridgedEvent(ctx, bridge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BridgedLogEvents returns the typed Cosmos events of the logs emitted by the
+// contract events registered in the event bridges of the params. The logs are
+// decoded with the ABIs registered for the contracts, the logs that can't be
+// decoded are skipped.
+//
+// The params and ABIs are read without consuming gas, so bridging events
+// doesn't change the gas used by the transaction.
+func (k Keeper) BridgedLogEvents(ctx sdk.Context, txHash string, logs []*types.Log) sdk.Events {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	ctx = ctx.WithKVGasConfig(storetypes.GasConfig{})
+	bridges := k.GetParams(ctx).EventBridges
+	if len(bridges) == 0 {
+		return nil
+	}
+
+	// the events of the bridges are only looked up for the matched logs, once
+	// per transaction
+	abiEvents := make(map[int]abi.Event, len(bridges))
+
+	var events sdk.Events
+	for _, log := range logs {
+		ethLog := log.ToEthereum()
+		for i, bridge := range bridges {
+			if !bridge.Matches(ethLog) {
+				continue
+			}
+
+			event, ok := abiEvents[i]
+			if !ok {
+				var err error
+				if event, err = k.bridgedEvent(ctx, bridge); err != nil {
+					k.Logger(ctx).Error(
+						"failed to find bridged event",
+						"contract", bridge.Contract,
+						"event", bridge.Event,
+						"tx-hash", txHash,
+						"error", err.Error(),
+					)
+					continue
+				}
+				abiEvents[i] = event
+			}
+
+			attrs, err := types.DecodeLog(event, ethLog)
+			if err != nil {
+				k.Logger(ctx).Error(
+					"failed to decode bridged log",
+					"contract", bridge.Contract,
+					"event", bridge.Event,
+					"tx-hash", txHash,
+					"error", err.Error(),
+				)
+				continue
+			}
+
+			attrs = append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyContractAddress, bridge.Contract),
+				sdk.NewAttribute(types.AttributeKeyEthereumTxHash, txHash),
+			}, attrs...)
+			events = append(events, sdk.NewEvent(bridge.EventType, attrs...))
+		}
+	}
+	return events
+}
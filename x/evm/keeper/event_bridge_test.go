@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+const transferEventABI = `[{
+	"type": "event",
+	"name": "Transfer",
+	"inputs": [
+		{"name": "from", "type": "address", "indexed": true},
+		{"name": "to", "type": "address", "indexed": true},
+		{"name": "value", "type": "uint256", "indexed": false}
+	]
+}]`
+
+func (suite *KeeperTestSuite) TestBridgedLogEvents() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1e18))
+	recipient := utiltx.GenerateAddress()
+
+	transfer := func(bridges []types.EventBridge) (*types.MsgEthereumTxResponse, sdk.Context) {
+		ctx, _ := suite.ctx.CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		for _, bridge := range bridges {
+			suite.app.EvmKeeper.SetContractABI(ctx, types.ContractABI{Address: bridge.Contract, ABI: transferEventABI})
+		}
+		params := suite.app.EvmKeeper.GetParams(ctx)
+		params.EventBridges = bridges
+		suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+
+		data, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(100))
+		suite.Require().NoError(err)
+
+		msg := types.NewTx(&types.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			Nonce:    suite.app.EvmKeeper.GetNonce(ctx, suite.address),
+			To:       &contractAddr,
+			GasLimit: 100_000,
+			Input:    data,
+		})
+		msg.From = suite.address.Hex()
+		suite.Require().NoError(msg.Sign(suite.ethSigner, suite.signer))
+
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		res, err := suite.app.EvmKeeper.EthereumTx(ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed())
+		return res, ctx
+	}
+
+	findEvent := func(ctx sdk.Context, eventType string) (sdk.Event, bool) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return event, true
+			}
+		}
+		return sdk.Event{}, false
+	}
+
+	expRes, expCtx := transfer(nil)
+	_, found := findEvent(expCtx, "erc20_transfer")
+	suite.Require().False(found)
+
+	testCases := []struct {
+		name     string
+		bridges  []types.EventBridge
+		expEvent bool
+	}{
+		{
+			"bridged event",
+			[]types.EventBridge{{Contract: contractAddr.Hex(), Event: "Transfer(address,address,uint256)", EventType: "erc20_transfer"}},
+			true,
+		},
+		{
+			"event of another contract",
+			[]types.EventBridge{{Contract: utiltx.GenerateAddress().Hex(), Event: "Transfer(address,address,uint256)", EventType: "erc20_transfer"}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, ctx := transfer(tc.bridges)
+			suite.Require().Equal(expRes.GasUsed, res.GasUsed)
+			suite.Require().Equal(expCtx.GasMeter().GasConsumed(), ctx.GasMeter().GasConsumed())
+
+			event, found := findEvent(ctx, "erc20_transfer")
+			suite.Require().Equal(tc.expEvent, found)
+			if !tc.expEvent {
+				return
+			}
+
+			attrs := make([]sdk.Attribute, len(event.Attributes))
+			for i, attr := range event.Attributes {
+				attrs[i] = sdk.NewAttribute(string(attr.Key), string(attr.Value))
+			}
+			suite.Require().Equal([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.Hex()),
+				sdk.NewAttribute(types.AttributeKeyEthereumTxHash, res.Hash),
+				sdk.NewAttribute("from", suite.address.Hex()),
+				sdk.NewAttribute("to", recipient.Hex()),
+				sdk.NewAttribute("value", "100"),
+			}, attrs)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidateEventBridges() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1e18))
+	bridge := types.EventBridge{Contract: contractAddr.Hex(), Event: "Transfer(address,address,uint256)", EventType: "erc20_transfer"}
+	approvalEventABI := strings.Replace(transferEventABI, `"Transfer"`, `"Approval"`, 1)
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context) error
+		expPass  bool
+	}{
+		{
+			"bridged event defined in the registered ABI",
+			func(ctx sdk.Context) error {
+				suite.app.EvmKeeper.SetContractABI(ctx, types.ContractABI{Address: contractAddr.Hex(), ABI: transferEventABI})
+				return suite.app.EvmKeeper.ValidateEventBridges(ctx, []types.EventBridge{bridge})
+			},
+			true,
+		},
+		{
+			"contract without registered ABI",
+			func(ctx sdk.Context) error {
+				return suite.app.EvmKeeper.ValidateEventBridges(ctx, []types.EventBridge{bridge})
+			},
+			false,
+		},
+		{
+			"bridged event missing from the registered ABI",
+			func(ctx sdk.Context) error {
+				suite.app.EvmKeeper.SetContractABI(ctx, types.ContractABI{Address: contractAddr.Hex(), ABI: approvalEventABI})
+				return suite.app.EvmKeeper.ValidateEventBridges(ctx, []types.EventBridge{bridge})
+			},
+			false,
+		},
+		{
+			"params with a bridged contract without registered ABI",
+			func(ctx sdk.Context) error {
+				params := suite.app.EvmKeeper.GetParams(ctx)
+				params.EventBridges = []types.EventBridge{bridge}
+				return suite.app.EvmKeeper.SetParams(ctx, params)
+			},
+			false,
+		},
+		{
+			"registration of an ABI missing the bridged event",
+			func(ctx sdk.Context) error {
+				suite.app.EvmKeeper.SetContractABI(ctx, types.ContractABI{Address: contractAddr.Hex(), ABI: transferEventABI})
+				params := suite.app.EvmKeeper.GetParams(ctx)
+				params.EventBridges = []types.EventBridge{bridge}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(ctx, params))
+
+				authority := suite.app.EvmKeeper.GetAuthority().String()
+				_, err := suite.app.EvmKeeper.RegisterContractABI(sdk.WrapSDKContext(ctx), &types.MsgRegisterContractABI{
+					Sender:   authority,
+					Contract: contractAddr.Hex(),
+					ABI:      approvalEventABI,
+				})
+				return err
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			err := tc.malleate(ctx)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidEventBridge)
+			}
+		})
+	}
+}
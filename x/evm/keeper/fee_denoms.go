@@ -0,0 +1,277 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// priceOracleGasLimit is the gas limit of the calls to the price oracles of the
+// fee denominations, which caps the work done for each of them per block.
+const priceOracleGasLimit = 200_000
+
+// WithERC20Keeper sets the x/erc20 keeper used to pay the fees with the ERC-20
+// tokens of the token pairs.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) WithERC20Keeper(erc20Keeper types.ERC20Keeper) *Keeper {
+	if k.erc20Keeper != nil {
+		panic("cannot set evm erc20 keeper twice")
+	}
+
+	k.erc20Keeper = erc20Keeper
+	return k
+}
+
+// DeductTxCostsInFeeDenom deducts the fees of an Ethereum transaction, given in
+// the EVM denomination, from the user balance of the fee denomination chosen by
+// the transaction. The ERC-20 tokens of the fee denomination token pair are only
+// converted if the transaction allows it. The fees paid are recorded for the
+// refund of the leftover gas. Returns the fees deducted.
+func (k *Keeper) DeductTxCostsInFeeDenom(
+	ctx sdk.Context,
+	fees sdk.Coins,
+	from common.Address,
+	txHash common.Hash,
+	choice types.FeeDenomChoice,
+) (sdk.Coins, error) {
+	amount := fees.AmountOf(k.GetParams(ctx).EvmDenom)
+
+	signerAcc, err := authante.GetSignerAcc(ctx, k.accountKeeper, from.Bytes())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "account not found for sender %s", from)
+	}
+
+	rate, err := k.FeeDenomRate(ctx, choice.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := sdk.NewCoin(choice.FeeDenom.Denom, types.ConvertFee(amount, rate))
+
+	balance := k.bankKeeper.GetBalance(ctx, signerAcc.GetAddress(), fee.Denom)
+	if balance.IsLT(fee) && choice.ConvertERC20 {
+		if k.erc20Keeper == nil {
+			return nil, fmt.Errorf("no token pairs to convert the ERC-20 tokens of %s", fee.Denom)
+		}
+		if err := k.erc20Keeper.ConvertERC20ToCoin(ctx, from, fee.Sub(balance)); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to convert the ERC-20 tokens")
+		}
+	}
+
+	if err := authante.DeductFees(k.bankKeeper, ctx, signerAcc, sdk.Coins{fee}); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to deduct fees %s from the user %s balance", fee, from)
+	}
+
+	k.SetFeePaymentTransient(ctx, txHash, types.FeePayment{Payer: from.Hex(), Fee: fee})
+	return sdk.Coins{fee}, nil
+}
+
+// FeeDenomRate returns the amount of the EVM denomination paid by one unit of
+// the fee denomination, which is the governance-set rate or the TWAP returned by
+// the price oracle of the fee denomination at the beginning of the block (see
+// UpdateFeeDenomRates).
+func (k Keeper) FeeDenomRate(ctx sdk.Context, feeDenom types.FeeDenom) (sdk.Dec, error) {
+	if feeDenom.PriceOracle == "" {
+		return feeDenom.Rate, nil
+	}
+
+	rate, found := k.GetFeeDenomRate(ctx, feeDenom.Denom)
+	if !found {
+		return sdk.Dec{}, fmt.Errorf("no price oracle rate for fee denom %s", feeDenom.Denom)
+	}
+	return rate, nil
+}
+
+// UpdateFeeDenomRates consults the price oracles of the fee denominations and
+// records their rates, so that the oracles are called once per block and not
+// by the ante handler of each transaction. The previous rate of a fee
+// denomination is kept if its price oracle fails. The rates of the fee
+// denominations that are no longer priced by an oracle are removed.
+func (k *Keeper) UpdateFeeDenomRates(ctx sdk.Context) {
+	feeDenoms := k.GetParams(ctx).FeeDenoms
+
+	priced := make(map[string]bool, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if feeDenom.PriceOracle == "" {
+			continue
+		}
+		priced[feeDenom.Denom] = true
+
+		rate, err := k.consultPriceOracle(ctx, feeDenom)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to consult the price oracle of the fee denom",
+				"denom", feeDenom.Denom,
+				"oracle", feeDenom.PriceOracle,
+				"error", err.Error(),
+			)
+			continue
+		}
+		k.SetFeeDenomRate(ctx, feeDenom.Denom, rate)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenomRate)
+	var removed [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if !priced[string(iterator.Key())] {
+			removed = append(removed, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range removed {
+		store.Delete(key)
+	}
+}
+
+// consultPriceOracle returns the TWAP of the fee denomination returned by its
+// price oracle. The call is limited to priceOracleGasLimit gas.
+func (k *Keeper) consultPriceOracle(ctx sdk.Context, feeDenom types.FeeDenom) (sdk.Dec, error) {
+	if k.erc20Keeper == nil {
+		return sdk.Dec{}, fmt.Errorf("no token pairs to consult the price oracle of %s", feeDenom.Denom)
+	}
+
+	token, found := k.erc20Keeper.GetTokenPairERC20(ctx, feeDenom.Denom)
+	if !found {
+		return sdk.Dec{}, fmt.Errorf("no token pair for fee denom %s", feeDenom.Denom)
+	}
+
+	data, err := types.PriceOracleABI.Pack("consult", token, types.PriceOracleAmountIn)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	oracle := common.HexToAddress(feeDenom.PriceOracle)
+	msg := ethtypes.NewMessage(
+		common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName)),
+		&oracle,
+		0,
+		big.NewInt(0),
+		priceOracleGasLimit,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		data,
+		ethtypes.AccessList{},
+		true,
+	)
+
+	res, err := k.ApplyMessage(ctx, msg, types.NewNoOpTracer(), false)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if res.Failed() {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrVMExecution, "price oracle %s call failed: %s", oracle, res.VmError)
+	}
+
+	var amountOut *big.Int
+	if err := types.PriceOracleABI.UnpackIntoInterface(&amountOut, "consult", res.Ret); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	rate := sdk.NewDecFromBigInt(amountOut).QuoInt(sdkmath.NewIntFromBigInt(types.PriceOracleAmountIn))
+	if !rate.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("invalid price oracle %s rate %s", oracle, rate)
+	}
+	return rate, nil
+}
+
+// GetFeeDenomRate returns the rate of the fee denomination recorded from its
+// price oracle, if any.
+func (k Keeper) GetFeeDenomRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenomRate)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.Dec{}, false
+	}
+
+	var rate sdk.Dec
+	if err := rate.Unmarshal(bz); err != nil {
+		return sdk.Dec{}, false
+	}
+	return rate, true
+}
+
+// SetFeeDenomRate records the rate of the fee denomination returned by its price oracle.
+func (k Keeper) SetFeeDenomRate(ctx sdk.Context, denom string, rate sdk.Dec) {
+	bz, err := rate.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeDenomRate)
+	store.Set([]byte(denom), bz)
+}
+
+// RefundFeePayment refunds the leftover gas of a transaction whose fees were
+// paid by a fee granter or with a fee denomination to the payer, in proportion
+// to the fees paid.
+func (k *Keeper) RefundFeePayment(ctx sdk.Context, msg core.Message, leftoverGas uint64, payment types.FeePayment) error {
+	if leftoverGas == 0 || msg.Gas() == 0 {
+		return nil
+	}
+
+	refund := payment.Fee.Amount.Mul(sdkmath.NewIntFromUint64(leftoverGas)).Quo(sdkmath.NewIntFromUint64(msg.Gas()))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	refundedCoins := sdk.Coins{sdk.NewCoin(payment.Fee.Denom, refund)}
+	payer := common.HexToAddress(payment.Payer)
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer.Bytes(), refundedCoins)
+	if err != nil {
+		err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
+		return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+	}
+	return nil
+}
+
+// SetFeePaymentTransient records the fees of the given transaction paid by a
+// fee granter or with a fee denomination.
+func (k Keeper) SetFeePaymentTransient(ctx sdk.Context, txHash common.Hash, payment types.FeePayment) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayment)
+	store.Set(txHash.Bytes(), k.cdc.MustMarshal(&payment))
+}
+
+// GetFeePaymentTransient returns the fees of the given transaction paid by a
+// fee granter or with a fee denomination, if any.
+func (k Keeper) GetFeePaymentTransient(ctx sdk.Context, txHash common.Hash) (types.FeePayment, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayment)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return types.FeePayment{}, false
+	}
+
+	var payment types.FeePayment
+	k.cdc.MustUnmarshal(bz, &payment)
+	return payment, true
+}
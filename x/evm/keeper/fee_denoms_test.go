@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	erc20types "github.com/evmos/evmos/v12/x/erc20/types"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// priceOracleCode is the runtime code of a price oracle returning 2e18 for any
+// call, i.e. a rate of 2.
+var priceOracleCode = hexutil.MustDecode("0x7f0000000000000000000000000000000000000000000000001bc16d674ec8000060005260206000f3")
+
+// revertCode is the runtime code of a contract reverting any call.
+var revertCode = hexutil.MustDecode("0x60006000fd")
+
+func (suite *KeeperTestSuite) TestDeductTxCostsInFeeDenom() {
+	var feeDenom types.FeeDenom
+	oracle := utiltx.GenerateAddress()
+	txHash := common.Hash{1}
+	fees := sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 1000))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPaid  sdk.Coin
+		expPass  bool
+	}{
+		{
+			"governance-set rate",
+			func() {
+				feeDenom = types.FeeDenom{Denom: "uusdc", Rate: sdk.NewDecWithPrec(5, 1)}
+			},
+			sdk.NewInt64Coin("uusdc", 2000),
+			true,
+		},
+		{
+			"rounded up amount",
+			func() {
+				feeDenom = types.FeeDenom{Denom: "uusdc", Rate: sdk.NewDec(3)}
+			},
+			sdk.NewInt64Coin("uusdc", 334),
+			true,
+		},
+		{
+			"insufficient balance",
+			func() {
+				feeDenom = types.FeeDenom{Denom: "uusdc", Rate: sdk.NewDecWithPrec(1, 1)}
+			},
+			sdk.Coin{},
+			false,
+		},
+		{
+			"price oracle rate",
+			func() {
+				pair := erc20types.NewTokenPair(utiltx.GenerateAddress(), "uusdc", erc20types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+				vmdb := suite.StateDB()
+				vmdb.SetCode(oracle, priceOracleCode)
+				suite.Require().NoError(vmdb.Commit())
+
+				feeDenom = types.FeeDenom{Denom: "uusdc", Rate: sdk.ZeroDec(), PriceOracle: oracle.Hex()}
+			},
+			sdk.NewInt64Coin("uusdc", 500),
+			true,
+		},
+		{
+			"price oracle without token pair",
+			func() {
+				feeDenom = types.FeeDenom{Denom: "uusdc", Rate: sdk.ZeroDec(), PriceOracle: oracle.Hex()}
+			},
+			sdk.Coin{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.FeeDenoms = []types.FeeDenom{feeDenom}
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			suite.app.EvmKeeper.UpdateFeeDenomRates(suite.ctx)
+
+			initBalance := sdk.NewInt64Coin("uusdc", 3000)
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), sdk.NewCoins(initBalance))
+			suite.Require().NoError(err)
+
+			choice := types.FeeDenomChoice{FeeDenom: feeDenom}
+			paid, err := suite.app.EvmKeeper.DeductTxCostsInFeeDenom(suite.ctx, fees, suite.address, txHash, choice)
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), "uusdc")
+			payment, found := suite.app.EvmKeeper.GetFeePaymentTransient(suite.ctx, txHash)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(initBalance, balance)
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.Coins{tc.expPaid}, paid)
+			suite.Require().Equal(initBalance.Sub(tc.expPaid), balance)
+			suite.Require().True(found)
+			suite.Require().Equal(types.FeePayment{Payer: suite.address.Hex(), Fee: tc.expPaid}, payment)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeDenomRates() {
+	suite.SetupTest()
+
+	oracle := utiltx.GenerateAddress()
+	pair := erc20types.NewTokenPair(utiltx.GenerateAddress(), "uusdc", erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(oracle, priceOracleCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	feeDenom := types.FeeDenom{Denom: "uusdc", Rate: sdk.ZeroDec(), PriceOracle: oracle.Hex()}
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = []types.FeeDenom{feeDenom}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the rate is only known once the oracle is consulted
+	_, err := suite.app.EvmKeeper.FeeDenomRate(suite.ctx, feeDenom)
+	suite.Require().Error(err)
+
+	suite.app.EvmKeeper.UpdateFeeDenomRates(suite.ctx)
+	rate, err := suite.app.EvmKeeper.FeeDenomRate(suite.ctx, feeDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(2), rate)
+
+	// the previous rate is kept if the oracle reverts
+	vmdb = suite.StateDB()
+	vmdb.SetCode(oracle, revertCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	suite.app.EvmKeeper.UpdateFeeDenomRates(suite.ctx)
+	rate, err = suite.app.EvmKeeper.FeeDenomRate(suite.ctx, feeDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(2), rate)
+
+	// the rate is removed along with the fee denom
+	params.FeeDenoms = nil
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.app.EvmKeeper.UpdateFeeDenomRates(suite.ctx)
+	_, found := suite.app.EvmKeeper.GetFeeDenomRate(suite.ctx, feeDenom.Denom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRefundFeePayment() {
+	suite.SetupTest()
+
+	payer := utiltx.GenerateAddress()
+	payment := types.FeePayment{Payer: payer.Hex(), Fee: sdk.NewInt64Coin("uusdc", 2000)}
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(payment.Fee))
+	suite.Require().NoError(err)
+
+	to := utiltx.GenerateAddress()
+	msg := ethtypes.NewMessage(suite.address, &to, 0, big.NewInt(0), 100, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false)
+
+	// a quarter of the gas is left over, which is refunded to the payer
+	suite.Require().NoError(suite.app.EvmKeeper.RefundFeePayment(suite.ctx, msg, 25, payment))
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, payer.Bytes(), "uusdc")
+	suite.Require().Equal(sdkmath.NewInt(500), balance.Amount)
+
+	// no leftover gas
+	suite.Require().NoError(suite.app.EvmKeeper.RefundFeePayment(suite.ctx, msg, 0, payment))
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, payer.Bytes(), "uusdc")
+	suite.Require().Equal(sdkmath.NewInt(500), balance.Amount)
+}
@@ -214,6 +214,51 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	}, nil
 }
 
+// CodeHashUsage implements the Query/CodeHashUsage gRPC method
+func (k Keeper) CodeHashUsage(c context.Context, req *types.QueryCodeHashUsageRequest) (*types.QueryCodeHashUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bz, err := hexutil.Decode(req.CodeHash)
+	if err != nil || len(bz) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code hash %s", req.CodeHash)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	codeHash := common.BytesToHash(bz)
+
+	return &types.QueryCodeHashUsageResponse{
+		RefCount: k.GetCodeRefCount(ctx, codeHash),
+		CodeSize: uint64(len(k.GetCode(ctx, codeHash))),
+	}, nil
+}
+
+// ContractABI implements the Query/ContractABI gRPC method
+func (k Keeper) ContractABI(c context.Context, req *types.QueryContractABIRequest) (*types.QueryContractABIResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument, err.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractABI, found := k.GetContractABI(ctx, common.HexToAddress(req.Address))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no ABI registered for contract %s", req.Address)
+	}
+
+	return &types.QueryContractABIResponse{
+		ContractABI: contractABI,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -346,6 +346,76 @@ func (suite *KeeperTestSuite) TestQueryCode() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryCodeHashUsage() {
+	var (
+		req                  *types.QueryCodeHashUsageRequest
+		expRefCount, expSize uint64
+	)
+
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	testCases := []struct {
+		msg      string
+		malleate func(vm.StateDB)
+		expPass  bool
+	}{
+		{
+			"invalid code hash",
+			func(vm.StateDB) {
+				req = &types.QueryCodeHashUsageRequest{
+					CodeHash: "0x1234",
+				}
+			},
+			false,
+		},
+		{
+			"unknown code hash",
+			func(vm.StateDB) {
+				req = &types.QueryCodeHashUsageRequest{
+					CodeHash: codeHash.Hex(),
+				}
+				expRefCount, expSize = 0, 0
+			},
+			true,
+		},
+		{
+			"success",
+			func(vmdb vm.StateDB) {
+				vmdb.SetCode(suite.address, code)
+				vmdb.SetCode(utiltx.GenerateAddress(), code)
+
+				req = &types.QueryCodeHashUsageRequest{
+					CodeHash: codeHash.Hex(),
+				}
+				expRefCount, expSize = 2, uint64(len(code))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			vmdb := suite.StateDB()
+			tc.malleate(vmdb)
+			suite.Require().NoError(vmdb.Commit())
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.CodeHashUsage(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRefCount, res.RefCount)
+				suite.Require().Equal(expSize, res.CodeSize)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryTxLogs() {
 	var expLogs []*types.Log
 	txHash := common.BytesToHash([]byte("tx_hash"))
@@ -16,14 +16,21 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v12/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks = MultiEvmHooks{}
+	_ types.EvmHooks = IsolatedEvmHook{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -33,6 +40,16 @@ func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
 	return hooks
 }
 
+// PreTxProcessing delegate the call to underlying hooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg core.Message, sender common.Address) error {
+	for i := range mh {
+		if err := mh[i].PreTxProcessing(ctx, msg, sender); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
 // PostTxProcessing delegate the call to underlying hooks
 func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	for i := range mh {
@@ -42,3 +59,138 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// EvmHookOptions defines how a hook wrapped by IsolatedEvmHook is executed by
+// default. The options are overridden by the EvmHooks params of the hook name.
+type EvmHookOptions struct {
+	// Name identifies the hook in the failure events and logs
+	Name string
+	// GasLimit is the maximum amount of gas the hook can consume on each call,
+	// zero means unlimited
+	GasLimit uint64
+	// RevertOnError reverts the whole transaction when the hook fails. Otherwise
+	// the failure is logged, the state changes of the hook are discarded and the
+	// transaction continues.
+	RevertOnError bool
+}
+
+// IsolatedEvmHook runs a hook on a cached context with its own gas meter, so
+// that its state changes are only persisted when it succeeds. Failures and out
+// of gas errors of the hook emit an EventTypeEvmHookFailed event and, depending
+// on the RevertOnError option, revert the transaction or are ignored. Any other
+// panic is propagated.
+type IsolatedEvmHook struct {
+	k    *Keeper
+	hook types.EvmHooks
+	opts EvmHookOptions
+}
+
+// NewIsolatedEvmHook wraps the hook with the given default execution options
+func NewIsolatedEvmHook(k *Keeper, hook types.EvmHooks, opts EvmHookOptions) IsolatedEvmHook {
+	if opts.Name == "" {
+		opts.Name = fmt.Sprintf("%T", hook)
+	}
+
+	return IsolatedEvmHook{
+		k:    k,
+		hook: hook,
+		opts: opts,
+	}
+}
+
+// PreTxProcessing runs the PreTxProcessing function of the wrapped hook
+func (ih IsolatedEvmHook) PreTxProcessing(ctx sdk.Context, msg core.Message, sender common.Address) error {
+	return ih.run(ctx, types.AttributeValueHookStagePreTx, sender, func(ctx sdk.Context) error {
+		return ih.hook.PreTxProcessing(ctx, msg, sender)
+	})
+}
+
+// PostTxProcessing runs the PostTxProcessing function of the wrapped hook
+func (ih IsolatedEvmHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return ih.run(ctx, types.AttributeValueHookStagePostTx, msg.From(), func(ctx sdk.Context) error {
+		return ih.hook.PostTxProcessing(ctx, msg, receipt)
+	})
+}
+
+// run executes fn on a cached context limited to the hook gas limit, the
+// gas consumed by the hook is charged to the gas meter of the parent context.
+func (ih IsolatedEvmHook) run(ctx sdk.Context, stage string, sender common.Address, fn func(sdk.Context) error) error {
+	opts := ih.options(ctx)
+
+	var gasMeter sdk.GasMeter
+	if opts.GasLimit > 0 {
+		gasMeter = sdk.NewGasMeter(opts.GasLimit)
+	} else {
+		gasMeter = sdk.NewInfiniteGasMeter()
+	}
+
+	cacheCtx, commit := ctx.WithGasMeter(gasMeter).CacheContext()
+
+	err := ih.call(cacheCtx, gasMeter, fn)
+
+	ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), fmt.Sprintf("evm hook %s", opts.Name))
+
+	if err == nil {
+		// commit also emits the events of the hook on the parent context
+		commit()
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEvmHookFailed,
+			sdk.NewAttribute(types.AttributeKeyHook, opts.Name),
+			sdk.NewAttribute(types.AttributeKeyHookStage, stage),
+			sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(types.AttributeKeyHookError, err.Error()),
+			sdk.NewAttribute(types.AttributeKeyHookReverted, strconv.FormatBool(opts.RevertOnError)),
+		),
+	)
+
+	if opts.RevertOnError {
+		return errorsmod.Wrapf(err, "EVM hook %s failed", opts.Name)
+	}
+
+	ctx.Logger().Error(
+		"EVM hook failed, discarding its changes",
+		"hook", opts.Name,
+		"stage", stage,
+		"sender", sender.Hex(),
+		"error", err.Error(),
+	)
+
+	return nil
+}
+
+// options returns the execution options of the hook, overridden by the
+// EvmHooks params of its name if set.
+func (ih IsolatedEvmHook) options(ctx sdk.Context) EvmHookOptions {
+	opts := ih.opts
+	if hookParams, found := ih.k.GetParams(ctx).GetEvmHook(opts.Name); found {
+		opts.GasLimit = hookParams.GasLimit
+		opts.RevertOnError = hookParams.RevertOnError
+	}
+	return opts
+}
+
+// call executes fn and converts the out of gas panic raised by the gas meter of
+// the hook into an error. The other panics, e.g. an out of gas of the parent
+// context or a broken invariant, are not failures of the hook and are
+// propagated.
+func (ih IsolatedEvmHook) call(ctx sdk.Context, gasMeter sdk.GasMeter, fn func(sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok || !gasMeter.IsOutOfGas() {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(
+				types.ErrHookOutOfGas, "out of gas in location: %s; limit: %d",
+				oog.Descriptor, gasMeter.Limit(),
+			)
+		}
+	}()
+
+	return fn(ctx)
+}
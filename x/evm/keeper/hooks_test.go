@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -19,6 +20,10 @@ type LogRecordHook struct {
 	Logs []*ethtypes.Log
 }
 
+func (dh *LogRecordHook) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 func (dh *LogRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
 	dh.Logs = receipt.Logs
 	return nil
@@ -27,6 +32,10 @@ func (dh *LogRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, receipt
 // FailureHook always fail
 type FailureHook struct{}
 
+func (dh FailureHook) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 func (dh FailureHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
 	return errors.New("post tx processing failed")
 }
@@ -88,3 +97,196 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+// StateHook writes a storage slot and then runs the given function
+type StateHook struct {
+	fn func(ctx sdk.Context) error
+}
+
+func (sh StateHook) PreTxProcessing(ctx sdk.Context, _ core.Message, sender common.Address) error {
+	return sh.run(ctx, sender)
+}
+
+func (sh StateHook) PostTxProcessing(ctx sdk.Context, msg core.Message, _ *ethtypes.Receipt) error {
+	return sh.run(ctx, msg.From())
+}
+
+func (sh StateHook) run(ctx sdk.Context, sender common.Address) error {
+	ctx.EventManager().EmitEvent(sdk.NewEvent("state_hook"))
+	stateHookKeeper.SetState(ctx, sender, common.Hash{1}, []byte{1})
+	return sh.fn(ctx)
+}
+
+var stateHookKeeper *keeper.Keeper
+
+func (suite *KeeperTestSuite) TestIsolatedEvmHook() {
+	testCases := []struct {
+		msg       string
+		fn        func(ctx sdk.Context) error
+		opts      keeper.EvmHookOptions
+		params    []types.EvmHookParams
+		expPanic  bool
+		expErr    bool
+		expCommit bool
+		expFailed string
+	}{
+		{
+			"success",
+			func(sdk.Context) error { return nil },
+			keeper.EvmHookOptions{Name: "test", GasLimit: 100_000},
+			nil,
+			false,
+			false,
+			true,
+			"",
+		},
+		{
+			"fail, log and continue",
+			func(sdk.Context) error { return errors.New("hook failed") },
+			keeper.EvmHookOptions{Name: "test"},
+			nil,
+			false,
+			false,
+			false,
+			"hook failed",
+		},
+		{
+			"fail, revert the tx",
+			func(sdk.Context) error { return errors.New("hook failed") },
+			keeper.EvmHookOptions{Name: "test", RevertOnError: true},
+			nil,
+			false,
+			true,
+			false,
+			"hook failed",
+		},
+		{
+			"out of gas",
+			func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(100_000, "test")
+				return nil
+			},
+			keeper.EvmHookOptions{Name: "test", GasLimit: 100_000},
+			nil,
+			false,
+			false,
+			false,
+			types.ErrHookOutOfGas.Error(),
+		},
+		{
+			"out of gas of the parent context",
+			func(sdk.Context) error { panic(sdk.ErrorOutOfGas{Descriptor: "parent"}) },
+			keeper.EvmHookOptions{Name: "test", GasLimit: 100_000},
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"panic",
+			func(sdk.Context) error { panic("boom") },
+			keeper.EvmHookOptions{Name: "test"},
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"params override the revert policy",
+			func(sdk.Context) error { return errors.New("hook failed") },
+			keeper.EvmHookOptions{Name: "test"},
+			[]types.EvmHookParams{{Name: "test", RevertOnError: true}},
+			false,
+			true,
+			false,
+			"hook failed",
+		},
+		{
+			"params override the gas limit",
+			func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(100_000, "test")
+				return nil
+			},
+			keeper.EvmHookOptions{Name: "test"},
+			[]types.EvmHookParams{{Name: "test", GasLimit: 100_000}},
+			false,
+			false,
+			false,
+			types.ErrHookOutOfGas.Error(),
+		},
+		{
+			"params of another hook",
+			func(sdk.Context) error { return errors.New("hook failed") },
+			keeper.EvmHookOptions{Name: "test"},
+			[]types.EvmHookParams{{Name: "other", RevertOnError: true}},
+			false,
+			false,
+			false,
+			"hook failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, stage := range []string{types.AttributeValueHookStagePreTx, types.AttributeValueHookStagePostTx} {
+			suite.Run(tc.msg+" "+stage, func() {
+				suite.SetupTest()
+				stateHookKeeper = suite.app.EvmKeeper
+
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				params.EvmHooks = tc.params
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+				hook := keeper.NewIsolatedEvmHook(suite.app.EvmKeeper, StateHook{fn: tc.fn}, tc.opts)
+				ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+				msg := ethtypes.NewMessage(suite.address, nil, 0, nil, 0, nil, nil, nil, nil, nil, false)
+
+				run := func() error {
+					if stage == types.AttributeValueHookStagePreTx {
+						return hook.PreTxProcessing(ctx, msg, suite.address)
+					}
+					return hook.PostTxProcessing(ctx, msg, &ethtypes.Receipt{})
+				}
+
+				if tc.expPanic {
+					suite.Require().Panics(func() { _ = run() })
+					return
+				}
+
+				err := run()
+
+				if tc.expErr {
+					suite.Require().Error(err)
+				} else {
+					suite.Require().NoError(err)
+				}
+
+				value := suite.app.EvmKeeper.GetState(ctx, suite.address, common.Hash{1})
+				events := ctx.EventManager().Events()
+				if tc.expCommit {
+					suite.Require().Equal(common.BytesToHash([]byte{1}), value)
+					suite.Require().Len(events, 1)
+					suite.Require().Equal("state_hook", events[0].Type)
+					return
+				}
+
+				suite.Require().Equal(common.Hash{}, value)
+				suite.Require().Len(events, 1)
+
+				event := events[0]
+				suite.Require().Equal(types.EventTypeEvmHookFailed, event.Type)
+
+				attrs := make(map[string]string)
+				for _, attr := range event.Attributes {
+					attrs[string(attr.Key)] = string(attr.Value)
+				}
+				suite.Require().Equal("test", attrs[types.AttributeKeyHook])
+				suite.Require().Equal(stage, attrs[types.AttributeKeyHookStage])
+				suite.Require().Equal(suite.address.Hex(), attrs[types.AttributeKeySender])
+				suite.Require().Contains(attrs[types.AttributeKeyHookError], tc.expFailed)
+				suite.Require().Equal(strconv.FormatBool(tc.expErr), attrs[types.AttributeKeyHookReverted])
+			})
+		}
+	}
+}
@@ -69,8 +69,20 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+	// stateful precompiled contracts that can be activated through governance
+	precompiles map[common.Address]types.StatefulPrecompiledContract
+	// source of the precompiled contracts defined by the state of other modules
+	precompileProvider types.PrecompileProvider
+	// converts the ERC-20 tokens of the token pairs to pay the fees, nil if not set
+	erc20Keeper types.ERC20Keeper
 	// Legacy subspace
 	ss paramstypes.Subspace
+	// parallel execution of the Ethereum txs of a block
+	parallel *parallelExecution
+	// block-scoped cache of the storage slots and params, nil if disabled
+	stateCache *stateCache
+	// parsed contract ABIs of the event bridges
+	abiCache *abiCache
 }
 
 // NewKeeper generates new evm module keeper
@@ -107,6 +119,8 @@ func NewKeeper(
 		transientKey:    transientKey,
 		tracer:          tracer,
 		ss:              ss,
+		parallel:        &parallelExecution{mode: types.ParallelExecutionOff},
+		abiCache:        &abiCache{},
 	}
 }
 
@@ -255,6 +269,14 @@ func (k *Keeper) CleanHooks() *Keeper {
 	return k
 }
 
+// PreTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg core.Message, sender common.Address) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PreTxProcessing(ctx, msg, sender)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
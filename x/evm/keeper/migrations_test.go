@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
 	evmkeeper "github.com/evmos/evmos/v12/x/evm/keeper"
 	"github.com/evmos/evmos/v12/x/evm/types"
 )
@@ -39,3 +41,28 @@ func (suite *KeeperTestSuite) TestMigrations() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate5to6() {
+	legacySubspace := newMockSubspace(types.DefaultParams())
+	migrator := evmkeeper.NewMigrator(*suite.app.EvmKeeper, legacySubspace)
+
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	db := suite.StateDB()
+	db.SetCode(suite.address, code)
+	db.SetCode(utiltx.GenerateAddress(), code)
+	suite.Require().NoError(db.Commit())
+
+	// reset the counts and store an unreferenced code
+	suite.app.EvmKeeper.SetCodeRefCount(suite.ctx, codeHash, 0)
+	orphanCode := []byte("orphan")
+	orphanHash := crypto.Keccak256Hash(orphanCode)
+	suite.app.EvmKeeper.SetCode(suite.ctx, orphanHash.Bytes(), orphanCode)
+
+	suite.Require().NoError(migrator.Migrate5to6(suite.ctx))
+
+	suite.Require().Equal(uint64(2), suite.app.EvmKeeper.GetCodeRefCount(suite.ctx, codeHash))
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, codeHash))
+	suite.Require().Nil(suite.app.EvmKeeper.GetCode(suite.ctx, orphanHash))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v12/x/evm/types"
 )
@@ -140,6 +141,9 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		),
 	})
 
+	// emit the typed events of the bridged contract events
+	ctx.EventManager().EmitEvents(k.BridgedLogEvents(ctx, response.Hash, response.Logs))
+
 	return response, nil
 }
 
@@ -159,3 +163,48 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterContractABI implements the gRPC MsgServer interface. It registers the
+// ABI and metadata of a contract, replacing the previous registration. The
+// sender must be the deployer of the contract or the governance account, and
+// the ABI must define the bridged events of the contract.
+func (k *Keeper) RegisterContractABI(goCtx context.Context, req *types.MsgRegisterContractABI) (*types.MsgRegisterContractABIResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract := common.HexToAddress(req.Contract)
+
+	if req.Sender != k.authority.String() && req.DeploymentAddress() != contract {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDeployer, "sender %s didn't deploy contract %s", req.Sender, contract)
+	}
+
+	acct := k.GetAccountWithoutBalance(ctx, contract)
+	if acct == nil || !acct.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAccount, "%s is not a contract", contract)
+	}
+
+	// the new ABI must still define the bridged events of the contract
+	for _, bridge := range k.GetParams(ctx).EventBridges {
+		if common.HexToAddress(bridge.Contract) != contract {
+			continue
+		}
+		if _, err := k.abiEvent(bridge, req.ABI); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetContractABI(ctx, types.ContractABI{
+		Address:  contract.Hex(),
+		CodeHash: common.BytesToHash(acct.CodeHash).Hex(),
+		ABI:      req.ABI,
+		Metadata: req.Metadata,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterContractABI,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+		),
+	)
+
+	return &types.MsgRegisterContractABIResponse{}, nil
+}
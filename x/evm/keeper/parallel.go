@@ -0,0 +1,386 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"runtime"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// parallelExecution holds the settings of the parallel execution and the
+// speculative results of the Ethereum txs of the block being delivered. It is
+// shared by the copies of the Keeper.
+type parallelExecution struct {
+	mode    string
+	workers int
+
+	// source of the txs of the blocks, nil if not available
+	txDecoder sdk.TxDecoder
+	blockTxs  func(height int64) [][]byte
+
+	mtx   sync.Mutex
+	batch *parallelBatch
+}
+
+// parallelBatch is the set of Ethereum txs of a block that have been executed
+// speculatively, by hash.
+type parallelBatch struct {
+	height int64
+	txs    map[common.Hash]*speculativeTx
+}
+
+// speculativeTx is the result of the execution of an Ethereum tx on the state
+// at the beginning of the block, along with the keys read and written by the
+// execution of its message.
+type speculativeTx struct {
+	hash   common.Hash
+	access *accessSet
+	events sdk.Events
+	res    *types.MsgEthereumTxResponse
+	err    error
+}
+
+// enabled returns true if the Ethereum txs are executed in parallel.
+func (p *parallelExecution) enabled() bool {
+	return p.mode == types.ParallelExecutionOn || p.mode == types.ParallelExecutionValidate
+}
+
+func (p *parallelExecution) setBatch(batch *parallelBatch) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.batch = batch
+}
+
+// next returns the speculative result of the tx of the block being delivered,
+// which is removed from the batch.
+func (p *parallelExecution) next(height int64, hash common.Hash) *speculativeTx {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	batch := p.batch
+	if batch == nil || batch.height != height {
+		return nil
+	}
+
+	spec := batch.txs[hash]
+	delete(batch.txs, hash)
+	return spec
+}
+
+// WithParallelExecution sets the mode of the parallel execution of the Ethereum
+// txs of a block and the maximum number of txs executed concurrently, which
+// defaults to the number of CPUs.
+func (k *Keeper) WithParallelExecution(mode string, workers int) *Keeper {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	k.parallel.mode = mode
+	k.parallel.workers = workers
+	return k
+}
+
+// SetBlockTxs sets the source of the raw txs of the blocks being delivered,
+// which returns nil if the block at the given height isn't available. The txs
+// are executed in parallel only when it's set.
+func (k *Keeper) SetBlockTxs(txDecoder sdk.TxDecoder, blockTxs func(height int64) [][]byte) {
+	k.parallel.txDecoder = txDecoder
+	k.parallel.blockTxs = blockTxs
+}
+
+// PrepareParallelExecution executes speculatively in parallel the Ethereum txs
+// of the block being delivered, on the state at the beginning of the block. The
+// execution of a tx is preceded by the fee deduction and nonce increment of its
+// ante handler, so that its message is executed on the state it will most
+// likely be delivered on. ApplyTransaction uses the result of a tx when the
+// state read by its message is unchanged, and re-executes it otherwise, so
+// that the committed results are identical to the sequential execution.
+//
+// NOTE: The txs are always executed sequentially when the node is run with a
+// tracer.
+func (k *Keeper) PrepareParallelExecution(ctx sdk.Context) {
+	if k.parallel == nil {
+		return
+	}
+
+	k.parallel.setBatch(nil)
+
+	if !k.parallel.enabled() || k.parallel.blockTxs == nil || k.tracer != "" || ctx.IsCheckTx() {
+		return
+	}
+
+	msgs := k.blockEthereumTxs(ctx)
+	if len(msgs) < 2 {
+		return
+	}
+
+	// the state is only read by the speculative executions, which write on
+	// their own branches
+	base := newLockedMultiStore(ctx.MultiStore())
+	specs := make([]*speculativeTx, len(msgs))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < k.parallel.workers && w < len(msgs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				specs[i] = k.speculate(ctx, base, msgs[i])
+			}
+		}()
+	}
+
+	for i := range msgs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	batch := &parallelBatch{
+		height: ctx.BlockHeight(),
+		txs:    make(map[common.Hash]*speculativeTx, len(specs)),
+	}
+	for _, spec := range specs {
+		batch.txs[spec.hash] = spec
+	}
+
+	k.parallel.setBatch(batch)
+}
+
+// blockEthereumTxs returns the Ethereum txs of the block being delivered. The
+// Cosmos txs that can't be decoded or that contain other messages are ignored.
+func (k *Keeper) blockEthereumTxs(ctx sdk.Context) []*types.MsgEthereumTx {
+	var msgs []*types.MsgEthereumTx
+	for _, bz := range k.parallel.blockTxs(ctx.BlockHeight()) {
+		tx, err := k.parallel.txDecoder(bz)
+		if err != nil {
+			continue
+		}
+
+		txMsgs := make([]*types.MsgEthereumTx, 0, len(tx.GetMsgs()))
+		for _, msg := range tx.GetMsgs() {
+			if msgEthTx, ok := msg.(*types.MsgEthereumTx); ok {
+				txMsgs = append(txMsgs, msgEthTx)
+			}
+		}
+		if len(txMsgs) == len(tx.GetMsgs()) {
+			msgs = append(msgs, txMsgs...)
+		}
+	}
+	return msgs
+}
+
+// speculate executes the Ethereum tx on a branch of the given state, recording
+// the keys read and written by the execution of its message. A failure only
+// invalidates the speculative result, as the tx is then re-executed in order.
+func (k *Keeper) speculate(ctx sdk.Context, base storetypes.MultiStore, msgEthTx *types.MsgEthereumTx) (spec *speculativeTx) {
+	tx := msgEthTx.AsTransaction()
+	spec = &speculativeTx{
+		hash:   tx.Hash(),
+		access: newAccessSet(),
+	}
+
+	ctx = ctx.WithMultiStore(newTrackedMultiStore(base, newAccessSet())).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	defer func() {
+		if r := recover(); r != nil {
+			spec.res, spec.err = nil, fmt.Errorf("speculative execution panicked: %v", r)
+		}
+	}()
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		spec.err = err
+		return spec
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		spec.err = err
+		return spec
+	}
+
+	if err := k.deductSpeculativeTxCosts(ctx, cfg, msgEthTx, msg.From()); err != nil {
+		spec.err = err
+		return spec
+	}
+
+	// only the accesses and events of the message are recorded
+	ctx = ctx.WithMultiStore(newTrackedMultiStore(ctx.MultiStore(), spec.access)).
+		WithEventManager(sdk.NewEventManager())
+
+	// the tx and log indexes are set when the result is applied
+	txConfig := statedb.NewTxConfig(common.BytesToHash(ctx.HeaderHash()), spec.hash, 0, 0)
+
+	spec.res, spec.err = k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	spec.events = ctx.EventManager().Events()
+	return spec
+}
+
+// deductSpeculativeTxCosts deducts the fees of the tx from the balance of the
+// sender and increments its nonce, like the ante handler of the tx. The fees
+// paid in other ways (fee grants, fee denominations) are ignored, as the
+// speculative result is then invalidated by the balances changed by the ante
+// handler.
+func (k *Keeper) deductSpeculativeTxCosts(ctx sdk.Context, cfg *statedb.EVMConfig, msgEthTx *types.MsgEthereumTx, from common.Address) error {
+	txData, err := types.UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return err
+	}
+
+	height := big.NewInt(ctx.BlockHeight())
+	homestead := cfg.ChainConfig.IsHomestead(height)
+	istanbul := cfg.ChainConfig.IsIstanbul(height)
+
+	fees, err := VerifyFee(txData, cfg.Params.EvmDenom, cfg.BaseFee, homestead, istanbul, false)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DeductTxCostsFromUserBalance(ctx, fees, from); err != nil {
+		return err
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, from.Bytes())
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", from)
+	}
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+	return nil
+}
+
+// nextSpeculativeTx returns the speculative result of the tx when it's a tx of
+// the block being delivered.
+func (k *Keeper) nextSpeculativeTx(ctx sdk.Context, hash common.Hash) *speculativeTx {
+	if k.parallel == nil || ctx.IsCheckTx() {
+		return nil
+	}
+	return k.parallel.next(ctx.BlockHeight(), hash)
+}
+
+// applyMessage applies the message of a tx. The speculative result of a tx of
+// the block is used when it's still valid. Otherwise, the message is applied on
+// the current state.
+func (k *Keeper) applyMessage(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	spec *speculativeTx,
+) (*types.MsgEthereumTxResponse, error) {
+	if spec == nil {
+		return k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	}
+
+	if !spec.valid(ctx) {
+		telemetry.IncrCounter(1, types.ModuleName, "parallel", "reexecuted")
+		return k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	}
+
+	res := spec.result(txConfig)
+
+	if k.parallel.mode == types.ParallelExecutionValidate {
+		return k.validateSpeculativeResult(ctx, msg, cfg, txConfig, spec, res)
+	}
+
+	spec.access.applyWrites(ctx.MultiStore())
+	ctx.EventManager().EmitEvents(spec.events)
+
+	telemetry.IncrCounter(1, types.ModuleName, "parallel", "speculative")
+	return res, nil
+}
+
+// validateSpeculativeResult applies the message on the current state and
+// compares the result, writes and events with the speculative ones. The
+// sequential result is the one applied.
+func (k *Keeper) validateSpeculativeResult(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	spec *speculativeTx,
+	specRes *types.MsgEthereumTxResponse,
+) (*types.MsgEthereumTxResponse, error) {
+	store := newTrackedMultiStore(ctx.MultiStore(), newAccessSet())
+	seqCtx := ctx.WithMultiStore(store).WithEventManager(sdk.NewEventManager())
+
+	res, err := k.ApplyMessageWithConfig(seqCtx, msg, nil, true, cfg, txConfig)
+
+	if err != nil ||
+		!reflect.DeepEqual(specRes, res) ||
+		!spec.access.writesEqual(store.access) ||
+		!reflect.DeepEqual(spec.events, seqCtx.EventManager().Events()) {
+		telemetry.IncrCounter(1, types.ModuleName, "parallel", "mismatch")
+		k.Logger(ctx).Error(
+			"speculative execution differs from the sequential one",
+			"hash", spec.hash.Hex(), "error", err,
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	store.Write()
+	ctx.EventManager().EmitEvents(seqCtx.EventManager().Events())
+	return res, nil
+}
+
+// valid returns true if the speculative execution succeeded and the state read
+// by its message is unchanged.
+func (spec *speculativeTx) valid(ctx sdk.Context) bool {
+	return spec.err == nil && spec.access.unchanged(ctx.MultiStore())
+}
+
+// result returns the speculative response with the tx and log indexes of the
+// tx.
+func (spec *speculativeTx) result(txConfig statedb.TxConfig) *types.MsgEthereumTxResponse {
+	res := *spec.res
+	if spec.res.Logs == nil {
+		return &res
+	}
+
+	res.Logs = make([]*types.Log, len(spec.res.Logs))
+	for i, log := range spec.res.Logs {
+		l := *log
+		l.TxIndex = uint64(txConfig.TxIndex)
+		l.Index = uint64(txConfig.LogIndex) + uint64(i)
+		res.Logs[i] = &l
+	}
+	return &res
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/evmos/v12/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v12/testutil"
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// blockResult is the result of the delivery of a block.
+type blockResult struct {
+	responses   []abci.ResponseDeliverTx
+	state       map[string]string
+	deliverTime time.Duration
+}
+
+// blockTestKey returns a deterministic key, so that the same txs can be
+// delivered on top of different setups.
+func blockTestKey(i byte) *ethsecp256k1.PrivKey {
+	return &ethsecp256k1.PrivKey{Key: crypto.Keccak256([]byte{i})}
+}
+
+// blockTestAddress returns the address of a key.
+func blockTestAddress(key cryptotypes.PrivKey) common.Address {
+	return common.BytesToAddress(key.PubKey().Address())
+}
+
+// setupBlockTest sets up a new chain where each key holds a balance and the
+// tokens of its own ERC20 contract, and returns the contract addresses.
+func (suite *KeeperTestSuite) setupBlockTest(t require.TestingT, keys []*ethsecp256k1.PrivKey) []common.Address {
+	suite.SetupTestWithT(t)
+
+	contracts := make([]common.Address, len(keys))
+	vmdb := suite.StateDB()
+	for i, key := range keys {
+		contracts[i] = suite.DeployTestContract(t, blockTestAddress(key), big.NewInt(1e18))
+		vmdb.AddBalance(blockTestAddress(key), big.NewInt(1e18))
+	}
+	require.NoError(t, vmdb.Commit())
+	return contracts
+}
+
+// newBlockTx returns the encoded Cosmos tx of a signed Ethereum tx.
+func (suite *KeeperTestSuite) newBlockTx(t require.TestingT, key cryptotypes.PrivKey, nonce uint64, to common.Address, value int64, data []byte) []byte {
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		Nonce:    nonce,
+		To:       &to,
+		Amount:   big.NewInt(value),
+		GasLimit: 100_000,
+		GasPrice: big.NewInt(1e9),
+		Input:    data,
+	})
+	msg.From = blockTestAddress(key).Hex()
+
+	tx, err := utiltx.PrepareEthTx(suite.clientCtx.TxConfig, suite.app, key, msg)
+	require.NoError(t, err)
+	bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+// deliverBlock commits the current block and delivers the txs in the next one,
+// with the given mode of parallel execution. It returns the responses of the
+// txs, along with the EVM state and the balances and nonces of the addresses,
+// and the time spent delivering the txs.
+func (suite *KeeperTestSuite) deliverBlock(t require.TestingT, mode string, txs [][]byte, addrs []common.Address) blockResult {
+	k := suite.app.EvmKeeper.WithParallelExecution(mode, 4)
+	k.SetBlockTxs(suite.clientCtx.TxConfig.TxDecoder(), func(int64) [][]byte { return txs })
+	defer func() {
+		k.WithParallelExecution(evmtypes.ParallelExecutionOff, 0)
+		k.SetBlockTxs(nil, nil)
+	}()
+
+	// BeginBlock of the next block prepares the speculative execution
+	var err error
+	suite.ctx, err = testutil.Commit(suite.ctx, suite.app, 0, nil)
+	require.NoError(t, err)
+
+	result := blockResult{state: make(map[string]string)}
+	start := time.Now()
+	for _, tx := range txs {
+		res := suite.app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+		result.responses = append(result.responses, res)
+	}
+	result.deliverTime = time.Since(start)
+
+	it := suite.ctx.KVStore(suite.app.GetKey(evmtypes.StoreKey)).Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		result.state[string(it.Key())] = string(it.Value())
+	}
+	require.NoError(t, it.Close())
+
+	for _, addr := range addrs {
+		result.state["balance/"+addr.Hex()] = k.GetBalance(suite.ctx, addr).String()
+		result.state["nonce/"+addr.Hex()] = strconv.FormatUint(k.GetNonce(suite.ctx, addr), 10)
+	}
+	return result
+}
+
+func (suite *KeeperTestSuite) TestParallelExecution() {
+	keys := []*ethsecp256k1.PrivKey{blockTestKey(0), blockTestKey(1), blockTestKey(2), blockTestKey(3)}
+	addrs := make([]common.Address, len(keys))
+	for i, key := range keys {
+		addrs[i] = blockTestAddress(key)
+	}
+
+	transferData := func(to common.Address, amount int64) []byte {
+		data, err := evmtypes.ERC20Contract.ABI.Pack("transfer", to, big.NewInt(amount))
+		suite.Require().NoError(err)
+		return data
+	}
+
+	blockTxs := func(contracts []common.Address) [][]byte {
+		return [][]byte{
+			// independent value transfers
+			suite.newBlockTx(suite.T(), keys[0], 0, addrs[2], 1000, nil),
+			suite.newBlockTx(suite.T(), keys[1], 0, addrs[3], 1000, nil),
+			// token transfer independent of the value transfers
+			suite.newBlockTx(suite.T(), keys[2], 0, contracts[2], 0, transferData(addrs[0], 100)),
+			// token transfer reading the balance and nonce written by the previous one
+			suite.newBlockTx(suite.T(), keys[2], 1, contracts[2], 0, transferData(addrs[1], 200)),
+			// value transfer from the recipient of the first tx
+			suite.newBlockTx(suite.T(), keys[3], 0, addrs[0], 500, nil),
+			// token transfer of the tokens received in the third tx
+			suite.newBlockTx(suite.T(), keys[0], 1, contracts[2], 0, transferData(addrs[3], 50)),
+		}
+	}
+
+	contracts := suite.setupBlockTest(suite.T(), keys)
+	txs := blockTxs(contracts)
+	expResult := suite.deliverBlock(suite.T(), evmtypes.ParallelExecutionOff, txs, addrs)
+
+	testCases := []struct {
+		name string
+		mode string
+	}{
+		{"parallel", evmtypes.ParallelExecutionOn},
+		{"validate", evmtypes.ParallelExecutionValidate},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(contracts, suite.setupBlockTest(suite.T(), keys))
+			result := suite.deliverBlock(suite.T(), tc.mode, txs, addrs)
+			suite.Require().Equal(expResult.responses, result.responses)
+			suite.Require().Equal(expResult.state, result.state)
+		})
+	}
+}
+
+// hashLoopCode is the runtime code of a contract that hashes a word 768 times.
+var hashLoopCode = common.FromHex("610300" + "5b" + "602060002060005260019003" + "8060035700")
+
+func benchmarkParallelExecution(b *testing.B, mode string) {
+	suite := KeeperTestSuite{}
+
+	keys := make([]*ethsecp256k1.PrivKey, 32)
+	for i := range keys {
+		keys[i] = blockTestKey(byte(i))
+	}
+	contract := common.BigToAddress(big.NewInt(0xbeef))
+
+	var deliverTime time.Duration
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		// the block is delivered on a new chain, as the invariants of the test
+		// setup are broken after a few blocks
+		b.StopTimer()
+		suite.setupBlockTest(b, keys)
+		vmdb := suite.StateDB()
+		vmdb.SetCode(contract, hashLoopCode)
+		require.NoError(b, vmdb.Commit())
+
+		txs := make([][]byte, len(keys))
+		for i, key := range keys {
+			txs[i] = suite.newBlockTx(b, key, 0, contract, 0, nil)
+		}
+		b.StartTimer()
+
+		deliverTime += suite.deliverBlock(b, mode, txs, nil).deliverTime
+	}
+
+	// the txs are delivered sequentially, after their speculative execution in
+	// BeginBlock, which is spread across the CPUs
+	b.ReportMetric(float64(deliverTime.Nanoseconds())/float64(b.N), "deliver-ns/op")
+}
+
+func BenchmarkSequentialExecution(b *testing.B) {
+	benchmarkParallelExecution(b, evmtypes.ParallelExecutionOff)
+}
+
+func BenchmarkParallelExecution(b *testing.B) {
+	benchmarkParallelExecution(b, evmtypes.ParallelExecutionOn)
+}
@@ -22,8 +22,7 @@ import (
 
 // GetParams returns the total set of evm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyPrefixParams)
+	bz := k.stateCache.read(ctx, k.storeKey, types.KeyPrefixParams)
 	if len(bz) == 0 {
 		return k.GetLegacyParams(ctx)
 	}
@@ -37,12 +36,21 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	if err := k.ValidatePrecompilesRegistered(params.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	if err := k.ValidateEventBridges(ctx, params.EventBridges); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
 		return err
 	}
 
+	k.stateCache.invalidate(ctx, types.KeyPrefixParams)
 	store.Set(types.KeyPrefixParams, bz)
 	return nil
 }
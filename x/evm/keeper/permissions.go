@@ -0,0 +1,118 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// CheckTxPermissions checks that the sender of a transaction is allowed to send
+// it by the Permissions parameter:
+//   - the sender must not be denied
+//   - the sender must be an allowed deployer of the init code for contract creations
+//   - the sender must be allowed to call the recipient if it's a contract or a
+//     precompiled contract
+//
+// The contracts deployed by other contracts are checked during the execution,
+// see createPolicyHooks. The messages of the module accounts (eg: the
+// contract deployments of x/erc20) are not restricted.
+func (k Keeper) CheckTxPermissions(
+	ctx sdk.Context,
+	params types.Params,
+	from common.Address,
+	to *common.Address,
+	data []byte,
+) error {
+	permissions := params.Permissions
+	if k.isModuleAccount(ctx, from) {
+		return nil
+	}
+
+	if permissions.IsDeniedSender(from) {
+		return errorsmod.Wrapf(types.ErrSenderDenied, "sender %s", from)
+	}
+
+	if to == nil {
+		if !permissions.Create.CanCreate(from, crypto.Keccak256Hash(data)) {
+			return errorsmod.Wrapf(types.ErrCreateNotAllowed, "deployer %s", from)
+		}
+		return nil
+	}
+
+	if !permissions.Call.Permissioned || permissions.Call.CanCall(from, *to) {
+		return nil
+	}
+
+	if k.isContract(ctx, params, *to) {
+		return errorsmod.Wrapf(types.ErrCallNotAllowed, "sender %s, contract %s", from, to)
+	}
+	return nil
+}
+
+// isContract returns true if the given address is a contract or an active
+// precompiled contract.
+func (k Keeper) isContract(ctx sdk.Context, params types.Params, address common.Address) bool {
+	if acc := k.GetAccountWithoutBalance(ctx, address); acc != nil && acc.IsContract() {
+		return true
+	}
+	_, found := k.GetActivePrecompile(ctx, params, address)
+	return found
+}
+
+// isModuleAccount returns true if the given address is a module account.
+func (k Keeper) isModuleAccount(ctx sdk.Context, address common.Address) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, address.Bytes()).(authtypes.ModuleAccountI)
+	return ok
+}
+
+var _ vm.OpCodeHooks = createPolicyHooks{}
+
+// createPolicyHooks checks the contracts deployed by other contracts through
+// CREATE and CREATE2 against the create policy. The EVM calls the CreateHook
+// before every contract deployment, including the ones of the precompiled
+// contracts, and the deployments that aren't allowed fail.
+type createPolicyHooks struct {
+	vm.NoopOpCodeHooks
+	policy types.CreatePolicy
+}
+
+// CreateHook implements vm.OpCodeHooks. The contract creation of the
+// transaction, whose caller is the transaction origin, is checked along with
+// its init code by CheckTxPermissions.
+func (h createPolicyHooks) CreateHook(evm *vm.EVM, caller common.Address) error {
+	if caller == evm.Origin || h.policy.CanCreateNested(caller) {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrCreateNotAllowed, "deployer %s", caller)
+}
+
+// opCodeHooks returns the EVM hooks that enforce the permissions of the given
+// parameters while executing the given message. The contract deployments of
+// the module accounts are not restricted.
+func (k Keeper) opCodeHooks(ctx sdk.Context, params types.Params, msg core.Message) vm.OpCodeHooks {
+	if !params.Permissions.Create.Permissioned || k.isModuleAccount(ctx, msg.From()) {
+		return vm.NewDefaultOpCodeHooks()
+	}
+	return createPolicyHooks{policy: params.Permissions.Create}
+}
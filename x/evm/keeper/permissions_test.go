@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v12/testutil"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+var (
+	// factoryRuntimeCode deploys an empty contract through CREATE on every call:
+	// PUSH1 0 PUSH1 0 PUSH1 0 CREATE STOP
+	factoryRuntimeCode = common.FromHex("0x600060006000f000")
+	// factoryInitCode returns the factoryRuntimeCode:
+	// PUSH1 8 PUSH1 12 PUSH1 0 CODECOPY PUSH1 8 PUSH1 0 RETURN
+	factoryInitCode = append(common.FromHex("0x6008600c60003960086000f3"), factoryRuntimeCode...)
+)
+
+// applyPermissionsMessage applies a message from the suite address with the
+// given permissions.
+func (suite *KeeperTestSuite) applyPermissionsMessage(
+	permissions types.Permissions,
+	to *common.Address,
+	data []byte,
+) (*types.MsgEthereumTxResponse, error) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.Permissions = permissions
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, suite.app.EvmKeeper.ChainID())
+	suite.Require().NoError(err)
+	txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(suite.address, to, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), nil, nil, data, nil, true)
+	return suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+}
+
+func (suite *KeeperTestSuite) TestPermissions() {
+	other := common.BigToAddress(big.NewInt(0xabcd))
+	factoryAddr := crypto.CreateAddress(suite.address, 0)
+
+	deployFactory := func() {
+		res, err := suite.applyPermissionsMessage(types.Permissions{}, nil, factoryInitCode)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		suite.Require().Equal(factoryAddr, crypto.CreateAddress(suite.address, 0))
+	}
+
+	allowDeployer := func(address common.Address, codeHashes ...string) types.CreatePolicy {
+		return types.CreatePolicy{
+			Permissioned:     true,
+			AllowedDeployers: []types.AllowedDeployer{{Address: address.Hex(), CodeHashes: codeHashes}},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() (types.Permissions, *common.Address, []byte)
+		expErr      error
+		expNested   bool
+		expContract *common.Address
+	}{
+		{
+			"permissionless",
+			func() (types.Permissions, *common.Address, []byte) {
+				return types.Permissions{}, nil, factoryInitCode
+			},
+			nil, false, &factoryAddr,
+		},
+		{
+			"denied sender",
+			func() (types.Permissions, *common.Address, []byte) {
+				return types.Permissions{DeniedSenders: []string{suite.address.Hex()}}, &other, nil
+			},
+			types.ErrSenderDenied, false, nil,
+		},
+		{
+			"deployer not allowed",
+			func() (types.Permissions, *common.Address, []byte) {
+				return types.Permissions{Create: allowDeployer(other)}, nil, factoryInitCode
+			},
+			types.ErrCreateNotAllowed, false, nil,
+		},
+		{
+			"deployer allowed",
+			func() (types.Permissions, *common.Address, []byte) {
+				return types.Permissions{Create: allowDeployer(suite.address)}, nil, factoryInitCode
+			},
+			nil, false, &factoryAddr,
+		},
+		{
+			"deployer allowed for another code hash",
+			func() (types.Permissions, *common.Address, []byte) {
+				create := allowDeployer(suite.address, common.Hash{1}.Hex())
+				return types.Permissions{Create: create}, nil, factoryInitCode
+			},
+			types.ErrCreateNotAllowed, false, nil,
+		},
+		{
+			"deployer allowed for the code hash",
+			func() (types.Permissions, *common.Address, []byte) {
+				create := allowDeployer(suite.address, crypto.Keccak256Hash(factoryInitCode).Hex())
+				return types.Permissions{Create: create}, nil, factoryInitCode
+			},
+			nil, false, &factoryAddr,
+		},
+		{
+			"nested deployment not allowed",
+			func() (types.Permissions, *common.Address, []byte) {
+				deployFactory()
+				return types.Permissions{Create: allowDeployer(suite.address)}, &factoryAddr, nil
+			},
+			nil, false, nil,
+		},
+		{
+			"nested deployment allowed for a code hash",
+			func() (types.Permissions, *common.Address, []byte) {
+				deployFactory()
+				create := allowDeployer(factoryAddr, crypto.Keccak256Hash(nil).Hex())
+				return types.Permissions{Create: create}, &factoryAddr, nil
+			},
+			nil, false, nil,
+		},
+		{
+			"nested deployment allowed",
+			func() (types.Permissions, *common.Address, []byte) {
+				deployFactory()
+				return types.Permissions{Create: allowDeployer(factoryAddr)}, &factoryAddr, nil
+			},
+			nil, true, nil,
+		},
+		{
+			"contract call not allowed",
+			func() (types.Permissions, *common.Address, []byte) {
+				deployFactory()
+				return types.Permissions{Call: types.CallPolicy{Permissioned: true}}, &factoryAddr, nil
+			},
+			types.ErrCallNotAllowed, false, nil,
+		},
+		{
+			"value transfer with a permissioned call policy",
+			func() (types.Permissions, *common.Address, []byte) {
+				return types.Permissions{Call: types.CallPolicy{Permissioned: true}}, &other, nil
+			},
+			nil, false, nil,
+		},
+		{
+			"public contract call",
+			func() (types.Permissions, *common.Address, []byte) {
+				deployFactory()
+				call := types.CallPolicy{Permissioned: true, PublicContracts: []string{factoryAddr.Hex()}}
+				return types.Permissions{Call: call}, &factoryAddr, nil
+			},
+			nil, true, nil,
+		},
+		{
+			"allowed sender contract call",
+			func() (types.Permissions, *common.Address, []byte) {
+				deployFactory()
+				call := types.CallPolicy{Permissioned: true, AllowedSenders: []string{suite.address.Hex()}}
+				return types.Permissions{Call: call}, &factoryAddr, nil
+			},
+			nil, true, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(suite.EvmDenom(), 1e18)))
+			suite.Require().NoError(err)
+
+			permissions, to, data := tc.malleate()
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+			res, err := suite.applyPermissionsMessage(permissions, to, data)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().False(res.Failed(), res.VmError)
+
+			if to != nil && *to == factoryAddr {
+				// the deployments of the factory that aren't allowed fail
+				nestedAddr := crypto.CreateAddress(factoryAddr, 1)
+				acc := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, nestedAddr)
+				suite.Require().Equal(tc.expNested, acc != nil)
+			}
+
+			if tc.expContract != nil {
+				acc := suite.app.EvmKeeper.GetAccountWithoutBalance(suite.ctx, *tc.expContract)
+				suite.Require().NotNil(acc)
+				suite.Require().True(acc.IsContract())
+				suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			}
+		})
+	}
+}
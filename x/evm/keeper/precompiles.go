@@ -0,0 +1,177 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// WithPrecompiles sets the stateful precompiled contracts that can be activated
+// through the ActivePrecompiles parameter.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) WithPrecompiles(precompiles ...types.StatefulPrecompiledContract) *Keeper {
+	if k.precompiles != nil {
+		panic("cannot set evm precompiles twice")
+	}
+
+	k.precompiles = make(map[common.Address]types.StatefulPrecompiledContract, len(precompiles))
+	for _, precompile := range precompiles {
+		address := precompile.Address()
+		if _, found := k.precompiles[address]; found {
+			panic(fmt.Sprintf("duplicated evm precompile %s", address))
+		}
+		k.precompiles[address] = precompile
+	}
+	return k
+}
+
+// CleanPrecompiles resets the stateful precompiled contracts of the EVM module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanPrecompiles() *Keeper {
+	k.precompiles = nil
+	return k
+}
+
+// WithPrecompileProvider sets the provider of the stateful precompiled
+// contracts defined by the state of other modules.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) WithPrecompileProvider(provider types.PrecompileProvider) *Keeper {
+	if k.precompileProvider != nil {
+		panic("cannot set evm precompile provider twice")
+	}
+
+	k.precompileProvider = provider
+	return k
+}
+
+// GetActivePrecompile returns the stateful precompiled contract at the given
+// address if it's registered and activated by the given parameters or, if not,
+// the one defined at the address by the precompile provider.
+func (k Keeper) GetActivePrecompile(
+	ctx sdk.Context,
+	params types.Params,
+	address common.Address,
+) (types.StatefulPrecompiledContract, bool) {
+	if params.IsActivePrecompile(address) {
+		precompile, found := k.precompiles[address]
+		return precompile, found
+	}
+
+	if k.precompileProvider == nil {
+		return nil, false
+	}
+	return k.precompileProvider.GetPrecompile(ctx, address)
+}
+
+// ValidatePrecompilesRegistered returns an error if any of the given active
+// precompiles is not registered on the keeper.
+func (k Keeper) ValidatePrecompilesRegistered(activePrecompiles []string) error {
+	for _, hexAddr := range activePrecompiles {
+		if _, found := k.precompiles[common.HexToAddress(hexAddr)]; !found {
+			return errorsmod.Wrapf(types.ErrPrecompileNotRegistered, "address %s", hexAddr)
+		}
+	}
+	return nil
+}
+
+// evmPrecompiles returns the precompiled contracts of the EVM for the given
+// rules, along with their addresses: the native precompiled contracts and the
+// stateful precompiled contracts activated by the given parameters. The ones
+// defined by the precompile provider are added when called, see
+// providedPrecompileHooks. The stateful precompiled contracts are run by the
+// EVM interpreter, so they can be called by other contracts as well.
+func (k Keeper) evmPrecompiles(
+	evmParams types.Params,
+	rules params.Rules,
+) (map[common.Address]vm.PrecompiledContract, []common.Address) {
+	native := vm.DefaultPrecompiles(rules)
+	nativeAddresses := vm.DefaultActivePrecompiles(rules)
+
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(native)+len(evmParams.ActivePrecompiles))
+	addresses := make([]common.Address, 0, len(native)+len(evmParams.ActivePrecompiles))
+	for _, address := range nativeAddresses {
+		precompiles[address] = native[address]
+		addresses = append(addresses, address)
+	}
+
+	for _, hexAddr := range evmParams.ActivePrecompiles {
+		address := common.HexToAddress(hexAddr)
+		if _, found := precompiles[address]; found {
+			continue
+		}
+		if precompile, found := k.precompiles[address]; found {
+			precompiles[address] = precompile
+			addresses = append(addresses, address)
+		}
+	}
+
+	return precompiles, addresses
+}
+
+var _ vm.OpCodeHooks = providedPrecompileHooks{}
+
+// providedPrecompileHooks adds the precompiled contract defined by the
+// precompile provider at the called address, if any, to the precompiles of the
+// EVM before each call. So only the called addresses are looked up, instead of
+// every precompiled contract of the provider on each EVM instance.
+type providedPrecompileHooks struct {
+	vm.OpCodeHooks
+	ctx         sdk.Context
+	provider    types.PrecompileProvider
+	precompiles map[common.Address]vm.PrecompiledContract
+}
+
+// CallHook implements vm.OpCodeHooks.
+func (h providedPrecompileHooks) CallHook(evm *vm.EVM, caller common.Address, recipient common.Address) error {
+	if err := h.OpCodeHooks.CallHook(evm, caller, recipient); err != nil {
+		return err
+	}
+
+	if _, found := h.precompiles[recipient]; found {
+		return nil
+	}
+	if precompile, found := h.provider.GetPrecompile(h.ctx, recipient); found {
+		h.precompiles[recipient] = precompile
+	}
+	return nil
+}
+
+// precompileHooks wraps the given EVM hooks to resolve the precompiled
+// contracts of the precompile provider, if any, into the given precompiles of
+// the EVM.
+func (k Keeper) precompileHooks(
+	ctx sdk.Context,
+	hooks vm.OpCodeHooks,
+	precompiles map[common.Address]vm.PrecompiledContract,
+) vm.OpCodeHooks {
+	if k.precompileProvider == nil {
+		return hooks
+	}
+	return providedPrecompileHooks{
+		OpCodeHooks: hooks,
+		ctx:         ctx,
+		provider:    k.precompileProvider,
+		precompiles: precompiles,
+	}
+}
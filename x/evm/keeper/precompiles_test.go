@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v12/testutil"
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+var bankSendPrecompileAddress = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+// bankSendPrecompile sends 100 units of the EVM denom from the caller to the
+// recipient encoded in the first 20 bytes of the input, and reverts afterwards
+// if the 21st byte is set.
+type bankSendPrecompile struct {
+	bankKeeper types.BankKeeper
+	denom      string
+}
+
+func (bankSendPrecompile) Address() common.Address {
+	return bankSendPrecompileAddress
+}
+
+func (bankSendPrecompile) RequiredGas(_ []byte) uint64 {
+	return 1000
+}
+
+func (p bankSendPrecompile) Run(evm *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	ctx, err := evm.StateDB.(statedb.ExtStateDB).BranchContext()
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := common.BytesToAddress(contract.Input[:20])
+	coins := sdk.NewCoins(sdk.NewInt64Coin(p.denom, 100))
+	if err := p.bankKeeper.SendCoins(ctx, contract.Caller().Bytes(), recipient.Bytes(), coins); err != nil {
+		return nil, err
+	}
+
+	if contract.Input[20] == 1 {
+		return nil, vm.ErrExecutionReverted
+	}
+	return recipient.Bytes(), nil
+}
+
+func (suite *KeeperTestSuite) TestCallPrecompile() {
+	recipient := common.BigToAddress(big.NewInt(0xabcd))
+
+	testCases := []struct {
+		name   string
+		active bool
+		revert bool
+	}{
+		{"precompile not active", false, false},
+		{"precompile committed", true, false},
+		{"precompile reverted", true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			denom := suite.EvmDenom()
+			suite.app.EvmKeeper.CleanPrecompiles().WithPrecompiles(bankSendPrecompile{bankKeeper: suite.app.BankKeeper, denom: denom})
+
+			if tc.active {
+				evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+				evmParams.ActivePrecompiles = []string{bankSendPrecompileAddress.Hex()}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+			}
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+			suite.Require().NoError(err)
+			senderBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), denom).Amount
+
+			input := append(recipient.Bytes(), 0)
+			if tc.revert {
+				input[20] = 1
+			}
+			to := bankSendPrecompileAddress
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &to, nonce, big.NewInt(50), 30000, big.NewInt(0), nil, nil, input, ethtypes.AccessList{}, true)
+
+			intrinsicGas, err := core.IntrinsicGas(input, nil, false, true, true)
+			suite.Require().NoError(err)
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+
+			precompileBalance := suite.app.BankKeeper.GetBalance(suite.ctx, bankSendPrecompileAddress.Bytes(), denom).Amount
+			recipientBalance := suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), denom).Amount
+			newSenderBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), denom).Amount
+
+			switch {
+			case !tc.active:
+				// plain value transfer to an account without code
+				suite.Require().False(res.Failed())
+				suite.Require().Equal(intrinsicGas, res.GasUsed)
+				suite.Require().Equal(int64(50), precompileBalance.Int64())
+				suite.Require().True(recipientBalance.IsZero())
+			case tc.revert:
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				suite.Require().Equal(intrinsicGas+1000, res.GasUsed)
+				suite.Require().True(precompileBalance.IsZero())
+				suite.Require().True(recipientBalance.IsZero())
+				suite.Require().Equal(senderBalance, newSenderBalance)
+			default:
+				suite.Require().False(res.Failed())
+				suite.Require().Equal(recipient.Bytes(), res.Ret)
+				suite.Require().Equal(intrinsicGas+1000, res.GasUsed)
+				suite.Require().Equal(int64(50), precompileBalance.Int64())
+				suite.Require().Equal(int64(100), recipientBalance.Int64())
+				suite.Require().Equal(senderBalance.SubRaw(150), newSenderBalance)
+			}
+		})
+	}
+}
+
+// bankSendCallerCode is the runtime code of a contract that calls the bank send
+// precompile with its own call input, returns the first 32 bytes of the output
+// and reverts if the call fails.
+var bankSendCallerCode = common.FromHex(
+	"366000600037" + // CALLDATACOPY(0, 0, CALLDATASIZE)
+		"6020600036600060006109005af1" + // CALL(GAS, 0x0900, 0, 0, CALLDATASIZE, 0, 32)
+		"15601d57" + // JUMPI(29, ISZERO(success))
+		"60206000f3" + // RETURN(0, 32)
+		"5b60006000fd", // JUMPDEST REVERT(0, 0)
+)
+
+func (suite *KeeperTestSuite) TestCallPrecompileFromContract() {
+	recipient := common.BigToAddress(big.NewInt(0xabcd))
+	caller := common.BigToAddress(big.NewInt(0xc0de))
+
+	testCases := []struct {
+		name   string
+		revert bool
+	}{
+		{"precompile committed", false},
+		{"precompile reverted", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			denom := suite.EvmDenom()
+			suite.app.EvmKeeper.CleanPrecompiles().WithPrecompiles(bankSendPrecompile{bankKeeper: suite.app.BankKeeper, denom: denom})
+
+			evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			evmParams.ActivePrecompiles = []string{bankSendPrecompileAddress.Hex()}
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+
+			stateDB := suite.StateDB()
+			stateDB.SetCode(caller, bankSendCallerCode)
+			suite.Require().NoError(stateDB.Commit())
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, caller.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+			suite.Require().NoError(err)
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, suite.app.EvmKeeper.ChainID())
+			suite.Require().NoError(err)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			input := append(recipient.Bytes(), 0)
+			if tc.revert {
+				input[20] = 1
+			}
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &caller, nonce, big.NewInt(0), 100000, big.NewInt(0), nil, nil, input, ethtypes.AccessList{}, true)
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+
+			callerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, caller.Bytes(), denom).Amount
+			recipientBalance := suite.app.BankKeeper.GetBalance(suite.ctx, recipient.Bytes(), denom).Amount
+
+			if tc.revert {
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+				suite.Require().Equal(int64(1000), callerBalance.Int64())
+				suite.Require().True(recipientBalance.IsZero())
+				return
+			}
+
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(recipient.Bytes(), res.Ret[:20])
+			suite.Require().Equal(int64(900), callerBalance.Int64())
+			suite.Require().Equal(int64(100), recipientBalance.Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetParamsUnregisteredPrecompile() {
+	suite.SetupTest()
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.ActivePrecompiles = []string{bankSendPrecompileAddress.Hex()}
+	err := suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+	suite.Require().ErrorIs(err, types.ErrPrecompileNotRegistered)
+}
+
+func (suite *KeeperTestSuite) TestBranchContext() {
+	suite.SetupTest()
+	key := common.BigToHash(big.NewInt(1))
+	original := common.BigToHash(big.NewInt(2))
+	changed := common.BigToHash(big.NewInt(3))
+
+	vmdb := suite.StateDB()
+	vmdb.SetState(suite.address, key, original)
+	suite.Require().NoError(vmdb.Commit())
+
+	vmdb = suite.StateDB()
+	vmdb.SetState(suite.address, key, changed)
+	_, err := vmdb.BranchContext()
+	suite.Require().NoError(err)
+
+	// the live state is reloaded from the branch, but the committed state is
+	// still the state at the start of the transaction
+	suite.Require().Equal(changed, vmdb.GetState(suite.address, key))
+	suite.Require().Equal(original, vmdb.GetCommittedState(suite.address, key))
+
+	// restoring the original value after the branch is not a noop change
+	vmdb.SetState(suite.address, key, original)
+	suite.Require().NoError(vmdb.Commit())
+	suite.Require().Equal(original, suite.app.EvmKeeper.GetState(suite.ctx, suite.address, key))
+
+	vmdb = suite.StateDB()
+	for i := 0; i < statedb.MaxCacheBranches; i++ {
+		_, err := vmdb.BranchContext()
+		suite.Require().NoError(err)
+	}
+	_, err = vmdb.BranchContext()
+	suite.Require().ErrorIs(err, statedb.ErrMaxCacheBranches)
+}
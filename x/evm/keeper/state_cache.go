@@ -0,0 +1,170 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"sync"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultStateCacheSize is the default maximum number of entries held by the
+// state cache.
+const DefaultStateCacheSize = 1 << 14
+
+// stateCache is a block-scoped cache of the raw contract storage slots and
+// params read from the EVM store.
+//
+// The cached values are the ones of the state committed by the previous block:
+// once a key is written through the keeper during the block, it's marked as
+// dirty and isn't cached anymore until the next block, whether the write is
+// committed or reverted by a cache context. The EVM keeper is the only writer
+// of the storage slots and params, so the store doesn't need to be read to
+// validate a cached value. The gas of the skipped store reads is consumed as if
+// they were performed, so that the gas consumption is identical with and
+// without the cache.
+//
+// The cache follows the height of the block being executed. Once the block is
+// committed, the queries at its height keep using the cache until the next
+// block begins, as the cached values are still the ones of the committed state:
+// the keys written during the block aren't cached. The queries at lower heights
+// and the CheckTx state, whose writes aren't committed, don't use it. The reads
+// of the speculative executions aren't cached either, as they must be recorded
+// by their tracked multistore.
+type stateCache struct {
+	size int
+
+	mtx     sync.Mutex
+	height  int64
+	entries map[string][]byte
+	dirty   map[string]struct{}
+}
+
+// WithStateCache enables the block-scoped cache of the contract storage slots
+// and params read from the EVM store, holding at most the given number of
+// entries. A size lower or equal to zero disables the cache.
+func (k *Keeper) WithStateCache(size int) *Keeper {
+	if size <= 0 {
+		k.stateCache = nil
+		return k
+	}
+
+	k.stateCache = &stateCache{
+		size:    size,
+		entries: make(map[string][]byte),
+		dirty:   make(map[string]struct{}),
+	}
+	return k
+}
+
+// enabled returns true if the cache can be used with the given context. It
+// must be called with the mutex held.
+func (c *stateCache) enabled(ctx sdk.Context) bool {
+	if c == nil || ctx.IsCheckTx() || ctx.BlockHeight() < c.height {
+		return false
+	}
+
+	if ctx.BlockHeight() > c.height {
+		c.height = ctx.BlockHeight()
+		c.entries = make(map[string][]byte)
+		c.dirty = make(map[string]struct{})
+	}
+	return true
+}
+
+// tracked returns true if the reads of the context are tracked.
+func tracked(ctx sdk.Context) bool {
+	_, ok := ctx.MultiStore().(*trackedMultiStore)
+	return ok
+}
+
+// get returns the cached value of the store key, consuming the gas of the
+// store read it replaces.
+func (c *stateCache) get(ctx sdk.Context, key []byte) ([]byte, bool) {
+	if c == nil || tracked(ctx) {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	if !c.enabled(ctx) {
+		c.mtx.Unlock()
+		return nil, false
+	}
+	value, ok := c.entries[string(key)]
+	c.mtx.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	// same gas consumption as gaskv.Store.Get
+	gasConfig := ctx.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(key)), storetypes.GasReadPerByteDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(value)), storetypes.GasReadPerByteDesc)
+
+	return value, true
+}
+
+// set caches the value read from the store key, unless the key has been
+// written during the block.
+func (c *stateCache) set(ctx sdk.Context, key, value []byte) {
+	if c == nil || tracked(ctx) {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.enabled(ctx) || len(c.entries) >= c.size {
+		return
+	}
+	if _, ok := c.dirty[string(key)]; ok {
+		return
+	}
+
+	c.entries[string(key)] = value
+}
+
+// invalidate marks the store key as written during the block.
+func (c *stateCache) invalidate(ctx sdk.Context, key []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.enabled(ctx) {
+		return
+	}
+
+	delete(c.entries, string(key))
+	c.dirty[string(key)] = struct{}{}
+}
+
+// read returns the value of the store key, reading it from the store if it's
+// not cached.
+func (c *stateCache) read(ctx sdk.Context, storeKey storetypes.StoreKey, key []byte) []byte {
+	if value, ok := c.get(ctx, key); ok {
+		return value
+	}
+
+	value := ctx.KVStore(storeKey).Get(key)
+	c.set(ctx, key, value)
+	return value
+}
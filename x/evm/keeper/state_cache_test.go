@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/evmos/evmos/v12/testutil/tx"
+	"github.com/evmos/evmos/v12/x/evm/keeper"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestStateCache() {
+	suite.SetupTest()
+
+	k := suite.app.EvmKeeper
+	defer k.WithStateCache(keeper.DefaultStateCacheSize)
+
+	addr := utiltx.GenerateAddress()
+	key := common.BytesToHash([]byte("key"))
+	emptyKey := common.BytesToHash([]byte("empty"))
+	value := common.BytesToHash([]byte("value"))
+
+	vmdb := suite.StateDB()
+	vmdb.SetState(addr, key, value)
+	suite.Require().NoError(vmdb.Commit())
+
+	suite.Run("gas consumption is not affected", func() {
+		gasUsed := func() uint64 {
+			ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			for i := 0; i < 3; i++ {
+				k.GetState(ctx, addr, key)
+				k.GetState(ctx, addr, emptyKey)
+				k.GetParams(ctx)
+			}
+			return ctx.GasMeter().GasConsumed()
+		}
+
+		k.WithStateCache(0)
+		expGas := gasUsed()
+
+		k.WithStateCache(keeper.DefaultStateCacheSize)
+		suite.Require().Equal(expGas, gasUsed())
+		suite.Require().Equal(expGas, gasUsed())
+	})
+
+	suite.Run("cached values skip the store read", func() {
+		k.WithStateCache(keeper.DefaultStateCacheSize)
+		suite.Require().Equal(value, k.GetState(suite.ctx, addr, key))
+
+		// the EVM keeper is the only writer of the storage slots
+		store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+		store.Set(types.StateKey(addr, key.Bytes()), common.Hash{1}.Bytes())
+		suite.Require().Equal(value, k.GetState(suite.ctx, addr, key))
+
+		// the cache isn't used by the queries of the previous block
+		queryCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() - 1)
+		suite.Require().Equal(common.Hash{1}, k.GetState(queryCtx, addr, key))
+
+		// nor by the next block
+		nextCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+		suite.Require().Equal(common.Hash{1}, k.GetState(nextCtx, addr, key))
+	})
+
+	suite.Run("writes are observed", func() {
+		k.WithStateCache(keeper.DefaultStateCacheSize)
+		suite.Require().Equal(common.Hash{1}, k.GetState(suite.ctx, addr, key))
+
+		// reverted writes
+		cacheCtx, _ := suite.ctx.CacheContext()
+		k.SetState(cacheCtx, addr, key, common.Hash{2}.Bytes())
+		suite.Require().Equal(common.Hash{2}, k.GetState(cacheCtx, addr, key))
+		suite.Require().Equal(common.Hash{1}, k.GetState(suite.ctx, addr, key))
+
+		// committed writes
+		k.SetState(suite.ctx, addr, key, common.Hash{3}.Bytes())
+		suite.Require().Equal(common.Hash{3}, k.GetState(suite.ctx, addr, key))
+		k.SetState(suite.ctx, addr, key, nil)
+		suite.Require().Equal(common.Hash{}, k.GetState(suite.ctx, addr, key))
+	})
+
+	suite.Run("queries at the height of the block see its committed state", func() {
+		otherKey := common.BytesToHash([]byte("other"))
+		k.SetState(suite.ctx, addr, otherKey, value.Bytes())
+
+		// a value cached during the block and a key written during the block
+		k.WithStateCache(keeper.DefaultStateCacheSize)
+		suite.Require().Equal(value, k.GetState(suite.ctx, addr, otherKey))
+		k.SetState(suite.ctx, addr, key, common.Hash{4}.Bytes())
+
+		for _, kv := range [][2]common.Hash{{otherKey, value}, {key, {4}}} {
+			res, err := suite.queryClient.Storage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRequest{
+				Address: addr.Hex(),
+				Key:     kv[0].Hex(),
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(kv[1].Hex(), res.Value)
+		}
+	})
+
+	suite.Run("params are not aliased", func() {
+		k.WithStateCache(keeper.DefaultStateCacheSize)
+
+		params := k.GetParams(suite.ctx)
+		params.ExtraEIPs = []int64{1344}
+		suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+		params = k.GetParams(suite.ctx)
+		expParams := k.GetParams(suite.ctx)
+		suite.Require().Equal([]int64{1344}, expParams.ExtraEIPs)
+
+		params.ExtraEIPs[0] = 2200
+		suite.Require().Equal(expParams, k.GetParams(suite.ctx))
+	})
+}
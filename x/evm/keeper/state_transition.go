@@ -40,10 +40,14 @@ import (
 // coinbase address to make it available for the COINBASE opcode, even though there is no
 // beneficiary of the coinbase transaction (since we're not mining).
 //
-// NOTE: the RANDOM opcode is currently not supported since it requires
-// RANDAO implementation. See https://github.com/evmos/ethermint/pull/1520#pullrequestreview-1200504697
-// for more information.
-
+// From the PrevRandaoBlock of the chain config, the randomness of the block
+// (see BlockRandomness) is returned by the PREVRANDAO opcode, which replaces
+// DIFFICULTY as the merge rules are enabled. The randomness is derived from the
+// block header hash, so it can be biased by the block proposer and must not be
+// used when the proposer has a stake in the outcome.
+//
+// The active stateful precompiled contracts are set on the EVM along with the
+// native ones, so that they're also run when called by other contracts.
 func (k *Keeper) NewEVM(
 	ctx sdk.Context,
 	msg core.Message,
@@ -61,7 +65,11 @@ func (k *Keeper) NewEVM(
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
-		Random:      nil, // not supported
+	}
+
+	if cfg.Params.ChainConfig.IsPrevRandao(blockCtx.BlockNumber) {
+		random := k.BlockRandomness(ctx)
+		blockCtx.Random = &random
 	}
 
 	txCtx := core.NewEVMTxContext(msg)
@@ -69,7 +77,36 @@ func (k *Keeper) NewEVM(
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	rules := cfg.ChainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil)
+	precompiles, addresses := k.evmPrecompiles(cfg.Params, rules)
+	hooks := k.precompileHooks(ctx, k.opCodeHooks(ctx, cfg.Params, msg), precompiles)
+	evm := vm.NewEVMWithHooks(hooks, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+
+	evm.WithPrecompiles(precompiles, addresses)
+	return evm
+}
+
+// BlockRandomness returns the randomness of the current block, derived from
+// its header hash. The header hash is only set on the Context while executing
+// the block (and tracing its transactions), so the randomness recorded at the
+// beginning of the block is returned otherwise (eg: on eth_call queries), as
+// the header of the query contexts is incomplete.
+func (k Keeper) BlockRandomness(ctx sdk.Context) common.Hash {
+	if headerHash := ctx.HeaderHash(); len(headerHash) != 0 {
+		return types.BlockRandomness(common.BytesToHash(headerHash))
+	}
+	return k.GetBlockRandomness(ctx)
+}
+
+// GetBlockRandomness returns the randomness recorded at the beginning of the
+// latest block where the PREVRANDAO opcode was enabled, or an empty hash.
+func (k Keeper) GetBlockRandomness(ctx sdk.Context) common.Hash {
+	return common.BytesToHash(ctx.KVStore(k.storeKey).Get(types.KeyPrefixBlockRandomness))
+}
+
+// SetBlockRandomness records the randomness of the current block.
+func (k Keeper) SetBlockRandomness(ctx sdk.Context, random common.Hash) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixBlockRandomness, random.Bytes())
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -145,7 +182,17 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 // returning.
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
+//
+// # Parallel execution
+//
+// When the Ethereum txs of the block have been executed speculatively, the speculative result of the tx is applied
+// if it's still valid, see PrepareParallelExecution.
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+	return k.applyTransaction(ctx, tx, k.nextSpeculativeTx(ctx, tx.Hash()))
+}
+
+// applyTransaction applies the transaction, using the given speculative result of its message if it's valid.
+func (k *Keeper) applyTransaction(ctx sdk.Context, tx *ethtypes.Transaction, spec *speculativeTx) (*types.MsgEthereumTxResponse, error) {
 	var (
 		bloom        *big.Int
 		bloomReceipt ethtypes.Bloom
@@ -175,8 +222,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	// If the pre processing hooks fail, the tx is not executed
+	if err = k.PreTxProcessing(tmpCtx, msg, msg.From()); err != nil {
+		return nil, errorsmod.Wrap(err, types.ErrPreTxProcessing.Error())
+	}
+
+	// the StateDB is committed
+	res, err := k.applyMessage(tmpCtx, msg, cfg, txConfig, spec)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
@@ -228,6 +280,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 
 			// If the tx failed in post processing hooks, we should clear the logs
 			res.Logs = nil
+
+			// The events of the reverted hooks are discarded, except the ones describing the failures
+			for _, event := range tmpCtx.EventManager().Events() {
+				if event.Type == types.EventTypeEvmHookFailed {
+					ctx.EventManager().EmitEvent(event)
+				}
+			}
 		} else if commit != nil {
 			// PostTxProcessing is successful, commit the tmpCtx
 			commit()
@@ -238,7 +297,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+	// The gas is refunded in the fee denomination the fees were paid with, if any.
+	if payment, found := k.GetFeePaymentTransient(ctx, txConfig.TxHash); found {
+		err = k.RefundFeePayment(ctx, msg, msg.Gas()-res.GasUsed, payment)
+	} else {
+		err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom)
+	}
+	if err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
@@ -328,6 +393,10 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	if err := k.CheckTxPermissions(ctx, cfg.Params, msg.From(), msg.To(), msg.Data()); err != nil {
+		return nil, err
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
@@ -361,8 +430,8 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
+	if rules := cfg.ChainConfig.Rules(evm.Context.BlockNumber, evm.Context.Random != nil); rules.IsBerlin {
+		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
 	if contractCreation {
@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -16,6 +17,7 @@ import (
 	"github.com/evmos/evmos/v12/x/evm/keeper"
 	"github.com/evmos/evmos/v12/x/evm/statedb"
 	"github.com/evmos/evmos/v12/x/evm/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -721,3 +723,45 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestBlockRandomness() {
+	// runtime code returning the PREVRANDAO value
+	code := common.FromHex("0x4460005260206000f3")
+	contract := utiltx.GenerateAddress()
+
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contract, code)
+	suite.Require().NoError(vmdb.Commit())
+
+	prevRandao := func() common.Hash {
+		msg := ethtypes.NewMessage(suite.address, &contract, 0, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+		res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, types.NewNoOpTracer(), false)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return common.BytesToHash(res.Ret)
+	}
+
+	headerHash := common.BytesToHash(tmhash.Sum([]byte("header")))
+	suite.ctx = suite.ctx.WithHeaderHash(headerHash.Bytes())
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+
+	random := prevRandao()
+	suite.Require().NotEqual(common.Hash{}, random)
+	suite.Require().Equal(types.BlockRandomness(headerHash), random)
+	suite.Require().Equal(random, suite.app.EvmKeeper.BlockRandomness(suite.ctx))
+
+	// queries without the header hash return the randomness recorded for the block
+	suite.ctx = suite.ctx.WithHeaderHash(nil)
+	suite.Require().Equal(random, prevRandao())
+
+	// the randomness changes with the block
+	suite.ctx = suite.ctx.WithHeaderHash(tmhash.Sum([]byte("next header")))
+	suite.Require().NotEqual(random, prevRandao())
+
+	// DIFFICULTY returns zero before the PREVRANDAO block
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	prevRandaoBlock := sdkmath.NewInt(suite.ctx.BlockHeight() + 1)
+	params.ChainConfig.PrevRandaoBlock = &prevRandaoBlock
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	suite.Require().Equal(common.Hash{}, prevRandao())
+}
@@ -49,9 +49,7 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 
 // GetState loads contract state from database, implements `statedb.Keeper` interface.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-
-	value := store.Get(key.Bytes())
+	value := k.stateCache.read(ctx, k.storeKey, types.StateKey(addr, key.Bytes()))
 	if len(value) == 0 {
 		return common.Hash{}
 	}
@@ -133,9 +131,11 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 	codeHash := common.BytesToHash(account.CodeHash)
 
 	if ethAcct, ok := acct.(evmostypes.EthAccountI); ok {
+		prevCodeHash := ethAcct.GetCodeHash()
 		if err := ethAcct.SetCodeHash(codeHash); err != nil {
 			return err
 		}
+		k.updateCodeRefCounts(ctx, prevCodeHash, codeHash)
 	}
 
 	k.accountKeeper.SetAccount(ctx, acct)
@@ -156,6 +156,8 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 // SetState update contract storage, delete if value is empty.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	k.stateCache.invalidate(ctx, types.StateKey(addr, key.Bytes()))
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	action := "updated"
 	if len(value) == 0 {
@@ -191,7 +193,7 @@ func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
 
 // DeleteAccount handles contract's suicide call:
 // - clear balance
-// - remove code
+// - remove code, if no other account references it
 // - remove states
 // - remove auth account
 func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
@@ -202,7 +204,7 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	}
 
 	// NOTE: only Ethereum accounts (contracts) can be selfdestructed
-	_, ok := acct.(evmostypes.EthAccountI)
+	ethAcct, ok := acct.(evmostypes.EthAccountI)
 	if !ok {
 		return errorsmod.Wrapf(types.ErrInvalidAccount, "type %T, address %s", acct, addr)
 	}
@@ -221,6 +223,9 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
 
+	// release the code reference of the account
+	k.updateCodeRefCounts(ctx, ethAcct.GetCodeHash(), common.Hash{})
+
 	k.Logger(ctx).Debug(
 		"account suicided",
 		"ethereum-address", addr.Hex(),
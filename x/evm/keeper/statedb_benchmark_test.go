@@ -191,3 +191,41 @@ func BenchmarkSuicide(b *testing.B) {
 		vmdb.Suicide(addr)
 	}
 }
+
+func benchmarkReadHotContract(b *testing.B, cached bool) {
+	suite := KeeperTestSuite{}
+	suite.SetupTestWithT(b)
+
+	if !cached {
+		suite.app.EvmKeeper.WithStateCache(0)
+	}
+
+	keys := make([]common.Hash, 16)
+	vmdb := suite.StateDB()
+	for i := range keys {
+		keys[i] = crypto.Keccak256Hash([]byte{byte(i)})
+		vmdb.SetState(suite.address, keys[i], crypto.Keccak256Hash(keys[i].Bytes()))
+	}
+	require.NoError(b, vmdb.Commit())
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	// every transaction reads the params and the contract storage through a
+	// new StateDB
+	for i := 0; i < b.N; i++ {
+		suite.app.EvmKeeper.GetParams(suite.ctx)
+		vmdb := suite.StateDB()
+		for _, key := range keys {
+			vmdb.GetState(suite.address, key)
+		}
+	}
+}
+
+func BenchmarkReadHotContract(b *testing.B) {
+	benchmarkReadHotContract(b, false)
+}
+
+func BenchmarkReadHotContractCached(b *testing.B) {
+	benchmarkReadHotContract(b, true)
+}
@@ -497,6 +497,45 @@ func (suite *KeeperTestSuite) TestSuicide() {
 	suite.Require().Equal(false, db.HasSuicided(addr2))
 }
 
+func (suite *KeeperTestSuite) TestCodeRefCount() {
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+	addr2 := utiltx.GenerateAddress()
+
+	// deploy the same code to two accounts
+	db := suite.StateDB()
+	db.SetCode(suite.address, code)
+	db.SetCode(addr2, code)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(uint64(2), suite.app.EvmKeeper.GetCodeRefCount(suite.ctx, codeHash))
+
+	// the code is kept while it's referenced
+	db = suite.StateDB()
+	suite.Require().True(db.Suicide(suite.address))
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetCodeRefCount(suite.ctx, codeHash))
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, codeHash))
+
+	// the code is deleted with its last reference
+	db = suite.StateDB()
+	suite.Require().True(db.Suicide(addr2))
+	suite.Require().NoError(db.Commit())
+	suite.Require().Zero(suite.app.EvmKeeper.GetCodeRefCount(suite.ctx, codeHash))
+	suite.Require().Nil(suite.app.EvmKeeper.GetCode(suite.ctx, codeHash))
+
+	// redeploying the code in the same tx as the last reference is destroyed keeps it
+	db = suite.StateDB()
+	db.SetCode(suite.address, code)
+	suite.Require().NoError(db.Commit())
+
+	db = suite.StateDB()
+	suite.Require().True(db.Suicide(suite.address))
+	db.SetCode(addr2, code)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetCodeRefCount(suite.ctx, codeHash))
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, codeHash))
+}
+
 func (suite *KeeperTestSuite) TestExist() {
 	testCases := []struct {
 		name     string
@@ -51,7 +51,10 @@ func TestMigrate(t *testing.T) {
 	ctx := testutil.DefaultContext(storeKey, tKey)
 	kvStore := ctx.KVStore(storeKey)
 
-	legacySubspace := newMockSubspace(types.DefaultParams())
+	// the PREVRANDAO block isn't defined by the legacy chain config
+	legacyParams := types.DefaultParams()
+	legacyParams.ChainConfig.PrevRandaoBlock = nil
+	legacySubspace := newMockSubspace(legacyParams)
 	require.NoError(t, v4.MigrateStore(ctx, storeKey, legacySubspace, cdc))
 
 	// Get all the new parameters from the kvStore
@@ -85,5 +88,5 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, legacySubspace.ps.EnableCreate, params.EnableCreate)
 	require.Equal(t, legacySubspace.ps.AllowUnprotectedTxs, params.AllowUnprotectedTxs)
 	require.Equal(t, legacySubspace.ps.ExtraEIPs, params.ExtraEIPs.EIPs)
-	require.EqualValues(t, legacySubspace.ps.ChainConfig, params.V4ChainConfig)
+	require.Equal(t, cdc.MustMarshal(&legacySubspace.ps.ChainConfig), cdc.MustMarshal(&params.V4ChainConfig))
 }
@@ -0,0 +1,76 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it computes the number of accounts that reference
+// each contract code hash and deletes the stored codes that are no longer
+// referenced by any account.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	ak types.AccountKeeper,
+) error {
+	refCountStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefixCodeRefCount)
+	codeStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefixCode)
+
+	ak.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		ethAccount, ok := account.(evmostypes.EthAccountI)
+		if !ok || !types.IsContractCodeHash(ethAccount.GetCodeHash()) {
+			return false
+		}
+
+		key := ethAccount.GetCodeHash().Bytes()
+
+		var count uint64
+		if bz := refCountStore.Get(key); len(bz) > 0 {
+			count = sdk.BigEndianToUint64(bz)
+		}
+
+		refCountStore.Set(key, sdk.Uint64ToBigEndian(count+1))
+		return false
+	})
+
+	var unreferenced [][]byte
+
+	iterator := codeStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if !refCountStore.Has(iterator.Key()) {
+			unreferenced = append(unreferenced, iterator.Key())
+		}
+	}
+
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, codeHash := range unreferenced {
+		codeStore.Delete(codeHash)
+	}
+
+	ctx.Logger().Info("computed the contract code reference counts", "deleted-codes", len(unreferenced))
+	return nil
+}
@@ -25,10 +25,12 @@ import (
 // codebase to support additional state transition functionalities. In particular
 // it supports appending a new entry to the state journal through
 // AppendJournalEntry so that the state can be reverted after running
-// stateful precompiled contracts.
+// stateful precompiled contracts, and branching the Cosmos SDK context through
+// BranchContext so that precompiles can modify the state of other modules.
 type ExtStateDB interface {
 	vm.StateDB
 	AppendJournalEntry(JournalEntry)
+	BranchContext() (sdk.Context, error)
 }
 
 // Keeper provide underlying storage of StateDB
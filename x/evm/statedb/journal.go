@@ -137,6 +137,12 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the transaction context
+	branchContextChange struct {
+		prevObjects  map[common.Address]*stateObject
+		prevBranches int
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -239,3 +245,12 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch branchContextChange) Revert(s *StateDB) {
+	s.stateObjects = ch.prevObjects
+	s.cacheBranches = s.cacheBranches[:ch.prevBranches]
+}
+
+func (ch branchContextChange) Dirtied() *common.Address {
+	return nil
+}
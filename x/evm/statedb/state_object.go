@@ -71,6 +71,7 @@ type stateObject struct {
 
 	// state storage
 	originStorage Storage
+	branchStorage Storage
 	dirtyStorage  Storage
 
 	address common.Address
@@ -78,6 +79,9 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// branched is true if the object was loaded from a branch of the
+	// transaction Context
+	branched bool
 }
 
 // newObject creates a state object.
@@ -93,7 +97,9 @@ func newObject(db *StateDB, address common.Address, account Account) *stateObjec
 		address:       address,
 		account:       account,
 		originStorage: make(Storage),
+		branchStorage: make(Storage),
 		dirtyStorage:  make(Storage),
+		branched:      len(db.cacheBranches) > 0,
 	}
 }
 
@@ -154,7 +160,7 @@ func (s *stateObject) Code() []byte {
 	if bytes.Equal(s.CodeHash(), emptyCodeHash) {
 		return nil
 	}
-	code := s.db.keeper.GetCode(s.db.ctx, common.BytesToHash(s.CodeHash()))
+	code := s.db.keeper.GetCode(s.db.currentContext(), common.BytesToHash(s.CodeHash()))
 	s.code = code
 	return code
 }
@@ -210,7 +216,9 @@ func (s *stateObject) Nonce() uint64 {
 	return s.account.Nonce
 }
 
-// GetCommittedState query the committed state
+// GetCommittedState query the committed state, i.e. the state at the start of
+// the transaction. It's read from the transaction Context, which the changes of
+// the transaction are only written to on Commit.
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
@@ -221,12 +229,27 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	return value
 }
 
+// getBranchState returns the state of the Context the object was loaded from,
+// which is the latest branch of the transaction Context, including the changes
+// of the stateful precompiled contracts, if the object is branched.
+func (s *stateObject) getBranchState(key common.Hash) common.Hash {
+	if !s.branched {
+		return s.GetCommittedState(key)
+	}
+	if value, cached := s.branchStorage[key]; cached {
+		return value
+	}
+	value := s.db.keeper.GetState(s.db.currentContext(), s.Address(), key)
+	s.branchStorage[key] = value
+	return value
+}
+
 // GetState query the current state (including dirty state)
 func (s *stateObject) GetState(key common.Hash) common.Hash {
 	if value, dirty := s.dirtyStorage[key]; dirty {
 		return value
 	}
-	return s.GetCommittedState(key)
+	return s.getBranchState(key)
 }
 
 // SetState sets the contract state
@@ -21,6 +21,7 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -28,6 +29,14 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// MaxCacheBranches is the maximum number of branches of the transaction Context,
+// i.e. of calls to the stateful precompiled contracts, in a transaction.
+const MaxCacheBranches = 10
+
+// ErrMaxCacheBranches is returned when the stateful precompiled contracts are
+// called more than MaxCacheBranches times in a transaction.
+var ErrMaxCacheBranches = fmt.Errorf("reached the maximum of %d stateful precompile calls in a transaction", MaxCacheBranches)
+
 // revision is the identifier of a version of state.
 // it consists of an auto-increment id and a journal index.
 // it's safer to use than using journal index alone.
@@ -36,7 +45,10 @@ type revision struct {
 	journalIndex int
 }
 
-var _ vm.StateDB = &StateDB{}
+var (
+	_ vm.StateDB = &StateDB{}
+	_ ExtStateDB = &StateDB{}
+)
 
 // StateDB structs within the ethereum protocol are used to store anything
 // within the merkle trie. StateDBs take care of caching and storing
@@ -65,6 +77,17 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Branches of the transaction context where the stateful precompiled
+	// contracts read and write the state of the Cosmos modules.
+	cacheBranches []cacheBranch
+}
+
+// cacheBranch is a branch of the transaction context, which is written to its
+// parent context on Commit.
+type cacheBranch struct {
+	ctx   sdk.Context
+	store storetypes.CacheMultiStore
 }
 
 // New creates a new state from a given trie.
@@ -90,6 +113,58 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// AppendJournalEntry appends a modification entry to the journal, so that it's
+// reverted along with the other modifications of the state.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
+// BranchContext returns a new branch of the transaction Context, where a stateful
+// precompiled contract can read and write the state of the Cosmos modules.
+//
+// The dirty states are written to the branch beforehand, so that the modules see
+// the changes made by the EVM, and the live state objects are reloaded from the
+// branch afterwards, so that the EVM sees the changes made by the modules. The
+// committed states are still read from the transaction Context, so that the gas
+// and refunds of the storage writes are computed against the state at the start
+// of the transaction. The branch is discarded when reverting to a snapshot taken
+// before its creation, and it's written to the transaction Context along with its
+// events on Commit.
+//
+// Each branch is stacked on top of the previous one, so the number of branches
+// of a transaction is limited to MaxCacheBranches.
+func (s *StateDB) BranchContext() (sdk.Context, error) {
+	if len(s.cacheBranches) >= MaxCacheBranches {
+		return sdk.Context{}, ErrMaxCacheBranches
+	}
+
+	parent := s.currentContext()
+	store := parent.MultiStore().CacheMultiStore()
+	ctx := parent.WithMultiStore(store).WithEventManager(sdk.NewEventManager())
+
+	s.journal.append(branchContextChange{
+		prevObjects:  s.stateObjects,
+		prevBranches: len(s.cacheBranches),
+	})
+	s.cacheBranches = append(s.cacheBranches, cacheBranch{ctx: ctx, store: store})
+
+	if err := s.writeStateObjects(ctx); err != nil {
+		return sdk.Context{}, err
+	}
+	s.stateObjects = make(map[common.Address]*stateObject)
+
+	return ctx, nil
+}
+
+// currentContext returns the Context that the state is read from, which is the
+// latest branch of the transaction Context if any.
+func (s *StateDB) currentContext() sdk.Context {
+	if n := len(s.cacheBranches); n > 0 {
+		return s.cacheBranches[n-1].ctx
+	}
+	return s.ctx
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -227,7 +302,7 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 		return obj
 	}
 	// If no live objects are available, load it from keeper
-	account := s.keeper.GetAccount(s.ctx, addr)
+	account := s.keeper.GetAccount(s.currentContext(), addr)
 	if account == nil {
 		return nil
 	}
@@ -287,7 +362,7 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
-	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
+	s.keeper.ForEachStorage(s.currentContext(), addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
 		}
@@ -456,26 +531,48 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 // Commit writes the dirty states to keeper
 // the StateDB object should be discarded after committed.
 func (s *StateDB) Commit() error {
+	if err := s.writeStateObjects(s.currentContext()); err != nil {
+		return err
+	}
+
+	// write the branches from the latest one, as each branch is written to its parent
+	for i := len(s.cacheBranches) - 1; i >= 0; i-- {
+		s.cacheBranches[i].store.Write()
+	}
+	for _, branch := range s.cacheBranches {
+		s.ctx.EventManager().EmitEvents(branch.ctx.EventManager().Events())
+	}
+	return nil
+}
+
+// writeStateObjects writes the dirty live state objects to the keeper. Dirty
+// objects that are not live anymore have already been written to a branch of
+// the transaction Context.
+func (s *StateDB) writeStateObjects(ctx sdk.Context) error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
+		if obj == nil {
+			continue
+		}
+
 		if obj.suicided {
-			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
+			if err := s.keeper.DeleteAccount(ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
 		} else {
 			if obj.code != nil && obj.dirtyCode {
-				s.keeper.SetCode(s.ctx, obj.CodeHash(), obj.code)
+				s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
 			}
-			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
+			if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				value := obj.dirtyStorage[key]
 				// Skip noop changes, persist actual changes
-				if value == obj.originStorage[key] {
+				if value == obj.getBranchState(key) {
 					continue
 				}
-				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+				s.keeper.SetState(ctx, obj.Address(), key, value.Bytes())
 			}
 		}
 	}
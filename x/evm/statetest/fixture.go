@@ -0,0 +1,191 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package statetest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StateTest is a test case of the ethereum/tests GeneralStateTests fixtures.
+// Each case defines a pre-state, a transaction with several data, gas limit
+// and value variants, and the expected post-state root and logs hash of each
+// variant per fork.
+type StateTest struct {
+	Env  Env                    `json:"env"`
+	Pre  core.GenesisAlloc      `json:"pre"`
+	Tx   Transaction            `json:"transaction"`
+	Post map[string][]PostState `json:"post"`
+}
+
+// Env defines the block context of a state test.
+type Env struct {
+	Coinbase   common.UnprefixedAddress `json:"currentCoinbase"`
+	Difficulty *math.HexOrDecimal256    `json:"currentDifficulty"`
+	Random     *math.HexOrDecimal256    `json:"currentRandom"`
+	GasLimit   math.HexOrDecimal64      `json:"currentGasLimit"`
+	Number     math.HexOrDecimal64      `json:"currentNumber"`
+	Timestamp  math.HexOrDecimal64      `json:"currentTimestamp"`
+	BaseFee    *math.HexOrDecimal256    `json:"currentBaseFee"`
+}
+
+// Transaction defines the transaction variants of a state test.
+type Transaction struct {
+	GasPrice             *math.HexOrDecimal256  `json:"gasPrice"`
+	MaxFeePerGas         *math.HexOrDecimal256  `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *math.HexOrDecimal256  `json:"maxPriorityFeePerGas"`
+	Nonce                math.HexOrDecimal64    `json:"nonce"`
+	To                   string                 `json:"to"`
+	Data                 []string               `json:"data"`
+	AccessLists          []*ethtypes.AccessList `json:"accessLists,omitempty"`
+	GasLimit             []math.HexOrDecimal64  `json:"gasLimit"`
+	Value                []string               `json:"value"`
+	PrivateKey           hexutil.Bytes          `json:"secretKey"`
+}
+
+// PostState defines the expected result of a transaction variant.
+type PostState struct {
+	Root            common.UnprefixedHash `json:"hash"`
+	Logs            common.UnprefixedHash `json:"logs"`
+	ExpectException string                `json:"expectException"`
+	Indexes         struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+}
+
+// LoadFile loads the state tests of a fixture file, indexed by name.
+func LoadFile(path string) (map[string]*StateTest, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var tests map[string]*StateTest
+	if err := json.Unmarshal(bz, &tests); err != nil {
+		return nil, fmt.Errorf("invalid state test file %s: %w", path, err)
+	}
+
+	return tests, nil
+}
+
+// BaseFee returns the base fee of the block when London is active.
+func (t *StateTest) BaseFee(london bool) *big.Int {
+	if !london {
+		return nil
+	}
+
+	if t.Env.BaseFee == nil {
+		// the fixtures are filled with a genesis base fee of 0x10, so the
+		// base fee of the block defaults to its parent minus 2
+		return big.NewInt(0x0a)
+	}
+
+	return (*big.Int)(t.Env.BaseFee)
+}
+
+// Message returns the message of the transaction variant of the post state,
+// priced with the effective gas price when a base fee is set.
+func (t *StateTest) Message(post PostState, baseFee *big.Int) (core.Message, error) {
+	tx := t.Tx
+
+	var from common.Address
+	if len(tx.PrivateKey) > 0 {
+		key, err := crypto.ToECDSA(tx.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		from = crypto.PubkeyToAddress(key.PublicKey)
+	}
+
+	var to *common.Address
+	if tx.To != "" {
+		to = new(common.Address)
+		if err := to.UnmarshalText([]byte(tx.To)); err != nil {
+			return nil, fmt.Errorf("invalid to address: %w", err)
+		}
+	}
+
+	if post.Indexes.Data >= len(tx.Data) {
+		return nil, fmt.Errorf("tx data index %d out of bounds", post.Indexes.Data)
+	}
+	if post.Indexes.Value >= len(tx.Value) {
+		return nil, fmt.Errorf("tx value index %d out of bounds", post.Indexes.Value)
+	}
+	if post.Indexes.Gas >= len(tx.GasLimit) {
+		return nil, fmt.Errorf("tx gas limit index %d out of bounds", post.Indexes.Gas)
+	}
+
+	value := new(big.Int)
+	if valueHex := tx.Value[post.Indexes.Value]; valueHex != "0x" {
+		v, ok := math.ParseBig256(valueHex)
+		if !ok {
+			return nil, fmt.Errorf("invalid tx value %q", valueHex)
+		}
+		value = v
+	}
+
+	dataHex := tx.Data[post.Indexes.Data]
+	data, err := hex.DecodeString(strings.TrimPrefix(dataHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx data %q", dataHex)
+	}
+
+	var accessList ethtypes.AccessList
+	if post.Indexes.Data < len(tx.AccessLists) && tx.AccessLists[post.Indexes.Data] != nil {
+		accessList = *tx.AccessLists[post.Indexes.Data]
+	}
+
+	gasPrice := (*big.Int)(tx.GasPrice)
+	gasFeeCap := (*big.Int)(tx.MaxFeePerGas)
+	gasTipCap := (*big.Int)(tx.MaxPriorityFeePerGas)
+
+	if baseFee != nil {
+		if gasFeeCap == nil {
+			gasFeeCap = gasPrice
+		}
+		if gasFeeCap == nil {
+			gasFeeCap = new(big.Int)
+		}
+		if gasTipCap == nil {
+			gasTipCap = gasFeeCap
+		}
+		gasPrice = math.BigMin(new(big.Int).Add(gasTipCap, baseFee), gasFeeCap)
+	}
+
+	if gasPrice == nil {
+		return nil, fmt.Errorf("no gas price provided")
+	}
+
+	return ethtypes.NewMessage(
+		from, to, uint64(tx.Nonce), value, uint64(tx.GasLimit[post.Indexes.Gas]),
+		gasPrice, gasFeeCap, gasTipCap, data, accessList, false,
+	), nil
+}
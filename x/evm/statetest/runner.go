@@ -0,0 +1,435 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package statetest
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/tests"
+
+	"github.com/evmos/evmos/v12/x/evm/keeper"
+	"github.com/evmos/evmos/v12/x/evm/statedb"
+	"github.com/evmos/evmos/v12/x/evm/types"
+)
+
+// Result is the outcome of a transaction variant of a state test.
+type Result struct {
+	File  string `json:"file"`
+	Name  string `json:"name"`
+	Fork  string `json:"fork"`
+	Index int    `json:"index"`
+	// Skipped is set when the fork isn't supported.
+	Skipped bool `json:"skipped,omitempty"`
+	// Error is the error that invalidated the transaction, if any.
+	Error        string      `json:"error,omitempty"`
+	Root         common.Hash `json:"root"`
+	ExpectedRoot common.Hash `json:"expected_root"`
+	Logs         common.Hash `json:"logs"`
+	ExpectedLogs common.Hash `json:"expected_logs"`
+}
+
+// Passed returns true if the post-state root and logs hash match the expected
+// ones.
+func (r Result) Passed() bool {
+	return !r.Skipped && r.Root == r.ExpectedRoot && r.Logs == r.ExpectedLogs
+}
+
+// ForkSummary counts the results of a fork.
+type ForkSummary struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
+
+// Report gathers the results of a state test run.
+type Report struct {
+	Results []Result                `json:"results"`
+	Forks   map[string]*ForkSummary `json:"forks"`
+}
+
+// Failures returns the results that don't match the expected post-state.
+func (r *Report) Failures() []Result {
+	var failures []Result
+	for _, result := range r.Results {
+		if !result.Skipped && !result.Passed() {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+func (r *Report) add(result Result) {
+	r.Results = append(r.Results, result)
+
+	summary, ok := r.Forks[result.Fork]
+	if !ok {
+		summary = &ForkSummary{}
+		r.Forks[result.Fork] = summary
+	}
+
+	switch {
+	case result.Skipped:
+		summary.Skipped++
+	case result.Passed():
+		summary.Passed++
+	default:
+		summary.Failed++
+	}
+}
+
+// Runner runs the state tests through the x/evm keeper. Each transaction
+// variant is executed on a branch of the context with ApplyMessageWithConfig,
+// using the chain config and extra EIPs of the fork. The checks and fee
+// payments that are done by the ante handler on DeliverTx are emulated as
+// Ethereum does: nonce, sender and balance checks, gas purchase, refund of the
+// leftover gas and payment of the priority fee to the coinbase.
+//
+// The post-state root is computed over all the non-module accounts of the
+// branch that didn't exist before the pre-state was written. The fee market
+// min gas multiplier must be zero for the gas used to match Ethereum.
+type Runner struct {
+	evmKeeper     *keeper.Keeper
+	accountKeeper types.AccountKeeper
+}
+
+// NewRunner creates a new state test Runner.
+func NewRunner(evmKeeper *keeper.Keeper, accountKeeper types.AccountKeeper) *Runner {
+	return &Runner{
+		evmKeeper:     evmKeeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// RunDir runs the state tests of all the JSON files of the directory tree. The
+// fork and the regular expression, when set, filter the forks and the test
+// names to run.
+func (r *Runner) RunDir(ctx sdk.Context, dir, fork string, run *regexp.Regexp) (*Report, error) {
+	if !r.evmKeeper.GetMinGasMultiplier(ctx).IsZero() {
+		return nil, fmt.Errorf("the fee market min gas multiplier must be zero")
+	}
+
+	report := &Report{Forks: make(map[string]*ForkSummary)}
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+
+		stateTests, err := LoadFile(path)
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(stateTests))
+		for name := range stateTests {
+			if run == nil || run.MatchString(name) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			test := stateTests[name]
+
+			forks := make([]string, 0, len(test.Post))
+			for postFork := range test.Post {
+				if fork == "" || fork == postFork {
+					forks = append(forks, postFork)
+				}
+			}
+			sort.Strings(forks)
+
+			for _, postFork := range forks {
+				for i := range test.Post[postFork] {
+					result := r.Run(ctx, test, postFork, i)
+					result.File, result.Name = path, name
+					report.add(result)
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Run executes the transaction variant of the post state of the fork with the
+// given index and compares the post-state root and logs hash with the expected
+// ones. The state changes are discarded.
+func (r *Runner) Run(ctx sdk.Context, test *StateTest, fork string, index int) Result {
+	post := test.Post[fork][index]
+	result := Result{
+		Fork:         fork,
+		Index:        index,
+		ExpectedRoot: common.Hash(post.Root),
+		ExpectedLogs: common.Hash(post.Logs),
+	}
+
+	chainConfig, eips, err := tests.GetChainConfig(fork)
+	if err != nil {
+		result.Skipped = true
+		result.Error = err.Error()
+		return result
+	}
+
+	header := ctx.BlockHeader()
+	header.Height = int64(test.Env.Number)
+	header.Time = time.Unix(int64(test.Env.Timestamp), 0).UTC()
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.
+		WithBlockHeader(header).
+		WithBlockGasMeter(sdk.NewGasMeter(uint64(test.Env.GasLimit)))
+
+	existing := make(map[common.Address]bool)
+	r.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		existing[common.BytesToAddress(account.GetAddress())] = true
+		return false
+	})
+
+	stateDB := statedb.New(ctx, r.evmKeeper, statedb.NewEmptyTxConfig(common.Hash{}))
+	for address, account := range test.Pre {
+		delete(existing, address)
+		stateDB.CreateAccount(address)
+		stateDB.SetCode(address, account.Code)
+		stateDB.SetNonce(address, account.Nonce)
+		if account.Balance != nil {
+			stateDB.AddBalance(address, account.Balance)
+		}
+		for key, value := range account.Storage {
+			stateDB.SetState(address, key, value)
+		}
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		result.Error = fmt.Sprintf("failed to write the pre-state: %s", err)
+		return result
+	}
+
+	params := r.evmKeeper.GetParams(ctx)
+	params.ExtraEIPs = make([]int64, len(eips))
+	for i, eip := range eips {
+		params.ExtraEIPs[i] = int64(eip)
+	}
+
+	cfg := &statedb.EVMConfig{
+		Params:      params,
+		ChainConfig: chainConfig,
+		CoinBase:    common.Address(test.Env.Coinbase),
+		BaseFee:     test.BaseFee(chainConfig.IsLondon(new(big.Int).SetUint64(uint64(test.Env.Number)))),
+	}
+
+	var logs []*ethtypes.Log
+
+	msg, err := test.Message(post, cfg.BaseFee)
+	if err == nil {
+		txCtx, write := ctx.CacheContext()
+		logs, err = r.applyMessage(txCtx, msg, cfg)
+		if err == nil {
+			write()
+		}
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	result.Root = r.stateRoot(ctx, existing)
+	result.Logs = rlpHash(logs)
+
+	return result
+}
+
+// applyMessage applies the message as an Ethereum transaction, with the checks
+// and fee payments of the ante handler and the EVM transaction. An error is
+// returned if the transaction is invalid, in which case the state changes must
+// be discarded.
+func (r *Runner) applyMessage(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig) ([]*ethtypes.Log, error) {
+	from := msg.From()
+
+	stateDB := statedb.New(ctx, r.evmKeeper, statedb.NewEmptyTxConfig(common.Hash{}))
+	if err := buyGas(ctx, stateDB, msg, cfg); err != nil {
+		return nil, err
+	}
+
+	if msg.To() != nil {
+		// the nonce of contract creations is set by ApplyMessageWithConfig
+		stateDB.SetNonce(from, msg.Nonce()+1)
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.Hash{})
+	res, err := r.evmKeeper.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// refund the leftover gas to the sender and pay the priority fee to the
+	// coinbase, the base fee is burnt
+	stateDB = statedb.New(ctx, r.evmKeeper, txConfig)
+
+	leftover := new(big.Int).SetUint64(msg.Gas() - res.GasUsed)
+	stateDB.AddBalance(from, leftover.Mul(leftover, msg.GasPrice()))
+
+	tip := new(big.Int).Set(msg.GasPrice())
+	if cfg.BaseFee != nil {
+		tip = new(big.Int).Sub(msg.GasFeeCap(), cfg.BaseFee)
+		if tip.Cmp(msg.GasTipCap()) > 0 {
+			tip = new(big.Int).Set(msg.GasTipCap())
+		}
+	}
+
+	// empty accounts are not created, as Ethereum removes them since EIP-158
+	if tip.Sign() > 0 {
+		stateDB.AddBalance(cfg.CoinBase, tip.Mul(tip, new(big.Int).SetUint64(res.GasUsed)))
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	return types.LogsToEthereum(res.Logs), nil
+}
+
+// buyGas checks the transaction validity and deducts the gas fees from the
+// sender balance, as the Ethereum state transition does before executing the
+// message.
+func buyGas(ctx sdk.Context, stateDB *statedb.StateDB, msg core.Message, cfg *statedb.EVMConfig) error {
+	from := msg.From()
+
+	switch nonce := stateDB.GetNonce(from); {
+	case nonce < msg.Nonce():
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from, msg.Nonce(), nonce)
+	case nonce > msg.Nonce():
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, from, msg.Nonce(), nonce)
+	case nonce+1 < nonce:
+		return fmt.Errorf("%w: address %s, nonce: %d", core.ErrNonceMax, from, nonce)
+	}
+
+	if codeHash := stateDB.GetCodeHash(from); codeHash != (common.Hash{}) && codeHash != common.BytesToHash(types.EmptyCodeHash) {
+		return fmt.Errorf("%w: address %s, codehash: %s", core.ErrSenderNoEOA, from, codeHash)
+	}
+
+	if cfg.BaseFee != nil {
+		if msg.GasFeeCap().Cmp(msg.GasTipCap()) < 0 {
+			return fmt.Errorf("%w: address %s", core.ErrTipAboveFeeCap, from)
+		}
+		if msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+			return fmt.Errorf("%w: address %s", core.ErrFeeCapTooLow, from)
+		}
+	}
+
+	if gasLimit := ctx.BlockGasMeter().Limit(); msg.Gas() > gasLimit {
+		return fmt.Errorf("%w: have %d, want %d", core.ErrGasLimitReached, gasLimit, msg.Gas())
+	}
+
+	fees := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+
+	balanceCheck := fees
+	if msg.GasFeeCap() != nil {
+		balanceCheck = new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasFeeCap())
+		balanceCheck.Add(balanceCheck, msg.Value())
+	}
+
+	if balance := stateDB.GetBalance(from); balance.Cmp(balanceCheck) < 0 {
+		return fmt.Errorf("%w: address %s have %v want %v", core.ErrInsufficientFunds, from, balance, balanceCheck)
+	}
+
+	stateDB.SubBalance(from, fees)
+
+	if msg.Value().Sign() > 0 && stateDB.GetBalance(from).Cmp(msg.Value()) < 0 {
+		return fmt.Errorf("%w: address %s", core.ErrInsufficientFundsForTransfer, from)
+	}
+
+	return nil
+}
+
+// stateRoot returns the Merkle Patricia Trie root of the accounts of the
+// context, excluding the module accounts and the given accounts.
+func (r *Runner) stateRoot(ctx sdk.Context, excluded map[common.Address]bool) common.Hash {
+	trie, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	r.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		if _, ok := account.(authtypes.ModuleAccountI); ok {
+			return false
+		}
+
+		address := common.BytesToAddress(account.GetAddress())
+		if excluded[address] {
+			return false
+		}
+
+		stateAccount := r.evmKeeper.GetAccount(ctx, address)
+		trie.SetNonce(address, stateAccount.Nonce)
+		trie.SetBalance(address, stateAccount.Balance)
+
+		if !bytes.Equal(stateAccount.CodeHash, types.EmptyCodeHash) {
+			trie.SetCode(address, r.evmKeeper.GetCode(ctx, common.BytesToHash(stateAccount.CodeHash)))
+		}
+
+		r.evmKeeper.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			trie.SetState(address, key, value)
+			return true
+		})
+
+		return false
+	})
+
+	return trie.IntermediateRoot(false)
+}
+
+// rlpHash returns the hash of the logs as it's committed in the fixtures.
+func rlpHash(logs []*ethtypes.Log) common.Hash {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	bz, err := rlp.EncodeToBytes(logs)
+	if err != nil {
+		return common.Hash{}
+	}
+
+	return crypto.Keccak256Hash(bz)
+}
@@ -0,0 +1,99 @@
+package statetest_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/evmos/v12/app"
+	"github.com/evmos/evmos/v12/utils"
+	"github.com/evmos/evmos/v12/x/evm/statetest"
+	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
+)
+
+type StateTestSuite struct {
+	suite.Suite
+
+	app    *app.Evmos
+	ctx    sdk.Context
+	runner *statetest.Runner
+}
+
+func TestStateTestSuite(t *testing.T) {
+	suite.Run(t, new(StateTestSuite))
+}
+
+func (suite *StateTestSuite) SetupTest() {
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.MinGasMultiplier = sdk.ZeroDec()
+
+	suite.app = app.Setup(false, feemarketGenesis)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{ChainID: utils.TestnetChainID + "-1", Height: 1})
+	suite.runner = statetest.NewRunner(suite.app.EvmKeeper, suite.app.AccountKeeper)
+}
+
+func (suite *StateTestSuite) TestRunDir() {
+	report, err := suite.runner.RunDir(suite.ctx, "testdata", "", nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Results, 5)
+	suite.Require().Empty(report.Failures())
+	suite.Require().Equal(statetest.ForkSummary{Passed: 3}, *report.Forks["Berlin"])
+	suite.Require().Equal(statetest.ForkSummary{Passed: 2}, *report.Forks["London"])
+
+	// the out of gas variant consumes all the gas
+	suite.Require().Empty(report.Results[2].Error)
+}
+
+func (suite *StateTestSuite) TestRunDirFilter() {
+	report, err := suite.runner.RunDir(suite.ctx, "testdata", "London", regexp.MustCompile("^storeAndLog$"))
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Results, 2)
+	suite.Require().Len(report.Forks, 1)
+
+	report, err = suite.runner.RunDir(suite.ctx, "testdata", "", regexp.MustCompile("unknown"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(report.Results)
+}
+
+func (suite *StateTestSuite) TestRunMismatch() {
+	tests, err := statetest.LoadFile("testdata/simple.json")
+	suite.Require().NoError(err)
+
+	test := tests["storeAndLog"]
+	test.Post["London"][0].Root[0] ^= 0xff
+
+	result := suite.runner.Run(suite.ctx, test, "London", 0)
+	suite.Require().False(result.Passed())
+	suite.Require().Equal(result.Logs, result.ExpectedLogs)
+
+	// a nonce mismatch invalidates the transaction
+	test.Tx.Nonce = 1
+	result = suite.runner.Run(suite.ctx, test, "London", 1)
+	suite.Require().Contains(result.Error, "nonce too high")
+	suite.Require().False(result.Passed())
+}
+
+func (suite *StateTestSuite) TestRunUnsupportedFork() {
+	tests, err := statetest.LoadFile("testdata/simple.json")
+	suite.Require().NoError(err)
+
+	test := tests["storeAndLog"]
+	test.Post["Prague"] = test.Post["London"]
+
+	result := suite.runner.Run(suite.ctx, test, "Prague", 0)
+	suite.Require().True(result.Skipped)
+	suite.Require().False(result.Passed())
+}
+
+func (suite *StateTestSuite) TestMinGasMultiplier() {
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.MinGasMultiplier = sdk.NewDecWithPrec(5, 1)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	_, err := suite.runner.RunDir(suite.ctx, "testdata", "", nil)
+	suite.Require().Error(err)
+}
@@ -58,6 +58,34 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
+// NewChainConfigFromEthereum maps an Ethereum ChainConfig to its x/evm
+// representation. Forks that are not scheduled (i.e nil blocks) are left unset,
+// as well as the PREVRANDAO block, which has no Ethereum counterpart.
+// The chain ID is ignored as it's derived from the Cosmos chain-id.
+func NewChainConfigFromEthereum(cfg *params.ChainConfig) ChainConfig {
+	return ChainConfig{
+		HomesteadBlock:      newBlockValue(cfg.HomesteadBlock),
+		DAOForkBlock:        newBlockValue(cfg.DAOForkBlock),
+		DAOForkSupport:      cfg.DAOForkSupport,
+		EIP150Block:         newBlockValue(cfg.EIP150Block),
+		EIP150Hash:          cfg.EIP150Hash.String(),
+		EIP155Block:         newBlockValue(cfg.EIP155Block),
+		EIP158Block:         newBlockValue(cfg.EIP158Block),
+		ByzantiumBlock:      newBlockValue(cfg.ByzantiumBlock),
+		ConstantinopleBlock: newBlockValue(cfg.ConstantinopleBlock),
+		PetersburgBlock:     newBlockValue(cfg.PetersburgBlock),
+		IstanbulBlock:       newBlockValue(cfg.IstanbulBlock),
+		MuirGlacierBlock:    newBlockValue(cfg.MuirGlacierBlock),
+		BerlinBlock:         newBlockValue(cfg.BerlinBlock),
+		LondonBlock:         newBlockValue(cfg.LondonBlock),
+		ArrowGlacierBlock:   newBlockValue(cfg.ArrowGlacierBlock),
+		GrayGlacierBlock:    newBlockValue(cfg.GrayGlacierBlock),
+		MergeNetsplitBlock:  newBlockValue(cfg.MergeNetsplitBlock),
+		ShanghaiBlock:       newBlockValue(cfg.ShanghaiBlock),
+		CancunBlock:         newBlockValue(cfg.CancunBlock),
+	}
+}
+
 // DefaultChainConfig returns default evm parameters.
 func DefaultChainConfig() ChainConfig {
 	homesteadBlock := sdk.ZeroInt()
@@ -77,6 +105,7 @@ func DefaultChainConfig() ChainConfig {
 	mergeNetsplitBlock := sdk.ZeroInt()
 	shanghaiBlock := sdk.ZeroInt()
 	cancunBlock := sdk.ZeroInt()
+	prevRandaoBlock := sdk.ZeroInt()
 
 	return ChainConfig{
 		HomesteadBlock:      &homesteadBlock,
@@ -98,6 +127,7 @@ func DefaultChainConfig() ChainConfig {
 		MergeNetsplitBlock:  &mergeNetsplitBlock,
 		ShanghaiBlock:       &shanghaiBlock,
 		CancunBlock:         &cancunBlock,
+		PrevRandaoBlock:     &prevRandaoBlock,
 	}
 }
 
@@ -109,6 +139,15 @@ func getBlockValue(block *sdkmath.Int) *big.Int {
 	return block.BigInt()
 }
 
+func newBlockValue(block *big.Int) *sdkmath.Int {
+	if block == nil {
+		return nil
+	}
+
+	value := sdkmath.NewIntFromBigInt(block)
+	return &value
+}
+
 // Validate performs a basic validation of the ChainConfig params. The function will return an error
 // if any of the block values is uninitialized (i.e nil) or if the EIP150Hash is an invalid hash.
 func (cc ChainConfig) Validate() error {
@@ -166,6 +205,12 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateBlock(cc.PrevRandaoBlock); err != nil {
+		return errorsmod.Wrap(err, "PrevRandaoBlock")
+	}
+	if cc.PrevRandaoBlock != nil && (cc.LondonBlock == nil || cc.PrevRandaoBlock.LT(*cc.LondonBlock)) {
+		return errorsmod.Wrap(ErrInvalidChainConfig, "PrevRandaoBlock cannot be before the London block")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
@@ -173,6 +218,13 @@ func (cc ChainConfig) Validate() error {
 	return nil
 }
 
+// IsPrevRandao returns true if the PREVRANDAO opcode is enabled at the given
+// block number, see PrevRandaoBlock.
+func (cc ChainConfig) IsPrevRandao(num *big.Int) bool {
+	block := getBlockValue(cc.PrevRandaoBlock)
+	return block != nil && block.Cmp(num) <= 0
+}
+
 func validateHash(hex string) error {
 	if hex != "" && strings.TrimSpace(hex) == "" {
 		return errorsmod.Wrap(ErrInvalidChainConfig, "hash cannot be blank")
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -367,6 +368,65 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid PrevRandaoBlock",
+			ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				PrevRandaoBlock:     newIntPtr(-1),
+			},
+			true,
+		},
+		{
+			"invalid PrevRandaoBlock - before LondonBlock",
+			ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(10),
+				PrevRandaoBlock:     newIntPtr(5),
+			},
+			true,
+		},
+		{
+			"invalid PrevRandaoBlock - without LondonBlock",
+			ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				PrevRandaoBlock:     newIntPtr(5),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -379,3 +439,14 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestNewChainConfigFromEthereum(t *testing.T) {
+	cc := DefaultChainConfig()
+	cc.CancunBlock = nil
+	cc.PrevRandaoBlock = nil
+
+	ethCfg := cc.EthereumConfig(big.NewInt(9000))
+	require.Equal(t, cc, NewChainConfigFromEthereum(ethCfg))
+	require.Nil(t, NewChainConfigFromEthereum(ethCfg).CancunBlock)
+	require.NoError(t, NewChainConfigFromEthereum(ethCfg).Validate())
+}
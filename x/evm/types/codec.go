@@ -23,6 +23,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -38,7 +39,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName        = "ethermint/MsgUpdateParams"
+	registerContractABIName = "ethermint/MsgRegisterContractABI"
+	contractAllowanceName   = "ethermint/ContractAllowance"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -57,6 +60,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgRegisterContractABI{},
+	)
+	registry.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&ContractAllowance{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -104,4 +112,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterContractABI{}, registerContractABIName, nil)
+	cdc.RegisterConcrete(&ContractAllowance{}, contractAllowanceName, nil)
 }
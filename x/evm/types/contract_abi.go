@@ -0,0 +1,195 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+var (
+	// revertSelector is the selector of the Error(string) revert reason
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// panicSelector is the selector of the Panic(uint256) error raised by the
+	// failed assertions of Solidity
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// DecodedArgument defines an ABI-decoded argument of a call or an error.
+type DecodedArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// DecodedCall defines a contract call decoded with the contract ABI.
+type DecodedCall struct {
+	// Method is the signature of the called method
+	Method  string            `json:"method"`
+	Args    []DecodedArgument `json:"args"`
+	Outputs []DecodedArgument `json:"outputs,omitempty"`
+}
+
+// DecodedError defines the revert data of a call decoded with the contract ABI.
+type DecodedError struct {
+	// Error is the signature of the error, Error(string) for the revert reasons
+	// and Panic(uint256) for the failed assertions
+	Error string            `json:"error"`
+	Args  []DecodedArgument `json:"args"`
+}
+
+// Validate performs a stateless validation of the contract ABI.
+func (c ContractABI) Validate() error {
+	if err := evmostypes.ValidateNonZeroAddress(c.Address); err != nil {
+		return err
+	}
+
+	if c.CodeHash != "" {
+		bz, err := hexutil.Decode(c.CodeHash)
+		if err != nil || len(bz) != common.HashLength {
+			return fmt.Errorf("invalid code hash %s", c.CodeHash)
+		}
+	}
+
+	_, err := ParseABI(c.ABI)
+	return err
+}
+
+// ParseABI parses a JSON contract ABI. The ABI must not be empty.
+func ParseABI(abiJSON string) (abi.ABI, error) {
+	if strings.TrimSpace(abiJSON) == "" {
+		return abi.ABI{}, fmt.Errorf("empty ABI")
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("invalid ABI: %w", err)
+	}
+	return contractABI, nil
+}
+
+// DecodeCall decodes the input of a contract call and, if not empty, its
+// output.
+func DecodeCall(contractABI abi.ABI, input, output []byte) (*DecodedCall, error) {
+	if len(input) < 4 {
+		return nil, fmt.Errorf("call data too short: %d bytes", len(input))
+	}
+
+	method, err := contractABI.MethodById(input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := decodeArguments(method.Inputs, input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s arguments: %w", method.Sig, err)
+	}
+
+	call := &DecodedCall{
+		Method: method.Sig,
+		Args:   args,
+	}
+
+	if len(output) > 0 && len(method.Outputs) > 0 {
+		call.Outputs, err = decodeArguments(method.Outputs, output)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s outputs: %w", method.Sig, err)
+		}
+	}
+	return call, nil
+}
+
+// DecodeError decodes the revert data of a call. The revert reasons and the
+// panics of Solidity are decoded without the contract ABI, which is used to
+// decode the custom errors.
+func DecodeError(contractABI abi.ABI, data []byte) (*DecodedError, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("revert data too short: %d bytes", len(data))
+	}
+
+	selector := data[:4]
+	switch {
+	case bytes.Equal(selector, revertSelector):
+		return decodeError("Error", abi.Arguments{{Name: "reason", Type: mustNewABIType("string")}}, data[4:])
+	case bytes.Equal(selector, panicSelector):
+		return decodeError("Panic", abi.Arguments{{Name: "code", Type: mustNewABIType("uint256")}}, data[4:])
+	}
+
+	for _, customErr := range contractABI.Errors {
+		if bytes.Equal(customErr.ID[:4], selector) {
+			return decodeError(customErr.Name, customErr.Inputs, data[4:])
+		}
+	}
+	return nil, fmt.Errorf("no error with selector %s", hexutil.Encode(selector))
+}
+
+// decodeError decodes the arguments of an error.
+func decodeError(name string, inputs abi.Arguments, data []byte) (*DecodedError, error) {
+	argTypes := make([]string, len(inputs))
+	for i, input := range inputs {
+		argTypes[i] = input.Type.String()
+	}
+	sig := fmt.Sprintf("%s(%s)", name, strings.Join(argTypes, ","))
+
+	args, err := decodeArguments(inputs, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s arguments: %w", sig, err)
+	}
+
+	return &DecodedError{
+		Error: sig,
+		Args:  args,
+	}, nil
+}
+
+// decodeArguments unpacks ABI-encoded data into its formatted arguments.
+func decodeArguments(arguments abi.Arguments, data []byte) ([]DecodedArgument, error) {
+	values, err := arguments.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]DecodedArgument, len(arguments))
+	for i, argument := range arguments {
+		value, err := formatABIValue(argument.Type, values[i])
+		if err != nil {
+			return nil, err
+		}
+		args[i] = DecodedArgument{
+			Name:  argument.Name,
+			Type:  argument.Type.String(),
+			Value: value,
+		}
+	}
+	return args, nil
+}
+
+// mustNewABIType returns the ABI type of the given elementary type.
+func mustNewABIType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
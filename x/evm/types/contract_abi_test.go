@@ -0,0 +1,234 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var testContractAddress = common.HexToAddress("0x0000000000000000000000000000000000000abc")
+
+const testContractABI = `[
+	{
+		"type": "function",
+		"name": "transfer",
+		"inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}],
+		"outputs": [{"name": "", "type": "bool"}]
+	},
+	{
+		"type": "error",
+		"name": "InsufficientBalance",
+		"inputs": [{"name": "available", "type": "uint256"}, {"name": "required", "type": "uint256"}]
+	}
+]`
+
+func TestDecodeCall(t *testing.T) {
+	contractABI, err := ParseABI(testContractABI)
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000123")
+	input, err := contractABI.Pack("transfer", to, big.NewInt(100))
+	require.NoError(t, err)
+	output, err := contractABI.Methods["transfer"].Outputs.Pack(true)
+	require.NoError(t, err)
+
+	call, err := DecodeCall(contractABI, input, output)
+	require.NoError(t, err)
+	require.Equal(t, &DecodedCall{
+		Method: "transfer(address,uint256)",
+		Args: []DecodedArgument{
+			{Name: "to", Type: "address", Value: to.Hex()},
+			{Name: "amount", Type: "uint256", Value: "100"},
+		},
+		Outputs: []DecodedArgument{
+			{Name: "", Type: "bool", Value: "true"},
+		},
+	}, call)
+
+	call, err = DecodeCall(contractABI, input, nil)
+	require.NoError(t, err)
+	require.Empty(t, call.Outputs)
+
+	_, err = DecodeCall(contractABI, input[:3], nil)
+	require.Error(t, err)
+
+	_, err = DecodeCall(contractABI, []byte{1, 2, 3, 4}, nil)
+	require.Error(t, err)
+
+	_, err = DecodeCall(contractABI, input[:20], nil)
+	require.Error(t, err)
+}
+
+func TestDecodeError(t *testing.T) {
+	contractABI, err := ParseABI(testContractABI)
+	require.NoError(t, err)
+
+	reason, err := abiArgs("string").Pack("not allowed")
+	require.NoError(t, err)
+	code, err := abiArgs("uint256").Pack(big.NewInt(0x11))
+	require.NoError(t, err)
+	custom, err := contractABI.Errors["InsufficientBalance"].Inputs.Pack(big.NewInt(1), big.NewInt(2))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		data     []byte
+		expError bool
+		expected *DecodedError
+	}{
+		{
+			"revert reason",
+			append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...),
+			false,
+			&DecodedError{
+				Error: "Error(string)",
+				Args:  []DecodedArgument{{Name: "reason", Type: "string", Value: "not allowed"}},
+			},
+		},
+		{
+			"panic",
+			append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], code...),
+			false,
+			&DecodedError{
+				Error: "Panic(uint256)",
+				Args:  []DecodedArgument{{Name: "code", Type: "uint256", Value: "17"}},
+			},
+		},
+		{
+			"custom error",
+			append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], custom...),
+			false,
+			&DecodedError{
+				Error: "InsufficientBalance(uint256,uint256)",
+				Args: []DecodedArgument{
+					{Name: "available", Type: "uint256", Value: "1"},
+					{Name: "required", Type: "uint256", Value: "2"},
+				},
+			},
+		},
+		{
+			"unknown selector",
+			[]byte{1, 2, 3, 4},
+			true,
+			nil,
+		},
+		{
+			"too short",
+			[]byte{1, 2},
+			true,
+			nil,
+		},
+		{
+			"invalid arguments",
+			crypto.Keccak256([]byte("Error(string)"))[:4],
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		decoded, err := DecodeError(contractABI, tc.data)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expected, decoded, tc.name)
+	}
+}
+
+func TestContractABIValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		contractABI ContractABI
+		expPass     bool
+	}{
+		{
+			"valid",
+			ContractABI{Address: testContractAddress.Hex(), ABI: testContractABI},
+			true,
+		},
+		{
+			"valid with code hash",
+			ContractABI{Address: testContractAddress.Hex(), CodeHash: common.Hash{1}.Hex(), ABI: testContractABI},
+			true,
+		},
+		{
+			"invalid address",
+			ContractABI{Address: "0x123", ABI: testContractABI},
+			false,
+		},
+		{
+			"invalid code hash",
+			ContractABI{Address: testContractAddress.Hex(), CodeHash: "0x01", ABI: testContractABI},
+			false,
+		},
+		{
+			"empty ABI",
+			ContractABI{Address: testContractAddress.Hex(), ABI: " "},
+			false,
+		},
+		{
+			"invalid ABI",
+			ContractABI{Address: testContractAddress.Hex(), ABI: "{"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.contractABI.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterContractABIValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(common.HexToAddress("0x0000000000000000000000000000000000000123").Bytes()).String()
+	contract := testContractAddress.Hex()
+
+	testCases := []struct {
+		name    string
+		msg     MsgRegisterContractABI
+		expPass bool
+	}{
+		{"valid CREATE", MsgRegisterContractABI{Sender: sender, Contract: contract, ABI: testContractABI, Nonce: 1}, true},
+		{"invalid sender", MsgRegisterContractABI{Sender: "evmos", Contract: contract, ABI: testContractABI}, false},
+		{"invalid contract", MsgRegisterContractABI{Sender: sender, Contract: "0x1", ABI: testContractABI}, false},
+		{"invalid ABI", MsgRegisterContractABI{Sender: sender, Contract: contract, ABI: "[{"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterContractABIDeploymentAddress(t *testing.T) {
+	deployer := common.HexToAddress("0x0000000000000000000000000000000000000123")
+	sender := sdk.AccAddress(deployer.Bytes()).String()
+
+	msg := MsgRegisterContractABI{Sender: sender, Nonce: 7}
+	require.Equal(t, crypto.CreateAddress(deployer, 7), msg.DeploymentAddress())
+}
+
+// abiArgs returns the unnamed arguments of the given elementary types.
+func abiArgs(elems ...string) abi.Arguments {
+	args := make(abi.Arguments, len(elems))
+	for i, t := range elems {
+		args[i] = abi.Argument{Type: mustNewABIType(t)}
+	}
+	return args
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	proto "github.com/gogo/protobuf/proto"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// gasCostPerContract is the gas consumed for each contract and selector
+// checked by a ContractAllowance.
+const gasCostPerContract = uint64(10)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*ContractAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*ContractAllowance)(nil)
+)
+
+// NewContractAllowance creates a fee allowance that only pays the fees of the
+// calls to the given contracts and methods.
+func NewContractAllowance(allowance feegrant.FeeAllowanceI, contracts, selectors []string) (*ContractAllowance, error) {
+	a := &ContractAllowance{
+		Contracts: contracts,
+		Selectors: selectors,
+	}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ContractAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the fee allowance that limits the fees paid.
+func (a *ContractAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+	return allowance, nil
+}
+
+// SetAllowance sets the fee allowance that limits the fees paid.
+func (a *ContractAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	var err error
+	a.Allowance, err = codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	return nil
+}
+
+// Accept implements FeeAllowanceI. It rejects the messages that aren't calls to
+// the allowed contracts and methods and delegates the fee limits to the
+// wrapped allowance.
+func (a *ContractAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	for _, msg := range msgs {
+		if err := a.checkMsg(ctx, msg); err != nil {
+			return false, errorsmod.Wrap(feegrant.ErrMessageNotAllowed, err.Error())
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// checkMsg returns an error if the message isn't an Ethereum transaction
+// calling one of the allowed contracts and methods.
+func (a *ContractAllowance) checkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	msgEthTx, ok := msg.(*MsgEthereumTx)
+	if !ok {
+		return fmt.Errorf("message %s is not an Ethereum transaction", sdk.MsgTypeURL(msg))
+	}
+
+	txData, err := UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return err
+	}
+
+	to := txData.GetTo()
+	if to == nil {
+		return fmt.Errorf("contract creations are not allowed")
+	}
+
+	if !a.isAllowedContract(ctx, *to) {
+		return fmt.Errorf("calls to contract %s are not allowed", to)
+	}
+
+	if len(a.Selectors) == 0 {
+		return nil
+	}
+
+	data := txData.GetData()
+	if len(data) < 4 || !a.isAllowedSelector(ctx, data[:4]) {
+		return fmt.Errorf("method of the call to contract %s is not allowed", to)
+	}
+	return nil
+}
+
+// isAllowedContract returns true if the calls to the given contract are sponsored.
+func (a *ContractAllowance) isAllowedContract(ctx sdk.Context, contract common.Address) bool {
+	for _, allowed := range a.Contracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerContract, "check contract")
+		if common.HexToAddress(allowed) == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// isAllowedSelector returns true if the calls to the method with the given
+// selector are sponsored.
+func (a *ContractAllowance) isAllowedSelector(ctx sdk.Context, selector []byte) bool {
+	for _, allowed := range a.Selectors {
+		ctx.GasMeter().ConsumeGas(gasCostPerContract, "check selector")
+		if bytes.Equal(common.FromHex(allowed), selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements FeeAllowanceI. The contracts must be set and the
+// selectors must be 4 bytes long.
+func (a *ContractAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+
+	if len(a.Contracts) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allowed contracts shouldn't be empty")
+	}
+
+	for _, contract := range a.Contracts {
+		if err := evmostypes.ValidateNonZeroAddress(contract); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract %s: %s", contract, err)
+		}
+	}
+
+	for _, selector := range a.Selectors {
+		if bz, err := hexutil.Decode(selector); err != nil || len(bz) != 4 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid selector %s", selector)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt implements FeeAllowanceI. It returns the expiry time of the
+// wrapped allowance.
+func (a *ContractAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestContractAllowanceAccept(t *testing.T) {
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000def")
+	selector := []byte{0xa9, 0x05, 0x9c, 0xbb}
+	fee := sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, 100))
+
+	newMsg := func(to *common.Address, input []byte) sdk.Msg {
+		return NewTx(&EvmTxArgs{
+			ChainID:  big.NewInt(1),
+			To:       to,
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+			Input:    input,
+		})
+	}
+
+	testCases := []struct {
+		name         string
+		selectors    []string
+		msgs         []sdk.Msg
+		expPass      bool
+		expRemaining int64
+	}{
+		{
+			"pass - allowed contract",
+			nil,
+			[]sdk.Msg{newMsg(&contract, nil)},
+			true,
+			900,
+		},
+		{
+			"pass - allowed contract and selector",
+			[]string{"0xa9059cbb"},
+			[]sdk.Msg{newMsg(&contract, append(selector, 0x01))},
+			true,
+			900,
+		},
+		{
+			"fail - contract not allowed",
+			nil,
+			[]sdk.Msg{newMsg(&other, nil)},
+			false,
+			1000,
+		},
+		{
+			"fail - selector not allowed",
+			[]string{"0x095ea7b3"},
+			[]sdk.Msg{newMsg(&contract, selector)},
+			false,
+			1000,
+		},
+		{
+			"fail - missing selector",
+			[]string{"0xa9059cbb"},
+			[]sdk.Msg{newMsg(&contract, nil)},
+			false,
+			1000,
+		},
+		{
+			"fail - contract creation",
+			nil,
+			[]sdk.Msg{newMsg(nil, nil)},
+			false,
+			1000,
+		},
+		{
+			"fail - not an Ethereum tx",
+			nil,
+			[]sdk.Msg{&banktypes.MsgSend{}},
+			false,
+			1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, 1000))}
+			allowance, err := NewContractAllowance(basic, []string{contract.Hex()}, tc.selectors)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			remove, err := allowance.Accept(ctx, fee, tc.msgs)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.False(t, remove)
+			} else {
+				require.Error(t, err)
+			}
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.expRemaining, inner.(*feegrant.BasicAllowance).SpendLimit.AmountOf(DefaultEVMDenom).Int64())
+		})
+	}
+}
+
+func TestContractAllowanceValidateBasic(t *testing.T) {
+	basic := &feegrant.BasicAllowance{}
+	contract := "0x0000000000000000000000000000000000000abc"
+
+	testCases := []struct {
+		name      string
+		contracts []string
+		selectors []string
+		expPass   bool
+	}{
+		{"pass", []string{contract}, []string{"0xa9059cbb"}, true},
+		{"fail - no contracts", nil, nil, false},
+		{"fail - invalid contract", []string{"contract"}, nil, false},
+		{"fail - zero address", []string{common.Address{}.Hex()}, nil, false},
+		{"fail - invalid selector", []string{contract}, []string{"0xa9059c"}, false},
+	}
+
+	for _, tc := range testCases {
+		allowance, err := NewContractAllowance(basic, tc.contracts, tc.selectors)
+		require.NoError(t, err)
+
+		err = allowance.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -42,9 +42,19 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrPrecompileNotRegistered
+	codeErrSenderDenied
+	codeErrCreateNotAllowed
+	codeErrCallNotAllowed
+	codeErrHookOutOfGas
+	codeErrInvalidDeployer
+	codeErrInvalidEventBridge
 )
 
-var ErrPostTxProcessing = errors.New("failed to execute post processing")
+var (
+	ErrPreTxProcessing  = errors.New("failed to execute pre processing")
+	ErrPostTxProcessing = errors.New("failed to execute post processing")
+)
 
 var (
 	// ErrInvalidState returns an error resulting from an invalid Storage State.
@@ -91,6 +101,27 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrPrecompileNotRegistered returns an error if an active precompiled contract is not registered on the keeper
+	ErrPrecompileNotRegistered = errorsmod.Register(ModuleName, codeErrPrecompileNotRegistered, "precompiled contract is not registered")
+
+	// ErrSenderDenied returns an error if the sender is denied by the Permissions parameter.
+	ErrSenderDenied = errorsmod.Register(ModuleName, codeErrSenderDenied, "sender is not allowed to send EVM transactions")
+
+	// ErrCreateNotAllowed returns an error if the deployer is not allowed by the Permissions parameter.
+	ErrCreateNotAllowed = errorsmod.Register(ModuleName, codeErrCreateNotAllowed, "contract deployment is not allowed")
+
+	// ErrCallNotAllowed returns an error if the contract call is not allowed by the Permissions parameter.
+	ErrCallNotAllowed = errorsmod.Register(ModuleName, codeErrCallNotAllowed, "contract call is not allowed")
+
+	// ErrHookOutOfGas returns an error if an EVM hook consumes more gas than its limit.
+	ErrHookOutOfGas = errorsmod.Register(ModuleName, codeErrHookOutOfGas, "EVM hook out of gas")
+
+	// ErrInvalidDeployer returns an error if the sender of a contract ABI registration isn't the deployer of the contract
+	ErrInvalidDeployer = errorsmod.Register(ModuleName, codeErrInvalidDeployer, "sender is not the deployer of the contract")
+
+	// ErrInvalidEventBridge returns an error if the event of an event bridge isn't defined in the ABI registered for its contract
+	ErrInvalidEventBridge = errorsmod.Register(ModuleName, codeErrInvalidEventBridge, "invalid event bridge")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
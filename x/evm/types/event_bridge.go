@@ -0,0 +1,171 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// validateEventBridges checks that the given event bridges are valid and that
+// each contract event is bridged only once.
+func validateEventBridges(bridges []EventBridge) error {
+	seen := make(map[string]bool, len(bridges))
+	for _, bridge := range bridges {
+		if err := bridge.Validate(); err != nil {
+			return fmt.Errorf("invalid event bridge %s %s: %w", bridge.Contract, bridge.Event, err)
+		}
+
+		key := common.HexToAddress(bridge.Contract).Hex() + bridge.Event
+		if seen[key] {
+			return fmt.Errorf("duplicated event bridge %s %s", bridge.Contract, bridge.Event)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the event bridge. The event is
+// decoded with the ABI registered for the contract, which is checked by the
+// keeper.
+func (b EventBridge) Validate() error {
+	if err := evmostypes.ValidateNonZeroAddress(b.Contract); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(b.Event) == "" {
+		return fmt.Errorf("empty event signature")
+	}
+
+	if strings.TrimSpace(b.EventType) == "" || strings.ContainsAny(b.EventType, " \t\n") {
+		return fmt.Errorf("invalid event type %q", b.EventType)
+	}
+	return nil
+}
+
+// ABIEvent returns the event of the contract ABI matching the event signature.
+func (b EventBridge) ABIEvent(contractABI abi.ABI) (abi.Event, error) {
+	for _, event := range contractABI.Events {
+		if event.Sig != b.Event {
+			continue
+		}
+		if event.Anonymous {
+			return abi.Event{}, fmt.Errorf("anonymous event %s can't be bridged", b.Event)
+		}
+		return event, nil
+	}
+	return abi.Event{}, fmt.Errorf("event %q not found in ABI", b.Event)
+}
+
+// Matches returns true if the log was emitted by the bridged contract event.
+func (b EventBridge) Matches(log *ethtypes.Log) bool {
+	return len(log.Topics) > 0 &&
+		log.Address == common.HexToAddress(b.Contract) &&
+		log.Topics[0] == crypto.Keccak256Hash([]byte(b.Event))
+}
+
+// DecodeLog returns the arguments of the log emitted by the event as event
+// attributes, in the order of the event inputs. The indexed arguments of dynamic
+// types are only stored as hashes in the log topics, so their attribute value is
+// the hex hash.
+func DecodeLog(event abi.Event, log *ethtypes.Log) ([]sdk.Attribute, error) {
+	values := make(map[string]interface{}, len(event.Inputs))
+	if err := event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+		return nil, fmt.Errorf("failed to unpack log data: %w", err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to parse log topics: %w", err)
+	}
+
+	attrs := make([]sdk.Attribute, len(event.Inputs))
+	for i, input := range event.Inputs {
+		value, err := formatABIValue(input.Type, values[input.Name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to format argument %s: %w", input.Name, err)
+		}
+		attrs[i] = sdk.NewAttribute(input.Name, value)
+	}
+	return attrs, nil
+}
+
+// formatABIValue formats an ABI-decoded value as an event attribute value.
+// Addresses, hashes and bytes are hex encoded, integers are formatted in base 10
+// and arrays and tuples are JSON encoded with their elements formatted the same
+// way.
+func formatABIValue(typ abi.Type, value interface{}) (string, error) {
+	// the indexed arguments of dynamic types are decoded as the hash of the value
+	if hash, ok := value.(common.Hash); ok {
+		return hash.Hex(), nil
+	}
+
+	formatted := abiValue(typ, reflect.ValueOf(value))
+	if str, ok := formatted.(string); ok {
+		return str, nil
+	}
+
+	bz, err := json.Marshal(formatted)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// abiValue converts an ABI-decoded value to a string, or to a slice or map of
+// converted values for arrays and tuples.
+func abiValue(typ abi.Type, value reflect.Value) interface{} {
+	switch typ.T {
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]interface{}, value.Len())
+		for i := range elems {
+			elems[i] = abiValue(*typ.Elem, value.Index(i))
+		}
+		return elems
+	case abi.TupleTy:
+		fields := make(map[string]interface{}, len(typ.TupleElems))
+		for i, elem := range typ.TupleElems {
+			fields[typ.TupleRawNames[i]] = abiValue(*elem, value.Field(i))
+		}
+		return fields
+	case abi.BytesTy, abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+		bz := make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(bz), value)
+		return hexutil.Encode(bz)
+	case abi.AddressTy:
+		return value.Interface().(common.Address).Hex()
+	case abi.IntTy, abi.UintTy:
+		return fmt.Sprintf("%d", value.Interface())
+	default:
+		return fmt.Sprintf("%v", value.Interface())
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEventSig = "Deposit(address,string,uint256,bytes32,uint8[],bool)"
+	testEventABI = `[{
+		"type": "event",
+		"name": "Deposit",
+		"inputs": [
+			{"name": "sender", "type": "address", "indexed": true},
+			{"name": "memo", "type": "string", "indexed": true},
+			{"name": "amount", "type": "uint256", "indexed": false},
+			{"name": "id", "type": "bytes32", "indexed": false},
+			{"name": "ids", "type": "uint8[]", "indexed": false},
+			{"name": "", "type": "bool", "indexed": false}
+		]
+	}]`
+)
+
+func TestEventBridgeDecodeLog(t *testing.T) {
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	sender := common.HexToAddress("0x0000000000000000000000000000000000000123")
+	memoHash := crypto.Keccak256Hash([]byte("memo"))
+	id := common.Hash{1}
+
+	bridge := EventBridge{
+		Contract:  contract.Hex(),
+		Event:     testEventSig,
+		EventType: "deposit",
+	}
+	require.NoError(t, bridge.Validate())
+
+	contractABI, err := ParseABI(testEventABI)
+	require.NoError(t, err)
+	event, err := bridge.ABIEvent(contractABI)
+	require.NoError(t, err)
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(1000), id, []uint8{1, 2}, true)
+	require.NoError(t, err)
+
+	log := &ethtypes.Log{
+		Address: contract,
+		Topics:  []common.Hash{crypto.Keccak256Hash([]byte(testEventSig)), common.BytesToHash(sender.Bytes()), memoHash},
+		Data:    data,
+	}
+	require.True(t, bridge.Matches(log))
+
+	attrs, err := DecodeLog(event, log)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Attribute{
+		sdk.NewAttribute("sender", sender.Hex()),
+		sdk.NewAttribute("memo", memoHash.Hex()),
+		sdk.NewAttribute("amount", "1000"),
+		sdk.NewAttribute("id", id.Hex()),
+		sdk.NewAttribute("ids", `["1","2"]`),
+		sdk.NewAttribute("arg5", "true"),
+	}, attrs)
+
+	otherContract := *log
+	otherContract.Address = sender
+	require.False(t, bridge.Matches(&otherContract))
+
+	otherEvent := *log
+	otherEvent.Topics = []common.Hash{crypto.Keccak256Hash([]byte("Deposit(address)"))}
+	require.False(t, bridge.Matches(&otherEvent))
+
+	malformed := *log
+	malformed.Data = data[:32]
+	_, err = DecodeLog(event, &malformed)
+	require.Error(t, err)
+
+	missing := bridge
+	missing.Event = "Deposit(address)"
+	_, err = missing.ABIEvent(contractABI)
+	require.Error(t, err)
+}
@@ -21,6 +21,10 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 
+	EventTypeEvmHookFailed = "evm_hook_failed"
+
+	EventTypeRegisterContractABI = "register_contract_abi"
+
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
@@ -33,6 +37,14 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeySender           = "sender"
+	AttributeKeyHook             = "hook"
+	AttributeKeyHookStage        = "stage"
+	AttributeKeyHookError        = "error"
+	AttributeKeyHookReverted     = "reverted"
+
+	AttributeValueHookStagePreTx  = "pre_tx_processing"
+	AttributeValueHookStagePostTx = "post_tx_processing"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -10,6 +10,7 @@ import (
 	math_bits "math/bits"
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -43,6 +44,22 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// active_precompiles defines the hex addresses of the stateful precompiled
+	// contracts that are active. The contracts must be registered on the EVM keeper.
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
+	// permissions defines the contract deployment and call policies and the
+	// senders that can't send EVM transactions.
+	Permissions Permissions `protobuf:"bytes,8,opt,name=permissions,proto3" json:"permissions"`
+	// event_bridges defines the contract events that are emitted as typed Cosmos
+	// events along with the EVM logs.
+	EventBridges []EventBridge `protobuf:"bytes,9,rep,name=event_bridges,json=eventBridges,proto3" json:"event_bridges" yaml:"event_bridges"`
+	// fee_denoms defines the denominations, other than the evm_denom, accepted
+	// to pay the fees of the Ethereum transactions. A transaction pays its fees
+	// in a fee denom only if it selects it in its access list.
+	FeeDenoms []FeeDenom `protobuf:"bytes,10,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// evm_hooks overrides the execution policies of the EVM hooks registered by
+	// the application.
+	EvmHooks []EvmHookParams `protobuf:"bytes,11,rep,name=evm_hooks,json=evmHooks,proto3" json:"evm_hooks" yaml:"evm_hooks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -125,64 +142,68 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
-// ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
-// instead of *big.Int.
-type ChainConfig struct {
-	// homestead_block switch (nil no fork, 0 = already homestead)
-	HomesteadBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=homestead_block,json=homesteadBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"homestead_block,omitempty" yaml:"homestead_block"`
-	// dao_fork_block corresponds to TheDAO hard-fork switch block (nil no fork)
-	DAOForkBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=dao_fork_block,json=daoForkBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dao_fork_block,omitempty" yaml:"dao_fork_block"`
-	// dao_fork_support defines whether the nodes supports or opposes the DAO hard-fork
-	DAOForkSupport bool `protobuf:"varint,3,opt,name=dao_fork_support,json=daoForkSupport,proto3" json:"dao_fork_support,omitempty" yaml:"dao_fork_support"`
-	// eip150_block: EIP150 implements the Gas price changes
-	// (https://github.com/ethereum/EIPs/issues/150) EIP150 HF block (nil no fork)
-	EIP150Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=eip150_block,json=eip150Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip150_block,omitempty" yaml:"eip150_block"`
-	// eip150_hash: EIP150 HF hash (needed for header only clients as only gas pricing changed)
-	EIP150Hash string `protobuf:"bytes,5,opt,name=eip150_hash,json=eip150Hash,proto3" json:"eip150_hash,omitempty" yaml:"byzantium_block"`
-	// eip155_block: EIP155Block HF block
-	EIP155Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=eip155_block,json=eip155Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip155_block,omitempty" yaml:"eip155_block"`
-	// eip158_block: EIP158 HF block
-	EIP158Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=eip158_block,json=eip158Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip158_block,omitempty" yaml:"eip158_block"`
-	// byzantium_block: Byzantium switch block (nil no fork, 0 = already on byzantium)
-	ByzantiumBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=byzantium_block,json=byzantiumBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"byzantium_block,omitempty" yaml:"byzantium_block"`
-	// constantinople_block: Constantinople switch block (nil no fork, 0 = already activated)
-	ConstantinopleBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=constantinople_block,json=constantinopleBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"constantinople_block,omitempty" yaml:"constantinople_block"`
-	// petersburg_block: Petersburg switch block (nil same as Constantinople)
-	PetersburgBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=petersburg_block,json=petersburgBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"petersburg_block,omitempty" yaml:"petersburg_block"`
-	// istanbul_block: Istanbul switch block (nil no fork, 0 = already on istanbul)
-	IstanbulBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=istanbul_block,json=istanbulBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"istanbul_block,omitempty" yaml:"istanbul_block"`
-	// muir_glacier_block: Eip-2384 (bomb delay) switch block (nil no fork, 0 = already activated)
-	MuirGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=muir_glacier_block,json=muirGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"muir_glacier_block,omitempty" yaml:"muir_glacier_block"`
-	// berlin_block: Berlin switch block (nil = no fork, 0 = already on berlin)
-	BerlinBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=berlin_block,json=berlinBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"berlin_block,omitempty" yaml:"berlin_block"`
-	// london_block: London switch block (nil = no fork, 0 = already on london)
-	LondonBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=london_block,json=londonBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"london_block,omitempty" yaml:"london_block"`
-	// arrow_glacier_block: Eip-4345 (bomb delay) switch block (nil = no fork, 0 = already activated)
-	ArrowGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=arrow_glacier_block,json=arrowGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"arrow_glacier_block,omitempty" yaml:"arrow_glacier_block"`
-	// gray_glacier_block: EIP-5133 (bomb delay) switch block (nil = no fork, 0 = already activated)
-	GrayGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=gray_glacier_block,json=grayGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gray_glacier_block,omitempty" yaml:"gray_glacier_block"`
-	// merge_netsplit_block: Virtual fork after The Merge to use as a network splitter
-	MergeNetsplitBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=merge_netsplit_block,json=mergeNetsplitBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"merge_netsplit_block,omitempty" yaml:"merge_netsplit_block"`
-	// shanghai_block switch block (nil = no fork, 0 = already on shanghai)
-	ShanghaiBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
-	// cancun_block switch block (nil = no fork, 0 = already on cancun)
-	CancunBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
 }
 
-func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
-func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
-func (*ChainConfig) ProtoMessage()    {}
-func (*ChainConfig) Descriptor() ([]byte, []int) {
+func (m *Params) GetPermissions() Permissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return Permissions{}
+}
+
+func (m *Params) GetEventBridges() []EventBridge {
+	if m != nil {
+		return m.EventBridges
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetEvmHooks() []EvmHookParams {
+	if m != nil {
+		return m.EvmHooks
+	}
+	return nil
+}
+
+// EvmHookParams defines the execution policy of an EVM hook run on a cached
+// context by the EVM module.
+type EvmHookParams struct {
+	// name is the name of the hook, i.e. the name of the module that registers it
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// gas_limit is the maximum amount of gas the hook can consume on each call,
+	// zero means unlimited
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// revert_on_error reverts the transaction when the hook fails. Otherwise the
+	// state changes of the hook are discarded and the transaction continues.
+	RevertOnError bool `protobuf:"varint,3,opt,name=revert_on_error,json=revertOnError,proto3" json:"revert_on_error,omitempty" yaml:"revert_on_error"`
+}
+
+func (m *EvmHookParams) Reset()         { *m = EvmHookParams{} }
+func (m *EvmHookParams) String() string { return proto.CompactTextString(m) }
+func (*EvmHookParams) ProtoMessage()    {}
+func (*EvmHookParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{1}
 }
 
-func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
+func (m *EvmHookParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *ChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EvmHookParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChainConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EvmHookParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -193,56 +214,73 @@ func (m *ChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 }
 
-func (m *ChainConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainConfig.Merge(m, src)
+func (m *EvmHookParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmHookParams.Merge(m, src)
 }
 
-func (m *ChainConfig) XXX_Size() int {
+func (m *EvmHookParams) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *ChainConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainConfig.DiscardUnknown(m)
+func (m *EvmHookParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmHookParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChainConfig proto.InternalMessageInfo
+var xxx_messageInfo_EvmHookParams proto.InternalMessageInfo
 
-func (m *ChainConfig) GetDAOForkSupport() bool {
+func (m *EvmHookParams) GetName() string {
 	if m != nil {
-		return m.DAOForkSupport
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-func (m *ChainConfig) GetEIP150Hash() string {
+func (m *EvmHookParams) GetGasLimit() uint64 {
 	if m != nil {
-		return m.EIP150Hash
+		return m.GasLimit
 	}
-	return ""
+	return 0
 }
 
-// State represents a single Storage key value pair item.
-type State struct {
-	// key is the stored key
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// value is the stored value for the given key
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *EvmHookParams) GetRevertOnError() bool {
+	if m != nil {
+		return m.RevertOnError
+	}
+	return false
 }
 
-func (m *State) Reset()         { *m = State{} }
-func (m *State) String() string { return proto.CompactTextString(m) }
-func (*State) ProtoMessage()    {}
-func (*State) Descriptor() ([]byte, []int) {
+// FeeDenom defines a denomination accepted to pay the fees of the Ethereum
+// transactions and the source of its exchange rate to the evm_denom.
+type FeeDenom struct {
+	// denom is the denomination of the Cosmos coin. For the ERC-20 token pairs,
+	// the ERC-20 tokens of the sender are converted to the coin if its balance
+	// doesn't cover the fees and the transaction allows the conversion.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the governance-set amount of evm_denom paid by one unit of denom.
+	// It must be set if the price_oracle is empty.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// price_oracle is the hex address of a TWAP oracle contract of the pool of
+	// the denom and the evm_denom. It must implement the
+	// consult(address token, uint256 amountIn) returns (uint256 amountOut)
+	// method, which is called with the ERC-20 contract of the denom token pair
+	// at the beginning of each block.
+	PriceOracle string `protobuf:"bytes,3,opt,name=price_oracle,json=priceOracle,proto3" json:"price_oracle,omitempty" yaml:"price_oracle"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 
-func (m *State) XXX_Unmarshal(b []byte) error {
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -253,58 +291,58 @@ func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *State) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_State.Merge(m, src)
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
 }
 
-func (m *State) XXX_Size() int {
+func (m *FeeDenom) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *State) XXX_DiscardUnknown() {
-	xxx_messageInfo_State.DiscardUnknown(m)
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_State proto.InternalMessageInfo
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
 
-func (m *State) GetKey() string {
+func (m *FeeDenom) GetDenom() string {
 	if m != nil {
-		return m.Key
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *State) GetValue() string {
+func (m *FeeDenom) GetPriceOracle() string {
 	if m != nil {
-		return m.Value
+		return m.PriceOracle
 	}
 	return ""
 }
 
-// TransactionLogs define the logs generated from a transaction execution
-// with a given hash. It it used for import/export data as transactions are not
-// persisted on blockchain state after an upgrade.
-type TransactionLogs struct {
-	// hash of the transaction
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// logs is an array of Logs for the given transaction hash
-	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+// FeePayment defines the fees of an Ethereum transaction paid by an account
+// other than the sender or in a denomination other than the evm_denom. The
+// leftover gas is refunded to the payer in proportion to the fees paid.
+type FeePayment struct {
+	// payer is the hex address of the account that paid the fees
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// fee is the amount paid
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
-func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
-func (*TransactionLogs) ProtoMessage()    {}
-func (*TransactionLogs) Descriptor() ([]byte, []int) {
+func (m *FeePayment) Reset()         { *m = FeePayment{} }
+func (m *FeePayment) String() string { return proto.CompactTextString(m) }
+func (*FeePayment) ProtoMessage()    {}
+func (*FeePayment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 
-func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
+func (m *FeePayment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *TransactionLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransactionLogs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeePayment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -315,77 +353,61 @@ func (m *TransactionLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 	}
 }
 
-func (m *TransactionLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionLogs.Merge(m, src)
+func (m *FeePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayment.Merge(m, src)
 }
 
-func (m *TransactionLogs) XXX_Size() int {
+func (m *FeePayment) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *TransactionLogs) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionLogs.DiscardUnknown(m)
+func (m *FeePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransactionLogs proto.InternalMessageInfo
+var xxx_messageInfo_FeePayment proto.InternalMessageInfo
 
-func (m *TransactionLogs) GetHash() string {
+func (m *FeePayment) GetPayer() string {
 	if m != nil {
-		return m.Hash
+		return m.Payer
 	}
 	return ""
 }
 
-func (m *TransactionLogs) GetLogs() []*Log {
+func (m *FeePayment) GetFee() types.Coin {
 	if m != nil {
-		return m.Logs
+		return m.Fee
 	}
-	return nil
+	return types.Coin{}
 }
 
-// Log represents an protobuf compatible Ethereum Log that defines a contract
-// log event. These events are generated by the LOG opcode and stored/indexed by
-// the node.
-//
-// NOTE: address, topics and data are consensus fields. The rest of the fields
-// are derived, i.e. filled in by the nodes, but not secured by consensus.
-type Log struct {
-	// address of the contract that generated the event
+// ContractABI defines the ABI and metadata registered for a contract.
+type ContractABI struct {
+	// address is the hex address of the contract
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// topics is a list of topics provided by the contract.
-	Topics []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
-	// data which is supplied by the contract, usually ABI-encoded
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	// block_number of the block in which the transaction was included
-	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
-	// tx_hash is the transaction hash
-	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"transactionHash"`
-	// tx_index of the transaction in the block
-	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"transactionIndex"`
-	// block_hash of the block in which the transaction was included
-	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
-	// index of the log in the block
-	Index uint64 `protobuf:"varint,8,opt,name=index,proto3" json:"logIndex"`
-	// removed is true if this log was reverted due to a chain
-	// reorganisation. You must pay attention to this field if you receive logs
-	// through a filter query.
-	Removed bool `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
-}
-
-func (m *Log) Reset()         { *m = Log{} }
-func (m *Log) String() string { return proto.CompactTextString(m) }
-func (*Log) ProtoMessage()    {}
-func (*Log) Descriptor() ([]byte, []int) {
+	// code_hash is the hex hash of the contract code when the ABI was registered
+	CodeHash string `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty" yaml:"code_hash"`
+	// abi is the JSON ABI of the contract
+	ABI string `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	// metadata is an arbitrary description of the contract, e.g. the Solidity
+	// metadata JSON or the source repository
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *ContractABI) Reset()         { *m = ContractABI{} }
+func (m *ContractABI) String() string { return proto.CompactTextString(m) }
+func (*ContractABI) ProtoMessage()    {}
+func (*ContractABI) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 
-func (m *Log) XXX_Unmarshal(b []byte) error {
+func (m *ContractABI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractABI.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -396,116 +418,75 @@ func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *Log) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Log.Merge(m, src)
+func (m *ContractABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractABI.Merge(m, src)
 }
 
-func (m *Log) XXX_Size() int {
+func (m *ContractABI) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *Log) XXX_DiscardUnknown() {
-	xxx_messageInfo_Log.DiscardUnknown(m)
+func (m *ContractABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractABI.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Log proto.InternalMessageInfo
+var xxx_messageInfo_ContractABI proto.InternalMessageInfo
 
-func (m *Log) GetAddress() string {
+func (m *ContractABI) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *Log) GetTopics() []string {
-	if m != nil {
-		return m.Topics
-	}
-	return nil
-}
-
-func (m *Log) GetData() []byte {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
-
-func (m *Log) GetBlockNumber() uint64 {
-	if m != nil {
-		return m.BlockNumber
-	}
-	return 0
-}
-
-func (m *Log) GetTxHash() string {
+func (m *ContractABI) GetCodeHash() string {
 	if m != nil {
-		return m.TxHash
+		return m.CodeHash
 	}
 	return ""
 }
 
-func (m *Log) GetTxIndex() uint64 {
-	if m != nil {
-		return m.TxIndex
-	}
-	return 0
-}
-
-func (m *Log) GetBlockHash() string {
+func (m *ContractABI) GetABI() string {
 	if m != nil {
-		return m.BlockHash
+		return m.ABI
 	}
 	return ""
 }
 
-func (m *Log) GetIndex() uint64 {
-	if m != nil {
-		return m.Index
-	}
-	return 0
-}
-
-func (m *Log) GetRemoved() bool {
+func (m *ContractABI) GetMetadata() string {
 	if m != nil {
-		return m.Removed
+		return m.Metadata
 	}
-	return false
+	return ""
 }
 
-// TxResult stores results of Tx execution.
-type TxResult struct {
-	// contract_address contains the ethereum address of the created contract (if
-	// any). If the state transition is an evm.Call, the contract address will be
-	// empty.
-	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
-	// bloom represents the bloom filter bytes
-	Bloom []byte `protobuf:"bytes,2,opt,name=bloom,proto3" json:"bloom,omitempty"`
-	// tx_logs contains the transaction hash and the proto-compatible ethereum
-	// logs.
-	TxLogs TransactionLogs `protobuf:"bytes,3,opt,name=tx_logs,json=txLogs,proto3" json:"tx_logs" yaml:"tx_logs"`
-	// ret defines the bytes from the execution.
-	Ret []byte `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
-	// reverted flag is set to true when the call has been reverted
-	Reverted bool `protobuf:"varint,5,opt,name=reverted,proto3" json:"reverted,omitempty"`
-	// gas_used notes the amount of gas consumed while execution
-	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+// EventBridge defines a contract event that is emitted as a typed Cosmos event
+// with the ABI-decoded arguments of the log as attributes. The logs are decoded
+// with the ABI registered for the contract.
+type EventBridge struct {
+	// contract is the hex address of the contract emitting the event
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// event is the canonical signature of the event, e.g.
+	// "Deposit(address,uint256)"
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// event_type is the type of the emitted Cosmos event
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty" yaml:"event_type"`
 }
 
-func (m *TxResult) Reset()         { *m = TxResult{} }
-func (m *TxResult) String() string { return proto.CompactTextString(m) }
-func (*TxResult) ProtoMessage()    {}
-func (*TxResult) Descriptor() ([]byte, []int) {
+func (m *EventBridge) Reset()         { *m = EventBridge{} }
+func (m *EventBridge) String() string { return proto.CompactTextString(m) }
+func (*EventBridge) ProtoMessage()    {}
+func (*EventBridge) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 
-func (m *TxResult) XXX_Unmarshal(b []byte) error {
+func (m *EventBridge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *TxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventBridge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -516,42 +497,65 @@ func (m *TxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *TxResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxResult.Merge(m, src)
+func (m *EventBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridge.Merge(m, src)
 }
 
-func (m *TxResult) XXX_Size() int {
+func (m *EventBridge) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *TxResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxResult.DiscardUnknown(m)
+func (m *EventBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxResult proto.InternalMessageInfo
+var xxx_messageInfo_EventBridge proto.InternalMessageInfo
 
-// AccessTuple is the element type of an access list.
-type AccessTuple struct {
-	// address is a hex formatted ethereum address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// storage_keys are hex formatted hashes of the storage keys
-	StorageKeys []string `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storageKeys"`
+func (m *EventBridge) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
 }
 
-func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
-func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
-func (*AccessTuple) ProtoMessage()    {}
-func (*AccessTuple) Descriptor() ([]byte, []int) {
+func (m *EventBridge) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *EventBridge) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+// Permissions defines the governance-managed access policies of the EVM.
+type Permissions struct {
+	// create defines the contract deployment policy
+	Create CreatePolicy `protobuf:"bytes,1,opt,name=create,proto3" json:"create"`
+	// call defines the contract call policy
+	Call CallPolicy `protobuf:"bytes,2,opt,name=call,proto3" json:"call"`
+	// denied_senders defines the hex addresses that can't send EVM transactions
+	DeniedSenders []string `protobuf:"bytes,3,rep,name=denied_senders,json=deniedSenders,proto3" json:"denied_senders,omitempty" yaml:"denied_senders"`
+}
+
+func (m *Permissions) Reset()         { *m = Permissions{} }
+func (m *Permissions) String() string { return proto.CompactTextString(m) }
+func (*Permissions) ProtoMessage()    {}
+func (*Permissions) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 
-func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
+func (m *Permissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *AccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccessTuple.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Permissions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,61 +566,66 @@ func (m *AccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 }
 
-func (m *AccessTuple) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccessTuple.Merge(m, src)
+func (m *Permissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permissions.Merge(m, src)
 }
 
-func (m *AccessTuple) XXX_Size() int {
+func (m *Permissions) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *AccessTuple) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccessTuple.DiscardUnknown(m)
+func (m *Permissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permissions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
+var xxx_messageInfo_Permissions proto.InternalMessageInfo
 
-// TraceConfig holds extra parameters to trace functions.
-type TraceConfig struct {
-	// tracer is a custom javascript tracer
-	Tracer string `protobuf:"bytes,1,opt,name=tracer,proto3" json:"tracer,omitempty"`
-	// timeout overrides the default timeout of 5 seconds for JavaScript-based tracing
-	// calls
-	Timeout string `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// reexec defines the number of blocks the tracer is willing to go back
-	Reexec uint64 `protobuf:"varint,3,opt,name=reexec,proto3" json:"reexec,omitempty"`
-	// disable_stack switches stack capture
-	DisableStack bool `protobuf:"varint,5,opt,name=disable_stack,json=disableStack,proto3" json:"disableStack"`
-	// disable_storage switches storage capture
-	DisableStorage bool `protobuf:"varint,6,opt,name=disable_storage,json=disableStorage,proto3" json:"disableStorage"`
-	// debug can be used to print output during capture end
-	Debug bool `protobuf:"varint,8,opt,name=debug,proto3" json:"debug,omitempty"`
-	// limit defines the maximum length of output, but zero means unlimited
-	Limit int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
-	// overrides can be used to execute a trace using future fork rules
-	Overrides *ChainConfig `protobuf:"bytes,10,opt,name=overrides,proto3" json:"overrides,omitempty"`
-	// enable_memory switches memory capture
-	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
-	// enable_return_data switches the capture of return data
-	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
-	// tracer_json_config configures the tracer using a JSON string
-	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+func (m *Permissions) GetCreate() CreatePolicy {
+	if m != nil {
+		return m.Create
+	}
+	return CreatePolicy{}
 }
 
-func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
-func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
-func (*TraceConfig) ProtoMessage()    {}
-func (*TraceConfig) Descriptor() ([]byte, []int) {
+func (m *Permissions) GetCall() CallPolicy {
+	if m != nil {
+		return m.Call
+	}
+	return CallPolicy{}
+}
+
+func (m *Permissions) GetDeniedSenders() []string {
+	if m != nil {
+		return m.DeniedSenders
+	}
+	return nil
+}
+
+// CreatePolicy defines the accounts that can deploy contracts, including the
+// contracts that deploy other contracts through CREATE and CREATE2.
+type CreatePolicy struct {
+	// permissioned restricts the deployments to the allowed deployers. Anyone can
+	// deploy contracts if it's false.
+	Permissioned bool `protobuf:"varint,1,opt,name=permissioned,proto3" json:"permissioned,omitempty"`
+	// allowed_deployers defines the accounts that can deploy contracts if the
+	// policy is permissioned
+	AllowedDeployers []AllowedDeployer `protobuf:"bytes,2,rep,name=allowed_deployers,json=allowedDeployers,proto3" json:"allowed_deployers" yaml:"allowed_deployers"`
+}
+
+func (m *CreatePolicy) Reset()         { *m = CreatePolicy{} }
+func (m *CreatePolicy) String() string { return proto.CompactTextString(m) }
+func (*CreatePolicy) ProtoMessage()    {}
+func (*CreatePolicy) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 
-func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
+func (m *CreatePolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *TraceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TraceConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreatePolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -627,835 +636,938 @@ func (m *TraceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 }
 
-func (m *TraceConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TraceConfig.Merge(m, src)
+func (m *CreatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePolicy.Merge(m, src)
 }
 
-func (m *TraceConfig) XXX_Size() int {
+func (m *CreatePolicy) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *TraceConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_TraceConfig.DiscardUnknown(m)
+func (m *CreatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TraceConfig proto.InternalMessageInfo
+var xxx_messageInfo_CreatePolicy proto.InternalMessageInfo
 
-func (m *TraceConfig) GetTracer() string {
+func (m *CreatePolicy) GetPermissioned() bool {
 	if m != nil {
-		return m.Tracer
+		return m.Permissioned
 	}
-	return ""
+	return false
 }
 
-func (m *TraceConfig) GetTimeout() string {
+func (m *CreatePolicy) GetAllowedDeployers() []AllowedDeployer {
 	if m != nil {
-		return m.Timeout
+		return m.AllowedDeployers
 	}
-	return ""
+	return nil
 }
 
-func (m *TraceConfig) GetReexec() uint64 {
-	if m != nil {
-		return m.Reexec
-	}
-	return 0
+// AllowedDeployer defines an account that can deploy contracts.
+type AllowedDeployer struct {
+	// address is the hex address of the deployer
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// code_hashes defines the hex keccak256 hashes of the init code that the
+	// deployer can deploy. Any contract can be deployed if it's empty. A contract
+	// deployer can't deploy through CREATE and CREATE2 if it's not empty, as the
+	// init code of these deployments isn't checked.
+	CodeHashes []string `protobuf:"bytes,2,rep,name=code_hashes,json=codeHashes,proto3" json:"code_hashes,omitempty" yaml:"code_hashes"`
 }
 
-func (m *TraceConfig) GetDisableStack() bool {
-	if m != nil {
-		return m.DisableStack
-	}
-	return false
+func (m *AllowedDeployer) Reset()         { *m = AllowedDeployer{} }
+func (m *AllowedDeployer) String() string { return proto.CompactTextString(m) }
+func (*AllowedDeployer) ProtoMessage()    {}
+func (*AllowedDeployer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 
-func (m *TraceConfig) GetDisableStorage() bool {
-	if m != nil {
-		return m.DisableStorage
-	}
-	return false
+func (m *AllowedDeployer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *TraceConfig) GetDebug() bool {
-	if m != nil {
-		return m.Debug
+func (m *AllowedDeployer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedDeployer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
 }
 
-func (m *TraceConfig) GetLimit() int32 {
+func (m *AllowedDeployer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedDeployer.Merge(m, src)
+}
+
+func (m *AllowedDeployer) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AllowedDeployer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedDeployer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedDeployer proto.InternalMessageInfo
+
+func (m *AllowedDeployer) GetAddress() string {
 	if m != nil {
-		return m.Limit
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *TraceConfig) GetOverrides() *ChainConfig {
+func (m *AllowedDeployer) GetCodeHashes() []string {
 	if m != nil {
-		return m.Overrides
+		return m.CodeHashes
 	}
 	return nil
 }
 
-func (m *TraceConfig) GetEnableMemory() bool {
+// CallPolicy defines the senders that can call contracts.
+type CallPolicy struct {
+	// permissioned restricts the contract calls to the allowed senders, except
+	// for the public contracts. Anyone can call any contract if it's false.
+	Permissioned bool `protobuf:"varint,1,opt,name=permissioned,proto3" json:"permissioned,omitempty"`
+	// allowed_senders defines the hex addresses that can call any contract if
+	// the policy is permissioned
+	AllowedSenders []string `protobuf:"bytes,2,rep,name=allowed_senders,json=allowedSenders,proto3" json:"allowed_senders,omitempty" yaml:"allowed_senders"`
+	// public_contracts defines the hex addresses of the contracts that anyone can
+	// call if the policy is permissioned
+	PublicContracts []string `protobuf:"bytes,3,rep,name=public_contracts,json=publicContracts,proto3" json:"public_contracts,omitempty" yaml:"public_contracts"`
+}
+
+func (m *CallPolicy) Reset()         { *m = CallPolicy{} }
+func (m *CallPolicy) String() string { return proto.CompactTextString(m) }
+func (*CallPolicy) ProtoMessage()    {}
+func (*CallPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+}
+
+func (m *CallPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CallPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CallPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallPolicy.Merge(m, src)
+}
+
+func (m *CallPolicy) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CallPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallPolicy proto.InternalMessageInfo
+
+func (m *CallPolicy) GetPermissioned() bool {
 	if m != nil {
-		return m.EnableMemory
+		return m.Permissioned
 	}
 	return false
 }
 
-func (m *TraceConfig) GetEnableReturnData() bool {
+func (m *CallPolicy) GetAllowedSenders() []string {
 	if m != nil {
-		return m.EnableReturnData
+		return m.AllowedSenders
 	}
-	return false
+	return nil
 }
 
-func (m *TraceConfig) GetTracerJsonConfig() string {
+func (m *CallPolicy) GetPublicContracts() []string {
 	if m != nil {
-		return m.TracerJsonConfig
+		return m.PublicContracts
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
-	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
-	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
-	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
-	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
-	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
-	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
-	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+// ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
+// instead of *big.Int.
+type ChainConfig struct {
+	// homestead_block switch (nil no fork, 0 = already homestead)
+	HomesteadBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=homestead_block,json=homesteadBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"homestead_block,omitempty" yaml:"homestead_block"`
+	// dao_fork_block corresponds to TheDAO hard-fork switch block (nil no fork)
+	DAOForkBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=dao_fork_block,json=daoForkBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dao_fork_block,omitempty" yaml:"dao_fork_block"`
+	// dao_fork_support defines whether the nodes supports or opposes the DAO hard-fork
+	DAOForkSupport bool `protobuf:"varint,3,opt,name=dao_fork_support,json=daoForkSupport,proto3" json:"dao_fork_support,omitempty" yaml:"dao_fork_support"`
+	// eip150_block: EIP150 implements the Gas price changes
+	// (https://github.com/ethereum/EIPs/issues/150) EIP150 HF block (nil no fork)
+	EIP150Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=eip150_block,json=eip150Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip150_block,omitempty" yaml:"eip150_block"`
+	// eip150_hash: EIP150 HF hash (needed for header only clients as only gas pricing changed)
+	EIP150Hash string `protobuf:"bytes,5,opt,name=eip150_hash,json=eip150Hash,proto3" json:"eip150_hash,omitempty" yaml:"byzantium_block"`
+	// eip155_block: EIP155Block HF block
+	EIP155Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=eip155_block,json=eip155Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip155_block,omitempty" yaml:"eip155_block"`
+	// eip158_block: EIP158 HF block
+	EIP158Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=eip158_block,json=eip158Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip158_block,omitempty" yaml:"eip158_block"`
+	// byzantium_block: Byzantium switch block (nil no fork, 0 = already on byzantium)
+	ByzantiumBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=byzantium_block,json=byzantiumBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"byzantium_block,omitempty" yaml:"byzantium_block"`
+	// constantinople_block: Constantinople switch block (nil no fork, 0 = already activated)
+	ConstantinopleBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=constantinople_block,json=constantinopleBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"constantinople_block,omitempty" yaml:"constantinople_block"`
+	// petersburg_block: Petersburg switch block (nil same as Constantinople)
+	PetersburgBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=petersburg_block,json=petersburgBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"petersburg_block,omitempty" yaml:"petersburg_block"`
+	// istanbul_block: Istanbul switch block (nil no fork, 0 = already on istanbul)
+	IstanbulBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=istanbul_block,json=istanbulBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"istanbul_block,omitempty" yaml:"istanbul_block"`
+	// muir_glacier_block: Eip-2384 (bomb delay) switch block (nil no fork, 0 = already activated)
+	MuirGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=muir_glacier_block,json=muirGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"muir_glacier_block,omitempty" yaml:"muir_glacier_block"`
+	// berlin_block: Berlin switch block (nil = no fork, 0 = already on berlin)
+	BerlinBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=berlin_block,json=berlinBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"berlin_block,omitempty" yaml:"berlin_block"`
+	// london_block: London switch block (nil = no fork, 0 = already on london)
+	LondonBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=london_block,json=londonBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"london_block,omitempty" yaml:"london_block"`
+	// arrow_glacier_block: Eip-4345 (bomb delay) switch block (nil = no fork, 0 = already activated)
+	ArrowGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=arrow_glacier_block,json=arrowGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"arrow_glacier_block,omitempty" yaml:"arrow_glacier_block"`
+	// gray_glacier_block: EIP-5133 (bomb delay) switch block (nil = no fork, 0 = already activated)
+	GrayGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=gray_glacier_block,json=grayGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gray_glacier_block,omitempty" yaml:"gray_glacier_block"`
+	// merge_netsplit_block: Virtual fork after The Merge to use as a network splitter
+	MergeNetsplitBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=merge_netsplit_block,json=mergeNetsplitBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"merge_netsplit_block,omitempty" yaml:"merge_netsplit_block"`
+	// shanghai_block switch block (nil = no fork, 0 = already on shanghai)
+	ShanghaiBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
+	// cancun_block switch block (nil = no fork, 0 = already on cancun)
+	CancunBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// prev_randao_block switch block of the PREVRANDAO opcode, which returns the randomness of the
+	// block derived from its header hash (nil = no fork, 0 = enabled from genesis). It must not be
+	// before the London block.
+	PrevRandaoBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,24,opt,name=prev_randao_block,json=prevRandaoBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"prev_randao_block,omitempty" yaml:"prev_randao_block"`
 }
 
-func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
+func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
+func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
+func (*ChainConfig) ProtoMessage()    {}
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
+}
 
-var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xb3,
-	0x9e, 0xef, 0x9c, 0x33, 0xdf, 0x37, 0x73, 0xe6, 0x8c, 0xe6, 0x70, 0xc1, 0x73, 0x22, 0x7a, 0x84,
-	0xa5, 0x71, 0x26, 0x8e, 0xc8, 0x20, 0x3d, 0x1a, 0x1c, 0xcb, 0x3f, 0x87, 0x7d, 0x46, 0x05, 0x85,
-	0x4e, 0xe9, 0x3b, 0x94, 0xc6, 0xc1, 0xf1, 0xf3, 0x56, 0x44, 0x23, 0xaa, 0x9c, 0x47, 0x72, 0xa4,
-	0xe3, 0xbc, 0xbf, 0x54, 0xc0, 0xc6, 0x15, 0x66, 0x38, 0xe5, 0xf0, 0x18, 0x54, 0xc9, 0x20, 0xf5,
-	0x43, 0x92, 0xd1, 0xb4, 0xbd, 0xba, 0xbf, 0x7a, 0x50, 0xed, 0xb4, 0xc6, 0x85, 0xeb, 0x8c, 0x70,
-	0x9a, 0x7c, 0xe6, 0x95, 0x2e, 0x0f, 0xd9, 0x64, 0x90, 0xbe, 0x96, 0x43, 0xf8, 0x73, 0xb0, 0x45,
-	0x32, 0xdc, 0x4d, 0x88, 0x1f, 0x30, 0x82, 0x05, 0x69, 0xaf, 0xed, 0xaf, 0x1e, 0xd8, 0x9d, 0xf6,
-	0xb8, 0x70, 0x5b, 0x66, 0xda, 0xac, 0xdb, 0x43, 0x75, 0x8d, 0x5f, 0x29, 0x08, 0x7f, 0x06, 0x6a,
-	0x13, 0x3f, 0x4e, 0x92, 0x76, 0x45, 0x4d, 0xde, 0x1d, 0x17, 0x2e, 0x9c, 0x9f, 0x8c, 0x93, 0xc4,
-	0x43, 0xc0, 0x4c, 0xc5, 0x49, 0x02, 0xcf, 0x00, 0x20, 0x43, 0xc1, 0xb0, 0x4f, 0xe2, 0x3e, 0x6f,
-	0x5b, 0xfb, 0x95, 0x83, 0x4a, 0xc7, 0xbb, 0x2b, 0xdc, 0xea, 0xb9, 0xb4, 0x9e, 0x5f, 0x5c, 0xf1,
-	0x71, 0xe1, 0x6e, 0x1b, 0x92, 0x32, 0xd0, 0x43, 0x55, 0x05, 0xce, 0xe3, 0x3e, 0x87, 0xbf, 0x07,
-	0xf5, 0xa0, 0x87, 0xe3, 0xcc, 0x0f, 0x68, 0xf6, 0x6d, 0x1c, 0xb5, 0xd7, 0xf7, 0x57, 0x0f, 0x6a,
-	0x27, 0x3f, 0x3a, 0x5c, 0xcc, 0xdb, 0xe1, 0x2b, 0x19, 0xf5, 0x4a, 0x05, 0x75, 0x5e, 0xfc, 0x50,
-	0xb8, 0x2b, 0xe3, 0xc2, 0xdd, 0xd1, 0xd4, 0xb3, 0x04, 0x1e, 0xaa, 0x05, 0xd3, 0x48, 0x78, 0x02,
-	0x9e, 0xe1, 0x24, 0xa1, 0x6f, 0xfd, 0x3c, 0x93, 0x89, 0x26, 0x81, 0x20, 0xa1, 0x2f, 0x86, 0xbc,
-	0xbd, 0x21, 0x37, 0x89, 0x76, 0x94, 0xf3, 0x9b, 0xa9, 0xef, 0x66, 0xc8, 0xbd, 0xbf, 0x6f, 0x83,
-	0xda, 0x8c, 0x1a, 0x4c, 0x41, 0xb3, 0x47, 0x53, 0xc2, 0x05, 0xc1, 0xa1, 0xdf, 0x4d, 0x68, 0x70,
-	0x6b, 0x8e, 0xe5, 0xf5, 0xbf, 0x0a, 0xf7, 0x27, 0x51, 0x2c, 0x7a, 0x79, 0xf7, 0x30, 0xa0, 0xe9,
-	0x51, 0x40, 0x79, 0x4a, 0xb9, 0xf9, 0xf3, 0x31, 0x0f, 0x6f, 0x8f, 0xc4, 0xa8, 0x4f, 0xf8, 0xe1,
-	0x45, 0x26, 0xc6, 0x85, 0xbb, 0xab, 0x17, 0xbb, 0x40, 0xe5, 0xa1, 0x46, 0x69, 0xe9, 0x48, 0x03,
-	0x1c, 0x81, 0x46, 0x88, 0xa9, 0xff, 0x2d, 0x65, 0xb7, 0x46, 0x6d, 0x4d, 0xa9, 0x5d, 0xff, 0xff,
-	0x6a, 0x77, 0x85, 0x5b, 0x7f, 0x7d, 0xf6, 0x9b, 0x2f, 0x28, 0xbb, 0x55, 0x9c, 0xe3, 0xc2, 0x7d,
-	0xa6, 0xd5, 0xe7, 0x99, 0x3d, 0x54, 0x0f, 0x31, 0x2d, 0xc3, 0xe0, 0x6f, 0x81, 0x53, 0x06, 0xf0,
-	0xbc, 0xdf, 0xa7, 0x4c, 0x98, 0x6a, 0xf8, 0xf8, 0xae, 0x70, 0x1b, 0x86, 0xf2, 0x5a, 0x7b, 0xc6,
-	0x85, 0xfb, 0xc1, 0x02, 0xa9, 0x99, 0xe3, 0xa1, 0x86, 0xa1, 0x35, 0xa1, 0x90, 0x83, 0x3a, 0x89,
-	0xfb, 0xc7, 0xa7, 0x9f, 0x98, 0x1d, 0x59, 0x6a, 0x47, 0x57, 0x4f, 0xda, 0x51, 0xed, 0xfc, 0xe2,
-	0xea, 0xf8, 0xf4, 0x93, 0xc9, 0x86, 0xcc, 0xd9, 0xcf, 0xd2, 0x7a, 0xa8, 0xa6, 0xa1, 0xde, 0xcd,
-	0x05, 0x30, 0xd0, 0xef, 0x61, 0xde, 0x53, 0x95, 0x55, 0xed, 0x1c, 0xdc, 0x15, 0x2e, 0xd0, 0x4c,
-	0x5f, 0x61, 0xde, 0x9b, 0x9e, 0x4b, 0x77, 0xf4, 0x07, 0x9c, 0x89, 0x38, 0x4f, 0x27, 0x5c, 0x40,
-	0x4f, 0x96, 0x51, 0xe5, 0xfa, 0x4f, 0xcd, 0xfa, 0x37, 0x96, 0x5e, 0xff, 0xe9, 0x63, 0xeb, 0x3f,
-	0x9d, 0x5f, 0xbf, 0x8e, 0x29, 0x45, 0x5f, 0x1a, 0xd1, 0xcd, 0xa5, 0x45, 0x5f, 0x3e, 0x26, 0xfa,
-	0x72, 0x5e, 0x54, 0xc7, 0xc8, 0x62, 0x5f, 0xc8, 0x44, 0xdb, 0x5e, 0xbe, 0xd8, 0x1f, 0x24, 0xb5,
-	0x51, 0x5a, 0xb4, 0xdc, 0x9f, 0x40, 0x2b, 0xa0, 0x19, 0x17, 0xd2, 0x96, 0xd1, 0x7e, 0x42, 0x8c,
-	0x66, 0x55, 0x69, 0x5e, 0x3c, 0x49, 0xf3, 0x85, 0xf9, 0x35, 0x78, 0x84, 0xcf, 0x43, 0x3b, 0xf3,
-	0x66, 0xad, 0xde, 0x07, 0x4e, 0x9f, 0x08, 0xc2, 0x78, 0x37, 0x67, 0x91, 0x51, 0x06, 0x4a, 0xf9,
-	0xfc, 0x49, 0xca, 0xe6, 0x1e, 0x2c, 0x72, 0x79, 0xa8, 0x39, 0x35, 0x69, 0xc5, 0xef, 0x40, 0x23,
-	0x96, 0xcb, 0xe8, 0xe6, 0x89, 0xd1, 0xab, 0x29, 0xbd, 0x57, 0x4f, 0xd2, 0x33, 0x97, 0x79, 0x9e,
-	0xc9, 0x43, 0x5b, 0x13, 0x83, 0xd6, 0xca, 0x01, 0x4c, 0xf3, 0x98, 0xf9, 0x51, 0x82, 0x83, 0x98,
-	0x30, 0xa3, 0x57, 0x57, 0x7a, 0x5f, 0x3e, 0x49, 0xef, 0x43, 0xad, 0xf7, 0x90, 0xcd, 0x43, 0x8e,
-	0x34, 0x7e, 0xa9, 0x6d, 0x5a, 0x36, 0x04, 0xf5, 0x2e, 0x61, 0x49, 0x9c, 0x19, 0xc1, 0x2d, 0x25,
-	0x78, 0xf6, 0x24, 0x41, 0x53, 0xa7, 0xb3, 0x3c, 0x1e, 0xaa, 0x69, 0x58, 0xaa, 0x24, 0x34, 0x0b,
-	0xe9, 0x44, 0x65, 0x7b, 0x79, 0x95, 0x59, 0x1e, 0x0f, 0xd5, 0x34, 0xd4, 0x2a, 0x43, 0xb0, 0x83,
-	0x19, 0xa3, 0x6f, 0x17, 0x72, 0x08, 0x95, 0xd8, 0x57, 0x4f, 0x12, 0x7b, 0xae, 0xc5, 0x1e, 0xa1,
-	0xf3, 0xd0, 0xb6, 0xb2, 0xce, 0x65, 0x31, 0x07, 0x30, 0x62, 0x78, 0xb4, 0x20, 0xdc, 0x5a, 0xfe,
-	0xf0, 0x1e, 0xb2, 0x79, 0xc8, 0x91, 0xc6, 0x39, 0xd9, 0x3f, 0x82, 0x56, 0x4a, 0x58, 0x44, 0xfc,
-	0x8c, 0x08, 0xde, 0x4f, 0x62, 0x61, 0x84, 0x9f, 0x2d, 0x7f, 0x1f, 0x1f, 0xe3, 0xf3, 0x10, 0x54,
-	0xe6, 0xaf, 0x8d, 0xb5, 0xbc, 0x1c, 0xbc, 0x87, 0xb3, 0xa8, 0x87, 0x63, 0x23, 0xbb, 0xbb, 0xfc,
-	0xe5, 0x98, 0x67, 0xf2, 0xd0, 0xd6, 0xc4, 0x50, 0xd6, 0x4f, 0x80, 0xb3, 0x20, 0x9f, 0xd4, 0xcf,
-	0x07, 0xcb, 0xd7, 0xcf, 0x2c, 0x8f, 0x6c, 0x3f, 0x14, 0x54, 0x2a, 0x97, 0x96, 0xdd, 0x70, 0x9a,
-	0x97, 0x96, 0xdd, 0x74, 0x9c, 0x4b, 0xcb, 0x76, 0x9c, 0xed, 0x4b, 0xcb, 0xde, 0x71, 0x5a, 0x68,
-	0x6b, 0x44, 0x13, 0xea, 0x0f, 0x3e, 0xd5, 0x93, 0x50, 0x8d, 0xbc, 0xc5, 0xdc, 0xfc, 0x46, 0xa2,
-	0x46, 0x80, 0x05, 0x4e, 0x46, 0xdc, 0xa4, 0x0a, 0x39, 0x3a, 0x81, 0x33, 0xaf, 0xf6, 0x11, 0x58,
-	0xbf, 0x16, 0xb2, 0x71, 0x73, 0x40, 0xe5, 0x96, 0x8c, 0x74, 0x37, 0x82, 0xe4, 0x10, 0xb6, 0xc0,
-	0xfa, 0x00, 0x27, 0xb9, 0xee, 0x00, 0xab, 0x48, 0x03, 0xef, 0x0a, 0x34, 0x6f, 0x18, 0xce, 0x38,
-	0x0e, 0x44, 0x4c, 0xb3, 0x37, 0x34, 0xe2, 0x10, 0x02, 0x4b, 0xbd, 0x8a, 0x7a, 0xae, 0x1a, 0xc3,
-	0x9f, 0x02, 0x2b, 0xa1, 0x11, 0x6f, 0xaf, 0xed, 0x57, 0x0e, 0x6a, 0x27, 0xcf, 0x1e, 0xf6, 0x60,
-	0x6f, 0x68, 0x84, 0x54, 0x88, 0xf7, 0x8f, 0x35, 0x50, 0x79, 0x43, 0x23, 0xd8, 0x06, 0x9b, 0x38,
-	0x0c, 0x19, 0xe1, 0xdc, 0x30, 0x4d, 0x20, 0xdc, 0x05, 0x1b, 0x82, 0xf6, 0xe3, 0x40, 0xd3, 0x55,
-	0x91, 0x41, 0x52, 0x38, 0xc4, 0x02, 0xab, 0xbe, 0xa2, 0x8e, 0xd4, 0x18, 0x9e, 0x80, 0xba, 0xda,
-	0x99, 0x9f, 0xe5, 0x69, 0x97, 0x30, 0xd5, 0x1e, 0x58, 0x9d, 0xe6, 0x7d, 0xe1, 0xd6, 0x94, 0xfd,
-	0x6b, 0x65, 0x46, 0xb3, 0x00, 0x7e, 0x04, 0x36, 0xc5, 0x70, 0xf6, 0x65, 0xdf, 0xb9, 0x2f, 0xdc,
-	0xa6, 0x98, 0x6e, 0x53, 0x3e, 0xdc, 0x68, 0x43, 0x0c, 0xd5, 0x03, 0x7e, 0x04, 0x6c, 0x31, 0xf4,
-	0xe3, 0x2c, 0x24, 0x43, 0xf5, 0x78, 0x5b, 0x9d, 0xd6, 0x7d, 0xe1, 0x3a, 0x33, 0xe1, 0x17, 0xd2,
-	0x87, 0x36, 0xc5, 0x50, 0x0d, 0xe0, 0x47, 0x00, 0xe8, 0x25, 0x29, 0x05, 0xfd, 0xf4, 0x6e, 0xdd,
-	0x17, 0x6e, 0x55, 0x59, 0x15, 0xf7, 0x74, 0x08, 0x3d, 0xb0, 0xae, 0xb9, 0x6d, 0xc5, 0x5d, 0xbf,
-	0x2f, 0x5c, 0x3b, 0xa1, 0x91, 0xe6, 0xd4, 0x2e, 0x99, 0x2a, 0x46, 0x52, 0x3a, 0x20, 0xa1, 0x7a,
-	0xdd, 0x6c, 0x34, 0x81, 0xde, 0x5f, 0xd7, 0x80, 0x7d, 0x33, 0x44, 0x84, 0xe7, 0x89, 0x80, 0x5f,
-	0x00, 0x27, 0xa0, 0x99, 0x60, 0x38, 0x10, 0xfe, 0x5c, 0x6a, 0x3b, 0x2f, 0xa6, 0x2f, 0xcd, 0x62,
-	0x84, 0x87, 0x9a, 0x13, 0xd3, 0x99, 0xc9, 0x7f, 0x0b, 0xac, 0x77, 0x13, 0x4a, 0x53, 0x55, 0x09,
-	0x75, 0xa4, 0x01, 0x44, 0x2a, 0x6b, 0xea, 0x94, 0x2b, 0xaa, 0xd3, 0xfe, 0xf1, 0xc3, 0x53, 0x5e,
-	0x28, 0x95, 0xce, 0xae, 0xe9, 0xb6, 0x1b, 0x5a, 0xdb, 0xcc, 0xf7, 0x64, 0x6e, 0x55, 0x29, 0x39,
-	0xa0, 0xc2, 0x88, 0x50, 0x87, 0x56, 0x47, 0x72, 0x08, 0x9f, 0x03, 0x9b, 0x91, 0x01, 0x61, 0x82,
-	0x84, 0xea, 0x70, 0x6c, 0x54, 0x62, 0xf8, 0x21, 0xb0, 0x23, 0xcc, 0xfd, 0x9c, 0x93, 0x50, 0x9f,
-	0x04, 0xda, 0x8c, 0x30, 0xff, 0x86, 0x93, 0xf0, 0x33, 0xeb, 0xcf, 0xdf, 0xbb, 0x2b, 0x1e, 0x06,
-	0xb5, 0xb3, 0x20, 0x20, 0x9c, 0xdf, 0xe4, 0xfd, 0x84, 0xfc, 0x97, 0x0a, 0x3b, 0x01, 0x75, 0x2e,
-	0x28, 0xc3, 0x11, 0xf1, 0x6f, 0xc9, 0xc8, 0xd4, 0x99, 0xae, 0x1a, 0x63, 0xff, 0x15, 0x19, 0x71,
-	0x34, 0x0b, 0x8c, 0xc4, 0xf7, 0x16, 0xa8, 0xdd, 0x30, 0x1c, 0x10, 0xd3, 0xe1, 0xcb, 0x5a, 0x95,
-	0x90, 0x19, 0x09, 0x83, 0xa4, 0xb6, 0x88, 0x53, 0x42, 0x73, 0x61, 0xee, 0xd3, 0x04, 0xca, 0x19,
-	0x8c, 0x90, 0x21, 0x09, 0x54, 0x1a, 0x2d, 0x64, 0x10, 0x3c, 0x05, 0x5b, 0x61, 0xcc, 0xd5, 0xe7,
-	0x12, 0x17, 0x38, 0xb8, 0xd5, 0xdb, 0xef, 0x38, 0xf7, 0x85, 0x5b, 0x37, 0x8e, 0x6b, 0x69, 0x47,
-	0x73, 0x08, 0x7e, 0x0e, 0x9a, 0xd3, 0x69, 0x6a, 0xb5, 0xfa, 0x03, 0xa5, 0x03, 0xef, 0x0b, 0xb7,
-	0x51, 0x86, 0x2a, 0x0f, 0x5a, 0xc0, 0xf2, 0xa4, 0x43, 0xd2, 0xcd, 0x23, 0x55, 0x7c, 0x36, 0xd2,
-	0x40, 0x5a, 0x93, 0x38, 0x8d, 0x85, 0x2a, 0xb6, 0x75, 0xa4, 0x01, 0xfc, 0x1c, 0x54, 0xe9, 0x80,
-	0x30, 0x16, 0x87, 0x84, 0xab, 0x56, 0xe7, 0x7f, 0x7d, 0x6b, 0xa1, 0x69, 0xbc, 0xdc, 0x9c, 0xf9,
-	0x14, 0x4c, 0x49, 0x4a, 0xd9, 0x48, 0xf5, 0x2e, 0x66, 0x73, 0xda, 0xf1, 0x6b, 0x65, 0x47, 0x73,
-	0x08, 0x76, 0x00, 0x34, 0xd3, 0x18, 0x11, 0x39, 0xcb, 0x7c, 0x75, 0xff, 0xeb, 0x6a, 0xae, 0xba,
-	0x85, 0xda, 0x8b, 0x94, 0xf3, 0x35, 0x16, 0x18, 0x3d, 0xb0, 0xc0, 0x5f, 0x00, 0xa8, 0xcf, 0xc4,
-	0xff, 0x8e, 0xd3, 0xf2, 0x63, 0x51, 0xb7, 0x16, 0x4a, 0x5f, 0x7b, 0xcd, 0x9a, 0x1d, 0x8d, 0x2e,
-	0x39, 0x35, 0xbb, 0xb8, 0xb4, 0x6c, 0xcb, 0x59, 0xbf, 0xb4, 0xec, 0x4d, 0xc7, 0x2e, 0xf3, 0x67,
-	0x76, 0x81, 0x76, 0x26, 0x78, 0x66, 0x79, 0x9d, 0x5f, 0xfe, 0x70, 0xb7, 0xb7, 0xfa, 0xee, 0x6e,
-	0x6f, 0xf5, 0xdf, 0x77, 0x7b, 0xab, 0x7f, 0x7b, 0xbf, 0xb7, 0xf2, 0xee, 0xfd, 0xde, 0xca, 0x3f,
-	0xdf, 0xef, 0xad, 0xfc, 0x6e, 0xf6, 0x7d, 0x20, 0x03, 0xf9, 0x3c, 0xe8, 0x7f, 0x07, 0xc7, 0xc7,
-	0x47, 0x43, 0xf5, 0x3f, 0x00, 0xea, 0x8d, 0xe8, 0x6e, 0xa8, 0x2f, 0xfb, 0x4f, 0xff, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x6d, 0x4d, 0x22, 0xdd, 0x1f, 0x10, 0x00, 0x00,
+func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainConfig.Merge(m, src)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnprotectedTxs {
-		i--
-		if m.AllowUnprotectedTxs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+func (m *ChainConfig) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ChainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainConfig proto.InternalMessageInfo
+
+func (m *ChainConfig) GetDAOForkSupport() bool {
+	if m != nil {
+		return m.DAOForkSupport
 	}
-	{
-		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+	return false
+}
+
+func (m *ChainConfig) GetEIP150Hash() string {
+	if m != nil {
+		return m.EIP150Hash
+	}
+	return ""
+}
+
+// State represents a single Storage key value pair item.
+type State struct {
+	// key is the stored key
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the stored value for the given key
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
+}
+
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_State.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvm(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
-		for _, num1 := range m.ExtraEIPs {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x22
+}
+
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+
+func (m *State) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func (m *State) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if m.EnableCall {
-		i--
-		if m.EnableCall {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *State) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if m.EnableCreate {
-		i--
-		if m.EnableCreate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// TransactionLogs define the logs generated from a transaction execution
+// with a given hash. It it used for import/export data as transactions are not
+// persisted on blockchain state after an upgrade.
+type TransactionLogs struct {
+	// hash of the transaction
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// logs is an array of Logs for the given transaction hash
+	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
+func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
+func (*TransactionLogs) ProtoMessage()    {}
+func (*TransactionLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{12}
+}
+
+func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TransactionLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionLogs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.EvmDenom) > 0 {
-		i -= len(m.EvmDenom)
-		copy(dAtA[i:], m.EvmDenom)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.EvmDenom)))
-		i--
-		dAtA[i] = 0xa
+}
+
+func (m *TransactionLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionLogs.Merge(m, src)
+}
+
+func (m *TransactionLogs) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TransactionLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionLogs proto.InternalMessageInfo
+
+func (m *TransactionLogs) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransactionLogs) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Log represents an protobuf compatible Ethereum Log that defines a contract
+// log event. These events are generated by the LOG opcode and stored/indexed by
+// the node.
+//
+// NOTE: address, topics and data are consensus fields. The rest of the fields
+// are derived, i.e. filled in by the nodes, but not secured by consensus.
+type Log struct {
+	// address of the contract that generated the event
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// topics is a list of topics provided by the contract.
+	Topics []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	// data which is supplied by the contract, usually ABI-encoded
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// block_number of the block in which the transaction was included
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
+	// tx_hash is the transaction hash
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"transactionHash"`
+	// tx_index of the transaction in the block
+	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"transactionIndex"`
+	// block_hash of the block in which the transaction was included
+	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
+	// index of the log in the block
+	Index uint64 `protobuf:"varint,8,opt,name=index,proto3" json:"logIndex"`
+	// removed is true if this log was reverted due to a chain
+	// reorganisation. You must pay attention to this field if you receive logs
+	// through a filter query.
+	Removed bool `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
-func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CancunBlock != nil {
-		{
-			size := m.CancunBlock.Size()
-			i -= size
-			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{13}
+}
+
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		return b[:n], nil
 	}
-	if m.ShanghaiBlock != nil {
-		{
-			size := m.ShanghaiBlock.Size()
-			i -= size
-			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
-	}
-	if m.MergeNetsplitBlock != nil {
-		{
-			size := m.MergeNetsplitBlock.Size()
-			i -= size
-			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
-	}
-	if m.GrayGlacierBlock != nil {
-		{
-			size := m.GrayGlacierBlock.Size()
-			i -= size
-			if _, err := m.GrayGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+}
+
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
+}
+
+func (m *Log) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Log proto.InternalMessageInfo
+
+func (m *Log) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.ArrowGlacierBlock != nil {
-		{
-			size := m.ArrowGlacierBlock.Size()
-			i -= size
-			if _, err := m.ArrowGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	return ""
+}
+
+func (m *Log) GetTopics() []string {
+	if m != nil {
+		return m.Topics
 	}
-	if m.LondonBlock != nil {
-		{
-			size := m.LondonBlock.Size()
-			i -= size
-			if _, err := m.LondonBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return nil
+}
+
+func (m *Log) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if m.BerlinBlock != nil {
-		{
-			size := m.BerlinBlock.Size()
-			i -= size
-			if _, err := m.BerlinBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	return nil
+}
+
+func (m *Log) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	if m.MuirGlacierBlock != nil {
-		{
-			size := m.MuirGlacierBlock.Size()
-			i -= size
-			if _, err := m.MuirGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	return 0
+}
+
+func (m *Log) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	if m.IstanbulBlock != nil {
-		{
-			size := m.IstanbulBlock.Size()
-			i -= size
-			if _, err := m.IstanbulBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *Log) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
 	}
-	if m.PetersburgBlock != nil {
-		{
-			size := m.PetersburgBlock.Size()
-			i -= size
-			if _, err := m.PetersburgBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	return 0
+}
+
+func (m *Log) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
-	if m.ConstantinopleBlock != nil {
-		{
-			size := m.ConstantinopleBlock.Size()
-			i -= size
-			if _, err := m.ConstantinopleBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *Log) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if m.ByzantiumBlock != nil {
-		{
-			size := m.ByzantiumBlock.Size()
-			i -= size
-			if _, err := m.ByzantiumBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *Log) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
 	}
-	if m.EIP158Block != nil {
-		{
-			size := m.EIP158Block.Size()
-			i -= size
-			if _, err := m.EIP158Block.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	return false
+}
+
+// TxResult stores results of Tx execution.
+type TxResult struct {
+	// contract_address contains the ethereum address of the created contract (if
+	// any). If the state transition is an evm.Call, the contract address will be
+	// empty.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// bloom represents the bloom filter bytes
+	Bloom []byte `protobuf:"bytes,2,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	// tx_logs contains the transaction hash and the proto-compatible ethereum
+	// logs.
+	TxLogs TransactionLogs `protobuf:"bytes,3,opt,name=tx_logs,json=txLogs,proto3" json:"tx_logs" yaml:"tx_logs"`
+	// ret defines the bytes from the execution.
+	Ret []byte `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
+	// reverted flag is set to true when the call has been reverted
+	Reverted bool `protobuf:"varint,5,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	// gas_used notes the amount of gas consumed while execution
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *TxResult) Reset()         { *m = TxResult{} }
+func (m *TxResult) String() string { return proto.CompactTextString(m) }
+func (*TxResult) ProtoMessage()    {}
+func (*TxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{14}
+}
+
+func (m *TxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if m.EIP155Block != nil {
-		{
-			size := m.EIP155Block.Size()
-			i -= size
-			if _, err := m.EIP155Block.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.EIP150Hash) > 0 {
-		i -= len(m.EIP150Hash)
-		copy(dAtA[i:], m.EIP150Hash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.EIP150Hash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.EIP150Block != nil {
-		{
-			size := m.EIP150Block.Size()
-			i -= size
-			if _, err := m.EIP150Block.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DAOForkSupport {
-		i--
-		if m.DAOForkSupport {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.DAOForkBlock != nil {
-		{
-			size := m.DAOForkBlock.Size()
-			i -= size
-			if _, err := m.DAOForkBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.HomesteadBlock != nil {
-		{
-			size := m.HomesteadBlock.Size()
-			i -= size
-			if _, err := m.HomesteadBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
 
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxResult.Merge(m, src)
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TxResult) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *TxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxResult.DiscardUnknown(m)
 }
 
-func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_TxResult proto.InternalMessageInfo
+
+// AccessTuple is the element type of an access list.
+type AccessTuple struct {
+	// address is a hex formatted ethereum address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// storage_keys are hex formatted hashes of the storage keys
+	StorageKeys []string `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storageKeys"`
 }
 
-func (m *TransactionLogs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
+func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
+func (*AccessTuple) ProtoMessage()    {}
+func (*AccessTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{15}
 }
 
-func (m *TransactionLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *Log) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessTuple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Log) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccessTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTuple.Merge(m, src)
 }
 
-func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Removed {
-		i--
-		if m.Removed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Index != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TxIndex != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Topics[iNdEx])
-			copy(dAtA[i:], m.Topics[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *AccessTuple) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *TxResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AccessTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTuple.DiscardUnknown(m)
 }
 
-func (m *TxResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
 
-func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Reverted {
-		i--
-		if m.Reverted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// TraceConfig holds extra parameters to trace functions.
+type TraceConfig struct {
+	// tracer is a custom javascript tracer
+	Tracer string `protobuf:"bytes,1,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	// timeout overrides the default timeout of 5 seconds for JavaScript-based tracing
+	// calls
+	Timeout string `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// reexec defines the number of blocks the tracer is willing to go back
+	Reexec uint64 `protobuf:"varint,3,opt,name=reexec,proto3" json:"reexec,omitempty"`
+	// disable_stack switches stack capture
+	DisableStack bool `protobuf:"varint,5,opt,name=disable_stack,json=disableStack,proto3" json:"disableStack"`
+	// disable_storage switches storage capture
+	DisableStorage bool `protobuf:"varint,6,opt,name=disable_storage,json=disableStorage,proto3" json:"disableStorage"`
+	// debug can be used to print output during capture end
+	Debug bool `protobuf:"varint,8,opt,name=debug,proto3" json:"debug,omitempty"`
+	// limit defines the maximum length of output, but zero means unlimited
+	Limit int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	// overrides can be used to execute a trace using future fork rules
+	Overrides *ChainConfig `protobuf:"bytes,10,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// enable_memory switches memory capture
+	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
+	// enable_return_data switches the capture of return data
+	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
+	// tracer_json_config configures the tracer using a JSON string
+	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+}
+
+func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
+func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
+func (*TraceConfig) ProtoMessage()    {}
+func (*TraceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{16}
+}
+
+func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TraceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Ret)))
-		i--
-		dAtA[i] = 0x22
+}
+
+func (m *TraceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceConfig.Merge(m, src)
+}
+
+func (m *TraceConfig) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TraceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceConfig proto.InternalMessageInfo
+
+func (m *TraceConfig) GetTracer() string {
+	if m != nil {
+		return m.Tracer
 	}
-	{
-		size, err := m.TxLogs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvm(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *TraceConfig) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Bloom) > 0 {
-		i -= len(m.Bloom)
-		copy(dAtA[i:], m.Bloom)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Bloom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TraceConfig) GetReexec() uint64 {
+	if m != nil {
+		return m.Reexec
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TraceConfig) GetDisableStack() bool {
+	if m != nil {
+		return m.DisableStack
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraceConfig) GetDisableStorage() bool {
+	if m != nil {
+		return m.DisableStorage
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TraceConfig) GetDebug() bool {
+	if m != nil {
+		return m.Debug
+	}
+	return false
 }
 
-func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageKeys) > 0 {
-		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StorageKeys[iNdEx])
-			copy(dAtA[i:], m.StorageKeys[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.StorageKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *TraceConfig) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TraceConfig) GetOverrides() *ChainConfig {
+	if m != nil {
+		return m.Overrides
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
+func (m *TraceConfig) GetEnableMemory() bool {
+	if m != nil {
+		return m.EnableMemory
+	}
+	return false
+}
+
+func (m *TraceConfig) GetEnableReturnData() bool {
+	if m != nil {
+		return m.EnableReturnData
+	}
+	return false
+}
+
+func (m *TraceConfig) GetTracerJsonConfig() string {
+	if m != nil {
+		return m.TracerJsonConfig
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*EvmHookParams)(nil), "ethermint.evm.v1.EvmHookParams")
+	proto.RegisterType((*FeeDenom)(nil), "ethermint.evm.v1.FeeDenom")
+	proto.RegisterType((*FeePayment)(nil), "ethermint.evm.v1.FeePayment")
+	proto.RegisterType((*ContractABI)(nil), "ethermint.evm.v1.ContractABI")
+	proto.RegisterType((*EventBridge)(nil), "ethermint.evm.v1.EventBridge")
+	proto.RegisterType((*Permissions)(nil), "ethermint.evm.v1.Permissions")
+	proto.RegisterType((*CreatePolicy)(nil), "ethermint.evm.v1.CreatePolicy")
+	proto.RegisterType((*AllowedDeployer)(nil), "ethermint.evm.v1.AllowedDeployer")
+	proto.RegisterType((*CallPolicy)(nil), "ethermint.evm.v1.CallPolicy")
+	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
+	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
+	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
+	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
+	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
+	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
+	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
+
+var fileDescriptor_d21ecc92c8c8583e = []byte{
+	// 2309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0x37, 0x4d, 0x4a, 0x22, 0x87, 0x14, 0x49, 0x8d, 0x64, 0x9b, 0x92, 0x13, 0xad, 0xba, 0x87,
+	0x42, 0x05, 0x12, 0x29, 0x52, 0xaa, 0xc6, 0x70, 0xda, 0x22, 0x5a, 0x49, 0x8e, 0xa5, 0xba, 0xb1,
+	0x30, 0x96, 0x5b, 0xa0, 0x40, 0xb1, 0x1d, 0xee, 0x3e, 0x53, 0x1b, 0xed, 0xee, 0x10, 0x33, 0x4b,
+	0x9a, 0x6c, 0xfb, 0x01, 0x0a, 0xf4, 0xd2, 0xf6, 0xd8, 0x93, 0x0f, 0x3d, 0xf7, 0x1b, 0xf4, 0x58,
+	0x20, 0xe8, 0x29, 0xc7, 0xa2, 0x07, 0xa2, 0x90, 0x6f, 0x3a, 0xea, 0x13, 0x14, 0xf3, 0x67, 0x97,
+	0x4b, 0x4a, 0x75, 0x2c, 0x5d, 0xc8, 0x79, 0x7f, 0x7f, 0x6f, 0xde, 0xbc, 0x79, 0x3b, 0x33, 0x68,
+	0x05, 0x92, 0x53, 0xe0, 0x51, 0x10, 0x27, 0x9b, 0xd0, 0x8f, 0x36, 0xfb, 0x5b, 0xf2, 0x6f, 0xa3,
+	0xcb, 0x59, 0xc2, 0x70, 0x33, 0x93, 0x6d, 0x48, 0x66, 0x7f, 0x6b, 0x65, 0xd5, 0x63, 0x22, 0x62,
+	0x62, 0xb3, 0x4d, 0x05, 0x6c, 0xf6, 0xb7, 0xda, 0x90, 0xd0, 0xad, 0x4d, 0x8f, 0x05, 0xb1, 0xb6,
+	0x58, 0x59, 0xea, 0xb0, 0x0e, 0x53, 0xc3, 0x4d, 0x39, 0xd2, 0x5c, 0xfb, 0xef, 0xb3, 0x68, 0xf6,
+	0x98, 0x72, 0x1a, 0x09, 0xbc, 0x85, 0x2a, 0xd0, 0x8f, 0x5c, 0x1f, 0x62, 0x16, 0xb5, 0x0a, 0x6b,
+	0x85, 0xf5, 0x8a, 0xb3, 0x74, 0x39, 0xb2, 0x9a, 0x43, 0x1a, 0x85, 0x8f, 0xed, 0x4c, 0x64, 0x93,
+	0x32, 0xf4, 0xa3, 0x7d, 0x39, 0xc4, 0x3f, 0x41, 0xf3, 0x10, 0xd3, 0x76, 0x08, 0xae, 0xc7, 0x81,
+	0x26, 0xd0, 0xba, 0xbb, 0x56, 0x58, 0x2f, 0x3b, 0xad, 0xcb, 0x91, 0xb5, 0x64, 0xcc, 0xf2, 0x62,
+	0x9b, 0xd4, 0x34, 0xbd, 0xa7, 0x48, 0xfc, 0x19, 0xaa, 0xa6, 0x72, 0x1a, 0x86, 0xad, 0xa2, 0x32,
+	0xbe, 0x7f, 0x39, 0xb2, 0xf0, 0xa4, 0x31, 0x0d, 0x43, 0x9b, 0x20, 0x63, 0x4a, 0xc3, 0x10, 0xef,
+	0x22, 0x04, 0x83, 0x84, 0x53, 0x17, 0x82, 0xae, 0x68, 0x95, 0xd6, 0x8a, 0xeb, 0x45, 0xc7, 0x3e,
+	0x1f, 0x59, 0x95, 0x03, 0xc9, 0x3d, 0x38, 0x3c, 0x16, 0x97, 0x23, 0x6b, 0xc1, 0x38, 0xc9, 0x14,
+	0x6d, 0x52, 0x51, 0xc4, 0x41, 0xd0, 0x15, 0xf8, 0xd7, 0xa8, 0xe6, 0x9d, 0xd2, 0x20, 0x76, 0x3d,
+	0x16, 0xbf, 0x0a, 0x3a, 0xad, 0x99, 0xb5, 0xc2, 0x7a, 0x75, 0xfb, 0xc3, 0x8d, 0xe9, 0xbc, 0x6e,
+	0xec, 0x49, 0xad, 0x3d, 0xa5, 0xe4, 0x3c, 0xfc, 0x66, 0x64, 0xdd, 0xb9, 0x1c, 0x59, 0x8b, 0xda,
+	0x75, 0xde, 0x81, 0x4d, 0xaa, 0xde, 0x58, 0x13, 0x6f, 0xa3, 0x7b, 0x34, 0x0c, 0xd9, 0x6b, 0xb7,
+	0x17, 0xcb, 0x44, 0x83, 0x97, 0x80, 0xef, 0x26, 0x03, 0xd1, 0x9a, 0x95, 0x93, 0x24, 0x8b, 0x4a,
+	0xf8, 0x72, 0x2c, 0x3b, 0x19, 0x08, 0xfc, 0x0c, 0x61, 0xea, 0x25, 0x41, 0x1f, 0xdc, 0x2e, 0x07,
+	0x8f, 0x45, 0xdd, 0x20, 0x04, 0xd1, 0x9a, 0x5b, 0x2b, 0xae, 0x57, 0x9c, 0x0f, 0x2f, 0x47, 0xd6,
+	0xb2, 0x46, 0xbd, 0xaa, 0x63, 0x93, 0x05, 0xcd, 0x3c, 0x1e, 0xf3, 0xf0, 0x01, 0xaa, 0x76, 0xe5,
+	0x4c, 0x84, 0x08, 0x58, 0x2c, 0x5a, 0xe5, 0xff, 0x37, 0xbf, 0xe3, 0xb1, 0x92, 0x53, 0x92, 0xf3,
+	0x23, 0x79, 0x3b, 0xfc, 0x1b, 0x34, 0x0f, 0x7d, 0x88, 0x13, 0xb7, 0xcd, 0x03, 0xbf, 0x03, 0xa2,
+	0x55, 0x59, 0x2b, 0x5e, 0xef, 0xe8, 0x40, 0xaa, 0x39, 0x4a, 0xcb, 0xf9, 0xc0, 0x24, 0x2a, 0xad,
+	0x82, 0xbc, 0x07, 0x59, 0x05, 0x63, 0x55, 0x81, 0x4f, 0x10, 0x7a, 0x05, 0xa0, 0x8b, 0x4b, 0xb4,
+	0x90, 0x72, 0xbf, 0x72, 0xd5, 0xfd, 0x13, 0x00, 0x55, 0x74, 0xce, 0xb2, 0xf1, 0x6d, 0xd6, 0x77,
+	0x6c, 0x6b, 0x93, 0xca, 0x2b, 0xa3, 0x24, 0xf0, 0x2f, 0x74, 0x35, 0x9f, 0x32, 0x76, 0x26, 0x5a,
+	0x55, 0xe5, 0xd4, 0xba, 0x2e, 0xe6, 0xe8, 0x29, 0x63, 0x67, 0x7a, 0x07, 0x38, 0x2d, 0xe3, 0x39,
+	0x57, 0xf2, 0xca, 0x5e, 0x97, 0xfc, 0x53, 0x35, 0xfc, 0x6b, 0x01, 0xcd, 0x4f, 0x58, 0x61, 0x8c,
+	0x4a, 0x31, 0x8d, 0x40, 0x6f, 0x19, 0xa2, 0xc6, 0x72, 0x2f, 0x75, 0xa8, 0x70, 0xc3, 0x20, 0x0a,
+	0x12, 0xb5, 0x29, 0x4a, 0xf9, 0xbd, 0x94, 0x89, 0x6c, 0x52, 0xee, 0x50, 0xf1, 0x4c, 0x0e, 0xb1,
+	0x83, 0x1a, 0x1c, 0xfa, 0xc0, 0x13, 0x97, 0xc5, 0x2e, 0x70, 0xce, 0xb8, 0xd9, 0x10, 0x2b, 0x97,
+	0x23, 0xeb, 0xbe, 0x36, 0x9c, 0x52, 0xb0, 0xc9, 0xbc, 0xe6, 0x3c, 0x8f, 0x0f, 0x14, 0xfd, 0xa6,
+	0x80, 0xca, 0x69, 0x9e, 0xf0, 0x12, 0x9a, 0xc9, 0xed, 0x65, 0xa2, 0x09, 0xec, 0xa0, 0x12, 0x4f,
+	0x77, 0x6a, 0xc5, 0xd9, 0x90, 0x33, 0xfe, 0xcf, 0xc8, 0xfa, 0x7e, 0x27, 0x48, 0x4e, 0x7b, 0xed,
+	0x0d, 0x8f, 0x45, 0x9b, 0xa6, 0x8f, 0xe8, 0xbf, 0x8f, 0x85, 0x7f, 0xb6, 0x99, 0x0c, 0xbb, 0x20,
+	0x36, 0xf6, 0xc1, 0x23, 0xca, 0x16, 0x3f, 0x46, 0xb5, 0x2e, 0x0f, 0x3c, 0x70, 0x19, 0xa7, 0x5e,
+	0x08, 0x2a, 0xce, 0x8a, 0xf3, 0x60, 0xbc, 0x31, 0xf2, 0x52, 0x9b, 0x54, 0x15, 0xf9, 0x5c, 0x53,
+	0x2f, 0x11, 0x7a, 0x02, 0x70, 0x4c, 0x87, 0x11, 0xc4, 0x89, 0x8c, 0xb1, 0x4b, 0x87, 0xc0, 0xd3,
+	0x18, 0x15, 0x81, 0xb7, 0x50, 0xf1, 0x15, 0xe8, 0x10, 0xab, 0xdb, 0xcb, 0x1b, 0x3a, 0x92, 0x0d,
+	0xd9, 0xd8, 0x36, 0x4c, 0x63, 0xdb, 0xd8, 0x63, 0x41, 0x6c, 0xca, 0x55, 0xea, 0xda, 0x7f, 0x2e,
+	0xa0, 0xea, 0x1e, 0x8b, 0x13, 0x4e, 0xbd, 0x64, 0xd7, 0x39, 0xc4, 0x2d, 0x34, 0x47, 0x7d, 0x9f,
+	0x83, 0x10, 0xc6, 0x75, 0x4a, 0xca, 0xa5, 0xf1, 0x98, 0x0f, 0xee, 0x29, 0x15, 0xa7, 0x26, 0x0b,
+	0xb9, 0xa5, 0xc9, 0x44, 0x36, 0x29, 0xcb, 0xf1, 0x53, 0x2a, 0x4e, 0xf1, 0x32, 0x2a, 0xd2, 0x76,
+	0x60, 0xa6, 0x39, 0x77, 0x3e, 0xb2, 0x8a, 0xbb, 0xce, 0x21, 0x91, 0x3c, 0xbc, 0x82, 0xca, 0x11,
+	0x24, 0xd4, 0xa7, 0x09, 0x6d, 0x95, 0x14, 0x50, 0x46, 0xdb, 0x3d, 0x54, 0xcd, 0xed, 0x09, 0xa9,
+	0xea, 0x99, 0x08, 0x4d, 0x4c, 0x19, 0x2d, 0xf3, 0xa0, 0xf6, 0x84, 0x0e, 0x88, 0x68, 0x02, 0xff,
+	0x10, 0x21, 0x35, 0x70, 0xe5, 0x02, 0x18, 0xf8, 0x7b, 0xb9, 0xce, 0x96, 0xc9, 0x64, 0x67, 0x93,
+	0xc4, 0x89, 0x1c, 0xff, 0xb3, 0x80, 0xaa, 0xb9, 0x4d, 0x8d, 0x7f, 0x8c, 0x66, 0x4d, 0x77, 0x2e,
+	0xa8, 0x84, 0xae, 0x5e, 0xd3, 0xe3, 0x94, 0xfc, 0x98, 0x85, 0x81, 0x37, 0x34, 0x59, 0x35, 0x36,
+	0xf8, 0x47, 0xa8, 0xa4, 0x9a, 0xb3, 0x5e, 0x8c, 0x0f, 0xae, 0xb1, 0xa5, 0x61, 0x38, 0x61, 0xa9,
+	0xf4, 0xf1, 0x17, 0xa8, 0xee, 0x43, 0x1c, 0x80, 0xef, 0x0a, 0x88, 0x7d, 0xe0, 0xa2, 0x55, 0x54,
+	0x8d, 0x6c, 0xf9, 0x72, 0x64, 0xdd, 0xd3, 0xf1, 0x4f, 0xca, 0x6d, 0x32, 0xaf, 0x19, 0x2f, 0x0c,
+	0xfd, 0xb7, 0x02, 0xaa, 0xe5, 0x03, 0xc3, 0x36, 0xaa, 0x8d, 0x3b, 0x13, 0xf8, 0x6a, 0x3a, 0x65,
+	0x32, 0xc1, 0xc3, 0x5d, 0xb4, 0xa0, 0x5a, 0x2b, 0xf8, 0xae, 0x0f, 0xdd, 0x90, 0x0d, 0x25, 0xf2,
+	0x5d, 0xb5, 0xfd, 0xbf, 0x77, 0x35, 0xf6, 0x5d, 0xad, 0xba, 0x6f, 0x34, 0x9d, 0x35, 0xd3, 0x00,
+	0x5a, 0xa6, 0xd3, 0x4e, 0x7b, 0xb2, 0x49, 0x93, 0x4e, 0x9a, 0x08, 0xdb, 0x47, 0x8d, 0x29, 0x37,
+	0xef, 0x28, 0xbe, 0xcf, 0x50, 0x35, 0xab, 0x30, 0xd0, 0x81, 0x55, 0xf2, 0x5f, 0xbc, 0x9c, 0xd0,
+	0x26, 0x28, 0x2d, 0x40, 0x10, 0xf6, 0x3f, 0x0a, 0x08, 0x8d, 0x33, 0xfd, 0x5e, 0xa9, 0xd8, 0x43,
+	0x8d, 0x74, 0x02, 0xe9, 0x12, 0x68, 0xbc, 0x5c, 0x43, 0x99, 0x52, 0xb0, 0x49, 0xdd, 0x70, 0xcc,
+	0x22, 0xe0, 0x27, 0xa8, 0xd9, 0xed, 0xb5, 0xc3, 0xc0, 0x73, 0xd3, 0x5a, 0x4d, 0x17, 0xf2, 0xe1,
+	0xe5, 0xc8, 0x7a, 0x60, 0xb6, 0xfb, 0x94, 0x86, 0x4d, 0x1a, 0x9a, 0xb5, 0x97, 0x71, 0xfe, 0x82,
+	0x51, 0x35, 0xf7, 0x25, 0xc5, 0x11, 0x6a, 0x9c, 0xb2, 0x08, 0x44, 0x02, 0xd4, 0x77, 0xdb, 0x21,
+	0xf3, 0xce, 0xcc, 0x91, 0x63, 0xff, 0x3d, 0xbb, 0xd1, 0x61, 0x9c, 0x8c, 0xa7, 0x31, 0xe5, 0xca,
+	0x26, 0xf5, 0x8c, 0xe3, 0x48, 0x06, 0x1e, 0xa2, 0xba, 0x4f, 0x99, 0xfb, 0x8a, 0xf1, 0x33, 0x83,
+	0xa6, 0x77, 0xfe, 0x8b, 0xf7, 0x47, 0x3b, 0x1f, 0x59, 0xb5, 0xfd, 0xdd, 0xe7, 0x4f, 0x18, 0x3f,
+	0x53, 0x3e, 0x73, 0x75, 0x3c, 0xe1, 0xd9, 0x26, 0x35, 0x9f, 0xb2, 0x4c, 0x0d, 0xff, 0x12, 0x35,
+	0x33, 0x05, 0xd1, 0xeb, 0x76, 0x19, 0x4f, 0x4c, 0x63, 0xff, 0xf8, 0x7c, 0x64, 0xd5, 0x8d, 0xcb,
+	0x17, 0x5a, 0x32, 0xce, 0xe9, 0xb4, 0x8d, 0x4d, 0xea, 0xc6, 0xad, 0x51, 0xc5, 0x02, 0xd5, 0x20,
+	0xe8, 0x6e, 0xed, 0x7c, 0x62, 0x66, 0xa4, 0xda, 0x8f, 0x73, 0x7c, 0xa3, 0x19, 0x55, 0x0f, 0x0e,
+	0x8f, 0xb7, 0x76, 0x3e, 0x49, 0x27, 0x64, 0xda, 0x77, 0xde, 0xad, 0x4d, 0xaa, 0x9a, 0xd4, 0xb3,
+	0x39, 0x44, 0x86, 0xd4, 0xfd, 0x73, 0x46, 0x61, 0xae, 0x9f, 0x8f, 0x2c, 0xa4, 0x3d, 0xc9, 0x72,
+	0x1d, 0xaf, 0x4b, 0x7b, 0xf8, 0x5b, 0x1a, 0x27, 0x41, 0x2f, 0x4a, 0x7d, 0x21, 0x6d, 0xac, 0xba,
+	0x6a, 0x1a, 0xff, 0x8e, 0x89, 0x7f, 0xf6, 0xd6, 0xf1, 0xef, 0x5c, 0x17, 0xff, 0xce, 0x64, 0xfc,
+	0x5a, 0x27, 0x03, 0x7d, 0x64, 0x40, 0xe7, 0x6e, 0x0d, 0xfa, 0xe8, 0x3a, 0xd0, 0x47, 0x93, 0xa0,
+	0x5a, 0x47, 0x16, 0xfb, 0x54, 0x26, 0x5a, 0xe5, 0xdb, 0x17, 0xfb, 0x95, 0xa4, 0xd6, 0x33, 0x8e,
+	0x86, 0xfb, 0x3d, 0x5a, 0xf2, 0x58, 0x2c, 0x12, 0xc9, 0x8b, 0x59, 0x37, 0x04, 0x83, 0x59, 0x51,
+	0x98, 0x87, 0x37, 0xc2, 0x7c, 0x98, 0xf6, 0xa5, 0xab, 0xfe, 0x6c, 0xb2, 0x38, 0xc9, 0xd6, 0xe8,
+	0x5d, 0xd4, 0xec, 0x42, 0x02, 0x5c, 0xb4, 0x7b, 0xbc, 0x63, 0x90, 0x91, 0x42, 0x3e, 0xb8, 0x11,
+	0x72, 0xda, 0x5b, 0xa6, 0x7c, 0xc9, 0xde, 0x92, 0xb1, 0x34, 0xe2, 0xd7, 0xa8, 0x1e, 0xc8, 0x30,
+	0xda, 0xbd, 0xd0, 0xe0, 0x55, 0x15, 0xde, 0xde, 0x8d, 0xf0, 0xcc, 0x66, 0x9e, 0xf4, 0x64, 0x93,
+	0xf9, 0x94, 0xa1, 0xb1, 0x7a, 0x08, 0x47, 0xbd, 0x80, 0xbb, 0x9d, 0x90, 0x7a, 0x01, 0x70, 0x83,
+	0x57, 0x53, 0x78, 0x5f, 0xde, 0x08, 0xcf, 0x9c, 0xe6, 0xaf, 0x7a, 0xb3, 0x49, 0x53, 0x32, 0xbf,
+	0xd4, 0x3c, 0x0d, 0xeb, 0xa3, 0x5a, 0x1b, 0x78, 0x18, 0xc4, 0x06, 0x70, 0x5e, 0x01, 0xee, 0xde,
+	0x08, 0xd0, 0xd4, 0x69, 0xde, 0x8f, 0x4d, 0xaa, 0x9a, 0xcc, 0x50, 0x42, 0x16, 0xfb, 0x2c, 0x45,
+	0x59, 0xb8, 0x3d, 0x4a, 0xde, 0x8f, 0x4d, 0xaa, 0x9a, 0xd4, 0x28, 0x03, 0xb4, 0x48, 0x39, 0x67,
+	0xaf, 0xa7, 0x72, 0x88, 0x15, 0xd8, 0xd3, 0x1b, 0x81, 0xad, 0x98, 0xaf, 0xd8, 0x55, 0x77, 0xf2,
+	0x4a, 0x24, 0xb9, 0x13, 0x59, 0xec, 0x21, 0xdc, 0xe1, 0x74, 0x38, 0x05, 0xbc, 0x74, 0xfb, 0xc5,
+	0xbb, 0xea, 0xcd, 0x26, 0x4d, 0xc9, 0x9c, 0x80, 0xfd, 0x1d, 0x5a, 0x8a, 0x80, 0x77, 0xc0, 0x8d,
+	0x21, 0x11, 0xdd, 0x30, 0x48, 0x0c, 0xf0, 0xbd, 0xdb, 0xef, 0xc7, 0xeb, 0xfc, 0xd9, 0x04, 0x2b,
+	0xf6, 0x57, 0x86, 0x9b, 0x6d, 0x0e, 0x71, 0x4a, 0xe3, 0xce, 0x29, 0x0d, 0x0c, 0xec, 0xfd, 0xdb,
+	0x6f, 0x8e, 0x49, 0x4f, 0x36, 0x99, 0x4f, 0x19, 0x59, 0xfd, 0x78, 0x34, 0xf6, 0x7a, 0x69, 0xfd,
+	0x3c, 0xb8, 0x7d, 0xfd, 0xe4, 0xfd, 0xc8, 0xab, 0xb5, 0x22, 0x35, 0x0a, 0x47, 0x0b, 0x5d, 0x0e,
+	0x7d, 0x97, 0xd3, 0x58, 0x7e, 0x24, 0x35, 0x54, 0x4b, 0x41, 0x3d, 0xb9, 0x11, 0x54, 0x2b, 0xbd,
+	0xac, 0x4c, 0x39, 0x93, 0x2d, 0x86, 0x43, 0x9f, 0x28, 0x96, 0xc2, 0x3c, 0x2a, 0x95, 0xeb, 0xcd,
+	0xc6, 0x51, 0xa9, 0xdc, 0x68, 0x36, 0x8f, 0x4a, 0xe5, 0x66, 0x73, 0xe1, 0xa8, 0x54, 0x5e, 0x6c,
+	0x2e, 0x91, 0xf9, 0x21, 0x0b, 0x99, 0xdb, 0xff, 0x54, 0x1b, 0x92, 0x2a, 0xbc, 0xa6, 0xc2, 0xf4,
+	0x65, 0x52, 0xf7, 0x68, 0x42, 0xc3, 0xa1, 0x30, 0xcb, 0x43, 0x9a, 0x7a, 0xd1, 0x72, 0x27, 0x85,
+	0x4d, 0x34, 0xf3, 0x22, 0x91, 0x87, 0xec, 0x26, 0x2a, 0x9e, 0xc1, 0xd0, 0x1c, 0x16, 0xe5, 0x50,
+	0x5e, 0x08, 0xfa, 0x34, 0xec, 0x41, 0x7a, 0x21, 0x50, 0x84, 0x7d, 0x8c, 0x1a, 0x27, 0x9c, 0xc6,
+	0x42, 0xde, 0xf6, 0x59, 0xfc, 0x8c, 0x75, 0xd4, 0xed, 0x53, 0x7d, 0x89, 0xcd, 0xed, 0x53, 0x8e,
+	0xf1, 0x0f, 0x50, 0x29, 0x64, 0x9d, 0xf4, 0xdc, 0x7b, 0xef, 0xea, 0xb9, 0xf7, 0x19, 0xeb, 0x10,
+	0xa5, 0x62, 0xff, 0xeb, 0x2e, 0x2a, 0x3e, 0x63, 0x9d, 0x77, 0x1c, 0x59, 0xef, 0xa3, 0xd9, 0x84,
+	0x75, 0x03, 0xcf, 0x9c, 0x1e, 0x89, 0xa1, 0x24, 0xb0, 0xba, 0xf5, 0xc8, 0xb3, 0x4c, 0x8d, 0xa8,
+	0x31, 0xde, 0x46, 0x35, 0x35, 0x33, 0x37, 0xee, 0x45, 0x6d, 0xe0, 0xea, 0x48, 0x52, 0x72, 0x1a,
+	0x17, 0x23, 0xab, 0xaa, 0xf8, 0x5f, 0x29, 0x36, 0xc9, 0x13, 0xf8, 0x23, 0x34, 0x97, 0x0c, 0xf2,
+	0xa7, 0x89, 0xc5, 0x8b, 0x91, 0xd5, 0x48, 0xc6, 0xd3, 0x94, 0x87, 0x05, 0x32, 0x9b, 0x0c, 0xe4,
+	0x3f, 0xde, 0x44, 0xe5, 0x64, 0xe0, 0x06, 0xb1, 0x0f, 0x03, 0x75, 0x60, 0x28, 0x39, 0x4b, 0x17,
+	0x23, 0xab, 0x99, 0x53, 0x3f, 0x94, 0x32, 0x32, 0x97, 0x0c, 0xd4, 0x00, 0x7f, 0x84, 0x90, 0x0e,
+	0x49, 0x21, 0xe8, 0xcf, 0xfd, 0xfc, 0xc5, 0xc8, 0xaa, 0x28, 0xae, 0xf2, 0x3d, 0x1e, 0x62, 0x1b,
+	0xcd, 0x68, 0xdf, 0x65, 0xe5, 0xbb, 0x76, 0x31, 0xb2, 0xca, 0x21, 0xeb, 0x68, 0x9f, 0x5a, 0x24,
+	0x53, 0xc5, 0x21, 0x62, 0x7d, 0xf0, 0xd5, 0x17, 0xb5, 0x4c, 0x52, 0xd2, 0xfe, 0xe3, 0x5d, 0x54,
+	0x3e, 0x19, 0x10, 0x10, 0xbd, 0x30, 0x91, 0x27, 0xe7, 0xf4, 0x40, 0xec, 0x4e, 0xa4, 0x36, 0x7f,
+	0x72, 0x9e, 0xd6, 0xb0, 0x49, 0x23, 0x65, 0xed, 0x9a, 0xfc, 0x2f, 0xa1, 0x99, 0x76, 0xc8, 0x58,
+	0xa4, 0x2a, 0xa1, 0x46, 0x34, 0x81, 0x89, 0xca, 0x9a, 0x5a, 0xe5, 0xe2, 0x5a, 0xe1, 0xfa, 0xdb,
+	0xcd, 0x54, 0xa9, 0x38, 0xf7, 0xcd, 0xed, 0xa6, 0xae, 0xb1, 0x8d, 0xbd, 0x2d, 0x73, 0xab, 0x4a,
+	0xa9, 0x89, 0x8a, 0x1c, 0x12, 0xb5, 0x68, 0x35, 0x22, 0x87, 0xf2, 0xca, 0xaa, 0x1f, 0x18, 0xc0,
+	0x57, 0x8b, 0x53, 0x26, 0x19, 0x8d, 0x97, 0x91, 0x7c, 0xbb, 0x70, 0x7b, 0x02, 0x7c, 0xbd, 0x12,
+	0x64, 0xae, 0x43, 0xc5, 0x4b, 0x01, 0xfe, 0xe3, 0xd2, 0x1f, 0xde, 0x58, 0x77, 0x6c, 0x8a, 0xaa,
+	0xbb, 0x9e, 0x07, 0x42, 0x9c, 0xf4, 0xba, 0x21, 0xbc, 0xa3, 0xc2, 0xb6, 0x51, 0x4d, 0x24, 0x8c,
+	0xd3, 0x0e, 0xb8, 0x67, 0x30, 0x4c, 0x6f, 0x29, 0xaa, 0x6a, 0x0c, 0xff, 0x67, 0x30, 0x14, 0x24,
+	0x4f, 0x18, 0x88, 0x37, 0x25, 0x54, 0x3d, 0xe1, 0xd4, 0x03, 0x73, 0xab, 0x90, 0xb5, 0x2a, 0xc9,
+	0xf4, 0x3d, 0xc1, 0x50, 0x12, 0x3b, 0x09, 0x22, 0x60, 0xbd, 0xf4, 0x82, 0x9d, 0x92, 0xd2, 0x82,
+	0x03, 0x0c, 0xc0, 0x53, 0x69, 0x2c, 0x11, 0x43, 0xe1, 0x1d, 0x34, 0xef, 0x07, 0x42, 0x3d, 0x3f,
+	0x8a, 0x84, 0x7a, 0x67, 0x7a, 0xfa, 0x4e, 0xf3, 0x62, 0x64, 0xd5, 0x8c, 0xe0, 0x85, 0xe4, 0x93,
+	0x09, 0x0a, 0x7f, 0x8e, 0x1a, 0x63, 0x33, 0x15, 0xad, 0x7e, 0xf0, 0x73, 0xf0, 0xc5, 0xc8, 0xaa,
+	0x67, 0xaa, 0x4a, 0x42, 0xa6, 0x68, 0xfd, 0x60, 0xd3, 0xee, 0x75, 0x54, 0xf1, 0x95, 0x89, 0x26,
+	0x24, 0x57, 0x3f, 0x23, 0xc9, 0x62, 0x9b, 0x21, 0x9a, 0xc0, 0x9f, 0xa3, 0x0a, 0xeb, 0x03, 0xe7,
+	0x81, 0x0f, 0x42, 0x1d, 0xaf, 0xbe, 0xeb, 0xed, 0x92, 0x8c, 0xf5, 0xe5, 0xe4, 0xcc, 0xd3, 0x6a,
+	0x04, 0x11, 0xe3, 0xc3, 0x56, 0x75, 0x3c, 0x39, 0x2d, 0xf8, 0xb9, 0xe2, 0x93, 0x09, 0x0a, 0x3b,
+	0x08, 0x1b, 0x33, 0x0e, 0x49, 0x8f, 0xc7, 0xae, 0xda, 0xff, 0x35, 0x65, 0xab, 0x76, 0xa1, 0x96,
+	0x12, 0x25, 0xdc, 0xa7, 0x09, 0x25, 0x57, 0x38, 0xf8, 0xa7, 0x08, 0xeb, 0x35, 0x71, 0xbf, 0x16,
+	0x2c, 0x7b, 0x7c, 0xd5, 0xc7, 0x19, 0x85, 0xaf, 0xa5, 0x26, 0xe6, 0xa6, 0xa6, 0x8e, 0x04, 0x33,
+	0xb3, 0x38, 0x2a, 0x95, 0x4b, 0xcd, 0x99, 0xa3, 0x52, 0x79, 0xae, 0x59, 0xce, 0xf2, 0x67, 0x66,
+	0x41, 0x16, 0x53, 0x3a, 0x17, 0x9e, 0xf3, 0xc5, 0x37, 0xe7, 0xab, 0x85, 0x6f, 0xcf, 0x57, 0x0b,
+	0xff, 0x3d, 0x5f, 0x2d, 0xfc, 0xe9, 0xed, 0xea, 0x9d, 0x6f, 0xdf, 0xae, 0xde, 0xf9, 0xf7, 0xdb,
+	0xd5, 0x3b, 0xbf, 0xca, 0x7f, 0x28, 0xa0, 0x2f, 0xbf, 0x13, 0xfa, 0xb7, 0xbf, 0xb5, 0xbd, 0x39,
+	0x90, 0x63, 0xfd, 0xb1, 0x68, 0xcf, 0xaa, 0x97, 0xf2, 0x4f, 0xff, 0x37, 0x00, 0x64, 0x34, 0x76,
+	0x85, 0x8f, 0x17, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1465,421 +1577,3126 @@ func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TraceConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TracerJsonConfig) > 0 {
-		i -= len(m.TracerJsonConfig)
-		copy(dAtA[i:], m.TracerJsonConfig)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
-		i--
-		dAtA[i] = 0x6a
+	if len(m.EvmHooks) > 0 {
+		for iNdEx := len(m.EvmHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	if m.EnableReturnData {
-		i--
-		if m.EnableReturnData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
-		i--
-		dAtA[i] = 0x60
 	}
-	if m.EnableMemory {
-		i--
-		if m.EnableMemory {
-			dAtA[i] = 1
+	if len(m.EventBridges) > 0 {
+		for iNdEx := len(m.EventBridges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventBridges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.AllowUnprotectedTxs {
+		i--
+		if m.AllowUnprotectedTxs {
+			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x30
 	}
-	if m.Overrides != nil {
-		{
-			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	{
+		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x52
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.Limit != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ExtraEIPs) > 0 {
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
+		for _, num1 := range m.ExtraEIPs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x22
 	}
-	if m.Debug {
+	if m.EnableCall {
 		i--
-		if m.Debug {
+		if m.EnableCall {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x18
 	}
-	if m.DisableStorage {
+	if m.EnableCreate {
 		i--
-		if m.DisableStorage {
+		if m.EnableCreate {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x10
 	}
-	if m.DisableStack {
+	if len(m.EvmDenom) > 0 {
+		i -= len(m.EvmDenom)
+		copy(dAtA[i:], m.EvmDenom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EvmDenom)))
 		i--
-		if m.DisableStack {
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmHookParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmHookParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmHookParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevertOnError {
+		i--
+		if m.RevertOnError {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.Reexec != 0 {
-		i = encodeVarintEvm(dAtA, i, uint64(m.Reexec))
-		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Timeout) > 0 {
-		i -= len(m.Timeout)
-		copy(dAtA[i:], m.Timeout)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Timeout)))
+	if m.GasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasLimit))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Tracer) > 0 {
-		i -= len(m.Tracer)
-		copy(dAtA[i:], m.Tracer)
-		i = encodeVarintEvm(dAtA, i, uint64(len(m.Tracer)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.EnableCreate {
-		n += 2
-	}
-	if m.EnableCall {
-		n += 2
+	if len(m.PriceOracle) > 0 {
+		i -= len(m.PriceOracle)
+		copy(dAtA[i:], m.PriceOracle)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.PriceOracle)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ExtraEIPs) > 0 {
-		l = 0
-		for _, e := range m.ExtraEIPs {
-			l += sovEvm(uint64(e))
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovEvm(uint64(l)) + l
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	l = m.ChainConfig.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	if m.AllowUnprotectedTxs {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeePayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HomesteadBlock != nil {
-		l = m.HomesteadBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.DAOForkBlock != nil {
-		l = m.DAOForkBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.DAOForkSupport {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractABI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.EIP150Block != nil {
-		l = m.EIP150Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ContractABI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.EIP150Hash)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.ABI) > 0 {
+		i -= len(m.ABI)
+		copy(dAtA[i:], m.ABI)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ABI)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.EIP155Block != nil {
-		l = m.EIP155Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EIP158Block != nil {
-		l = m.EIP158Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ByzantiumBlock != nil {
-		l = m.ByzantiumBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ConstantinopleBlock != nil {
-		l = m.ConstantinopleBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.PetersburgBlock != nil {
-		l = m.PetersburgBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.IstanbulBlock != nil {
-		l = m.IstanbulBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.MuirGlacierBlock != nil {
-		l = m.MuirGlacierBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.BerlinBlock != nil {
-		l = m.BerlinBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.LondonBlock != nil {
-		l = m.LondonBlock.Size()
-		n += 2 + l + sovEvm(uint64(l))
-	}
-	if m.ArrowGlacierBlock != nil {
-		l = m.ArrowGlacierBlock.Size()
-		n += 2 + l + sovEvm(uint64(l))
-	}
-	if m.GrayGlacierBlock != nil {
-		l = m.GrayGlacierBlock.Size()
-		n += 2 + l + sovEvm(uint64(l))
-	}
-	if m.MergeNetsplitBlock != nil {
-		l = m.MergeNetsplitBlock.Size()
-		n += 2 + l + sovEvm(uint64(l))
-	}
-	if m.ShanghaiBlock != nil {
-		l = m.ShanghaiBlock.Size()
-		n += 2 + l + sovEvm(uint64(l))
-	}
-	if m.CancunBlock != nil {
-		l = m.CancunBlock.Size()
-		n += 2 + l + sovEvm(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TransactionLogs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Permissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Permissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.DeniedSenders) > 0 {
+		for iNdEx := len(m.DeniedSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedSenders[iNdEx])
+			copy(dAtA[i:], m.DeniedSenders[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DeniedSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovEvm(uint64(l))
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Create.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Log) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if len(m.Topics) > 0 {
-		for _, s := range m.Topics {
-			l = len(s)
-			n += 1 + l + sovEvm(uint64(l))
+	if len(m.AllowedDeployers) > 0 {
+		for iNdEx := len(m.AllowedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedDeployers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovEvm(uint64(m.BlockNumber))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovEvm(uint64(m.TxIndex))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovEvm(uint64(m.Index))
-	}
-	if m.Removed {
-		n += 2
+	if m.Permissioned {
+		i--
+		if m.Permissioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TxResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllowedDeployer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDeployer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDeployer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	l = len(m.Bloom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	l = m.TxLogs.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.Reverted {
-		n += 2
+	if len(m.CodeHashes) > 0 {
+		for iNdEx := len(m.CodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeHashes[iNdEx])
+			copy(dAtA[i:], m.CodeHashes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.GasUsed != 0 {
-		n += 1 + sovEvm(uint64(m.GasUsed))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccessTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CallPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CallPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.PublicContracts) > 0 {
+		for iNdEx := len(m.PublicContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicContracts[iNdEx])
+			copy(dAtA[i:], m.PublicContracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.PublicContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.StorageKeys) > 0 {
-		for _, s := range m.StorageKeys {
-			l = len(s)
-			n += 1 + l + sovEvm(uint64(l))
+	if len(m.AllowedSenders) > 0 {
+		for iNdEx := len(m.AllowedSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSenders[iNdEx])
+			copy(dAtA[i:], m.AllowedSenders[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
-}
-
-func (m *TraceConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Permissioned {
+		i--
+		if m.Permissioned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Tracer)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if m.PrevRandaoBlock != nil {
+		{
+			size := m.PrevRandaoBlock.Size()
+			i -= size
+			if _, err := m.PrevRandaoBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	l = len(m.Timeout)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if m.CancunBlock != nil {
+		{
+			size := m.CancunBlock.Size()
+			i -= size
+			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.Reexec != 0 {
-		n += 1 + sovEvm(uint64(m.Reexec))
+	if m.ShanghaiBlock != nil {
+		{
+			size := m.ShanghaiBlock.Size()
+			i -= size
+			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.DisableStack {
-		n += 2
+	if m.MergeNetsplitBlock != nil {
+		{
+			size := m.MergeNetsplitBlock.Size()
+			i -= size
+			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.DisableStorage {
-		n += 2
+	if m.GrayGlacierBlock != nil {
+		{
+			size := m.GrayGlacierBlock.Size()
+			i -= size
+			if _, err := m.GrayGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.Debug {
-		n += 2
+	if m.ArrowGlacierBlock != nil {
+		{
+			size := m.ArrowGlacierBlock.Size()
+			i -= size
+			if _, err := m.ArrowGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEvm(uint64(m.Limit))
+	if m.LondonBlock != nil {
+		{
+			size := m.LondonBlock.Size()
+			i -= size
+			if _, err := m.LondonBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.Overrides != nil {
-		l = m.Overrides.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.BerlinBlock != nil {
+		{
+			size := m.BerlinBlock.Size()
+			i -= size
+			if _, err := m.BerlinBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.EnableMemory {
-		n += 2
+	if m.MuirGlacierBlock != nil {
+		{
+			size := m.MuirGlacierBlock.Size()
+			i -= size
+			if _, err := m.MuirGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.EnableReturnData {
-		n += 2
+	if m.IstanbulBlock != nil {
+		{
+			size := m.IstanbulBlock.Size()
+			i -= size
+			if _, err := m.IstanbulBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.TracerJsonConfig)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if m.PetersburgBlock != nil {
+		{
+			size := m.PetersburgBlock.Size()
+			i -= size
+			if _, err := m.PetersburgBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ConstantinopleBlock != nil {
+		{
+			size := m.ConstantinopleBlock.Size()
+			i -= size
+			if _, err := m.ConstantinopleBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ByzantiumBlock != nil {
+		{
+			size := m.ByzantiumBlock.Size()
+			i -= size
+			if _, err := m.ByzantiumBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EIP158Block != nil {
+		{
+			size := m.EIP158Block.Size()
+			i -= size
+			if _, err := m.EIP158Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EIP155Block != nil {
+		{
+			size := m.EIP155Block.Size()
+			i -= size
+			if _, err := m.EIP155Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EIP150Hash) > 0 {
+		i -= len(m.EIP150Hash)
+		copy(dAtA[i:], m.EIP150Hash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.EIP150Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EIP150Block != nil {
+		{
+			size := m.EIP150Block.Size()
+			i -= size
+			if _, err := m.EIP150Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DAOForkSupport {
+		i--
+		if m.DAOForkSupport {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DAOForkBlock != nil {
+		{
+			size := m.DAOForkBlock.Size()
+			i -= size
+			if _, err := m.DAOForkBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HomesteadBlock != nil {
+		{
+			size := m.HomesteadBlock.Size()
+			i -= size
+			if _, err := m.HomesteadBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reverted {
+		i--
+		if m.Reverted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TxLogs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bloom) > 0 {
+		i -= len(m.Bloom)
+		copy(dAtA[i:], m.Bloom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Bloom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageKeys) > 0 {
+		for iNdEx := len(m.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageKeys[iNdEx])
+			copy(dAtA[i:], m.StorageKeys[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.StorageKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TracerJsonConfig) > 0 {
+		i -= len(m.TracerJsonConfig)
+		copy(dAtA[i:], m.TracerJsonConfig)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.EnableReturnData {
+		i--
+		if m.EnableReturnData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EnableMemory {
+		i--
+		if m.EnableMemory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Overrides != nil {
+		{
+			size, err := m.Overrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Debug {
+		i--
+		if m.Debug {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DisableStorage {
+		i--
+		if m.DisableStorage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisableStack {
+		i--
+		if m.DisableStack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Reexec != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Reexec))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Timeout) > 0 {
+		i -= len(m.Timeout)
+		copy(dAtA[i:], m.Timeout)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Timeout)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tracer) > 0 {
+		i -= len(m.Tracer)
+		copy(dAtA[i:], m.Tracer)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Tracer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EnableCreate {
+		n += 2
+	}
+	if m.EnableCall {
+		n += 2
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovEvm(uint64(e))
+		}
+		n += 1 + sovEvm(uint64(l)) + l
+	}
+	l = m.ChainConfig.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.AllowUnprotectedTxs {
+		n += 2
+	}
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.Permissions.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.EventBridges) > 0 {
+		for _, e := range m.EventBridges {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.EvmHooks) > 0 {
+		for _, e := range m.EvmHooks {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmHookParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.GasLimit))
+	}
+	if m.RevertOnError {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.PriceOracle)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *FeePayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *ContractABI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ABI)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.DeniedSenders) > 0 {
+		for _, s := range m.DeniedSenders {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permissioned {
+		n += 2
+	}
+	if len(m.AllowedDeployers) > 0 {
+		for _, e := range m.AllowedDeployers {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedDeployer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.CodeHashes) > 0 {
+		for _, s := range m.CodeHashes {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permissioned {
+		n += 2
+	}
+	if len(m.AllowedSenders) > 0 {
+		for _, s := range m.AllowedSenders {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.PublicContracts) > 0 {
+		for _, s := range m.PublicContracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HomesteadBlock != nil {
+		l = m.HomesteadBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkBlock != nil {
+		l = m.DAOForkBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkSupport {
+		n += 2
+	}
+	if m.EIP150Block != nil {
+		l = m.EIP150Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.EIP150Hash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EIP155Block != nil {
+		l = m.EIP155Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EIP158Block != nil {
+		l = m.EIP158Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ByzantiumBlock != nil {
+		l = m.ByzantiumBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ConstantinopleBlock != nil {
+		l = m.ConstantinopleBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.PetersburgBlock != nil {
+		l = m.PetersburgBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.IstanbulBlock != nil {
+		l = m.IstanbulBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.MuirGlacierBlock != nil {
+		l = m.MuirGlacierBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.BerlinBlock != nil {
+		l = m.BerlinBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.LondonBlock != nil {
+		l = m.LondonBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.ArrowGlacierBlock != nil {
+		l = m.ArrowGlacierBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.GrayGlacierBlock != nil {
+		l = m.GrayGlacierBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.MergeNetsplitBlock != nil {
+		l = m.MergeNetsplitBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.ShanghaiBlock != nil {
+		l = m.ShanghaiBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.CancunBlock != nil {
+		l = m.CancunBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	if m.PrevRandaoBlock != nil {
+		l = m.PrevRandaoBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *State) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Log) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovEvm(uint64(m.BlockNumber))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovEvm(uint64(m.TxIndex))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovEvm(uint64(m.Index))
+	}
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Bloom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.TxLogs.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Reverted {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvm(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *AccessTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.StorageKeys) > 0 {
+		for _, s := range m.StorageKeys {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TraceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tracer)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Reexec != 0 {
+		n += 1 + sovEvm(uint64(m.Reexec))
+	}
+	if m.DisableStack {
+		n += 2
+	}
+	if m.DisableStorage {
+		n += 2
+	}
+	if m.Debug {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEvm(uint64(m.Limit))
+	}
+	if m.Overrides != nil {
+		l = m.Overrides.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EnableMemory {
+		n += 2
+	}
+	if m.EnableReturnData {
+		n += 2
+	}
+	l = len(m.TracerJsonConfig)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func sovEvm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozEvm(x uint64) (n int) {
+	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCreate = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCall = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBridges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBridges = append(m.EventBridges, EventBridge{})
+			if err := m.EventBridges[len(m.EventBridges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmHooks = append(m.EvmHooks, EvmHookParams{})
+			if err := m.EvmHooks[len(m.EvmHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EvmHookParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmHookParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmHookParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertOnError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevertOnError = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeePayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractABI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractABI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractABI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ABI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ABI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventBridge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Permissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedSenders = append(m.DeniedSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CreatePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissioned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permissioned = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDeployers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDeployers = append(m.AllowedDeployers, AllowedDeployer{})
+			if err := m.AllowedDeployers[len(m.AllowedDeployers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
-
-func sovEvm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
 
-func sozEvm(x uint64) (n int) {
-	return sovEvm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AllowedDeployer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1902,15 +4719,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedDeployer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedDeployer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1938,13 +4755,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHashes", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1954,15 +4771,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableCreate = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHashes = append(m.CodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CallPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissioned", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1979,88 +4859,12 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableCall = bool(v != 0)
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExtraEIPs = append(m.ExtraEIPs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvm
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvm
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
-					m.ExtraEIPs = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtraEIPs = append(m.ExtraEIPs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
-			}
-		case 5:
+			m.Permissioned = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSenders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2070,30 +4874,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedSenders = append(m.AllowedSenders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicContracts", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2103,12 +4906,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowUnprotectedTxs = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicContracts = append(m.PublicContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2824,6 +5639,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRandaoBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.PrevRandaoBlock = &v
+			if err := m.PrevRandaoBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,47 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GetEvmHook returns the execution policy of the EVM hook with the given name,
+// if set.
+func (p Params) GetEvmHook(name string) (EvmHookParams, bool) {
+	for _, hook := range p.EvmHooks {
+		if hook.Name == name {
+			return hook, true
+		}
+	}
+	return EvmHookParams{}, false
+}
+
+func validateEvmHooks(hooks []EvmHookParams) error {
+	seen := make(map[string]bool, len(hooks))
+	for _, hook := range hooks {
+		if hook.Name == "" {
+			return errors.New("EVM hook name cannot be empty")
+		}
+
+		if seen[hook.Name] {
+			return fmt.Errorf("duplicated EVM hook %s", hook.Name)
+		}
+		seen[hook.Name] = true
+	}
+	return nil
+}
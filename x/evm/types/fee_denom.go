@@ -0,0 +1,196 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// PriceOracleAmountIn is the amount of the fee denomination, in base units, for
+// which the price oracles are consulted. The exchange rate is the returned
+// amount of the EVM denomination divided by PriceOracleAmountIn.
+var PriceOracleAmountIn = big.NewInt(1e18)
+
+// PriceOracleABI is the ABI of the TWAP price oracles of the fee denominations.
+var PriceOracleABI abi.ABI
+
+func init() {
+	var err error
+	PriceOracleABI, err = abi.JSON(strings.NewReader(`[{
+		"type": "function",
+		"name": "consult",
+		"stateMutability": "view",
+		"inputs": [{"name": "token", "type": "address"}, {"name": "amountIn", "type": "uint256"}],
+		"outputs": [{"name": "amountOut", "type": "uint256"}]
+	}]`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var (
+	// FeeDenomSelectorAddress is the reserved address of the access list entry
+	// that selects the fee denomination paying the fees of an Ethereum tx, so
+	// that the choice is part of the signed tx.
+	FeeDenomSelectorAddress = common.BytesToAddress(crypto.Keccak256([]byte("fee-denom")))
+	// FeeDenomConvertERC20Key is the storage key of the selector entry that
+	// allows the conversion of the ERC-20 tokens of the fee denomination token
+	// pair when the balance of the fee denomination doesn't cover the fees.
+	FeeDenomConvertERC20Key = crypto.Keccak256Hash([]byte("convert-erc20"))
+)
+
+// FeeDenomChoice is the fee denomination chosen to pay the fees of an Ethereum tx.
+type FeeDenomChoice struct {
+	FeeDenom     FeeDenom
+	ConvertERC20 bool
+}
+
+// FeeDenomAccessListKey returns the storage key of the selector entry that
+// selects the given fee denomination, i.e. keccak256(denom).
+func FeeDenomAccessListKey(denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom))
+}
+
+// FeeDenomAccessTuple returns the access list entry that selects the given fee
+// denomination to pay the fees of an Ethereum tx.
+func FeeDenomAccessTuple(denom string, convertERC20 bool) ethtypes.AccessTuple {
+	tuple := ethtypes.AccessTuple{
+		Address:     FeeDenomSelectorAddress,
+		StorageKeys: []common.Hash{FeeDenomAccessListKey(denom)},
+	}
+	if convertERC20 {
+		tuple.StorageKeys = append(tuple.StorageKeys, FeeDenomConvertERC20Key)
+	}
+	return tuple
+}
+
+// FeeDenomChoiceFromAccessList returns the fee denomination selected by the
+// access list of an Ethereum tx, if any, among the accepted fee denominations.
+// It returns an error if the selector entry is invalid or selects a fee
+// denomination that isn't accepted.
+func FeeDenomChoiceFromAccessList(accessList ethtypes.AccessList, feeDenoms []FeeDenom) (FeeDenomChoice, bool, error) {
+	var (
+		choice   FeeDenomChoice
+		selected bool
+		found    bool
+	)
+	for _, tuple := range accessList {
+		if tuple.Address != FeeDenomSelectorAddress {
+			continue
+		}
+		found = true
+
+		for _, key := range tuple.StorageKeys {
+			if key == FeeDenomConvertERC20Key {
+				choice.ConvertERC20 = true
+				continue
+			}
+			if selected {
+				return FeeDenomChoice{}, false, fmt.Errorf("more than one fee denom selected")
+			}
+
+			feeDenom, ok := feeDenomByKey(feeDenoms, key)
+			if !ok {
+				return FeeDenomChoice{}, false, fmt.Errorf("selected fee denom %s is not accepted", key)
+			}
+			choice.FeeDenom = feeDenom
+			selected = true
+		}
+	}
+
+	if found && !selected {
+		return FeeDenomChoice{}, false, fmt.Errorf("no fee denom selected")
+	}
+	return choice, found, nil
+}
+
+// feeDenomByKey returns the fee denomination of the given selector storage key.
+func feeDenomByKey(feeDenoms []FeeDenom, key common.Hash) (FeeDenom, bool) {
+	for _, feeDenom := range feeDenoms {
+		if FeeDenomAccessListKey(feeDenom.Denom) == key {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// validateFeeDenoms checks that the given fee denominations are valid, unique
+// and different from the EVM denomination.
+func validateFeeDenoms(feeDenoms []FeeDenom, evmDenom string) error {
+	seen := make(map[string]bool, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return fmt.Errorf("invalid fee denom %s: %w", feeDenom.Denom, err)
+		}
+
+		if feeDenom.Denom == evmDenom {
+			return fmt.Errorf("fee denom %s is the evm denom", feeDenom.Denom)
+		}
+
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicated fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the fee denomination. Either the
+// rate or the price oracle must be set.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if fd.Rate.IsNil() {
+		return fmt.Errorf("rate cannot be nil")
+	}
+
+	if fd.Rate.IsNegative() {
+		return fmt.Errorf("rate cannot be negative: %s", fd.Rate)
+	}
+
+	if fd.PriceOracle == "" {
+		if !fd.Rate.IsPositive() {
+			return fmt.Errorf("rate must be positive if the price oracle is not set")
+		}
+		return nil
+	}
+
+	if fd.Rate.IsPositive() {
+		return fmt.Errorf("rate must be zero if the price oracle is set")
+	}
+
+	return evmostypes.ValidateNonZeroAddress(fd.PriceOracle)
+}
+
+// ConvertFee returns the amount of the fee denomination that pays for the given
+// amount of the EVM denomination at the given rate, rounded up.
+func ConvertFee(evmDenomAmount sdkmath.Int, rate sdk.Dec) sdkmath.Int {
+	return sdk.NewDecFromInt(evmDenomAmount).Quo(rate).Ceil().TruncateInt()
+}
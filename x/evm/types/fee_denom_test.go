@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeDenomChoiceFromAccessList(t *testing.T) {
+	feeDenoms := []FeeDenom{
+		{Denom: "uatom", Rate: sdk.OneDec()},
+		{Denom: "uusdc", Rate: sdk.OneDec()},
+	}
+	other := ethtypes.AccessTuple{Address: common.Address{1}, StorageKeys: []common.Hash{{1}}}
+
+	testCases := []struct {
+		name       string
+		accessList ethtypes.AccessList
+		expChoice  FeeDenomChoice
+		expFound   bool
+		expError   bool
+	}{
+		{"no access list", nil, FeeDenomChoice{}, false, false},
+		{"no selector", ethtypes.AccessList{other}, FeeDenomChoice{}, false, false},
+		{
+			"fee denom selected",
+			ethtypes.AccessList{other, FeeDenomAccessTuple("uusdc", false)},
+			FeeDenomChoice{FeeDenom: feeDenoms[1]},
+			true,
+			false,
+		},
+		{
+			"fee denom selected with the ERC-20 conversion",
+			ethtypes.AccessList{FeeDenomAccessTuple("uatom", true)},
+			FeeDenomChoice{FeeDenom: feeDenoms[0], ConvertERC20: true},
+			true,
+			false,
+		},
+		{
+			"fee denom not accepted",
+			ethtypes.AccessList{FeeDenomAccessTuple("uosmo", false)},
+			FeeDenomChoice{},
+			false,
+			true,
+		},
+		{
+			"more than one fee denom selected",
+			ethtypes.AccessList{FeeDenomAccessTuple("uatom", false), FeeDenomAccessTuple("uusdc", false)},
+			FeeDenomChoice{},
+			false,
+			true,
+		},
+		{
+			"no fee denom selected",
+			ethtypes.AccessList{{Address: FeeDenomSelectorAddress, StorageKeys: []common.Hash{FeeDenomConvertERC20Key}}},
+			FeeDenomChoice{},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			choice, found, err := FeeDenomChoiceFromAccessList(tc.accessList, feeDenoms)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expChoice, choice)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// FeeGranterSelectorAddress is the reserved address of the access list entry
+	// that selects the fee granter paying the fees of an Ethereum tx, so that the
+	// choice is part of the signed tx.
+	FeeGranterSelectorAddress = common.BytesToAddress(crypto.Keccak256([]byte("fee-granter")))
+	// FeeGrantContractKey is the storage key of the selector entry that selects
+	// the allowance granted to the called contract instead of the one granted to
+	// the sender.
+	FeeGrantContractKey = crypto.Keccak256Hash([]byte("contract-grantee"))
+)
+
+// FeeGrantChoice is the fee allowance chosen to pay the fees of an Ethereum tx.
+type FeeGrantChoice struct {
+	Granter  sdk.AccAddress
+	Contract bool
+}
+
+// Grantee returns the grantee of the chosen fee allowance: the called contract
+// if the allowance granted to it is chosen, otherwise the sender.
+func (c FeeGrantChoice) Grantee(from sdk.AccAddress, to *common.Address) (sdk.AccAddress, error) {
+	if !c.Contract {
+		return from, nil
+	}
+	if to == nil {
+		return nil, fmt.Errorf("contract fee allowance selected by a contract creation")
+	}
+	return to.Bytes(), nil
+}
+
+// FeeGranterAccessListKey returns the storage key of the selector entry that
+// selects the given fee granter, i.e. its address left-padded to 32 bytes.
+func FeeGranterAccessListKey(granter sdk.AccAddress) common.Hash {
+	return common.BytesToHash(granter)
+}
+
+// FeeGranterAccessTuple returns the access list entry that selects the fee
+// allowance of the given granter to pay the fees of an Ethereum tx, granted to
+// the called contract if contract is true, otherwise to the sender.
+func FeeGranterAccessTuple(granter sdk.AccAddress, contract bool) ethtypes.AccessTuple {
+	tuple := ethtypes.AccessTuple{
+		Address:     FeeGranterSelectorAddress,
+		StorageKeys: []common.Hash{FeeGranterAccessListKey(granter)},
+	}
+	if contract {
+		tuple.StorageKeys = append(tuple.StorageKeys, FeeGrantContractKey)
+	}
+	return tuple
+}
+
+// FeeGrantChoiceFromAccessList returns the fee allowance selected by the access
+// list of an Ethereum tx, if any. It returns an error if the selector entry is
+// invalid.
+func FeeGrantChoiceFromAccessList(accessList ethtypes.AccessList) (FeeGrantChoice, bool, error) {
+	var (
+		choice   FeeGrantChoice
+		selected bool
+		found    bool
+	)
+	for _, tuple := range accessList {
+		if tuple.Address != FeeGranterSelectorAddress {
+			continue
+		}
+		found = true
+
+		for _, key := range tuple.StorageKeys {
+			if key == FeeGrantContractKey {
+				choice.Contract = true
+				continue
+			}
+			if selected {
+				return FeeGrantChoice{}, false, fmt.Errorf("more than one fee granter selected")
+			}
+
+			// the granter address is left-padded with zeros
+			if !bytes.Equal(key[:common.HashLength-common.AddressLength], make([]byte, common.HashLength-common.AddressLength)) {
+				return FeeGrantChoice{}, false, fmt.Errorf("invalid fee granter key %s", key)
+			}
+			choice.Granter = common.BytesToAddress(key.Bytes()).Bytes()
+			selected = true
+		}
+	}
+
+	if found && !selected {
+		return FeeGrantChoice{}, false, fmt.Errorf("no fee granter selected")
+	}
+	return choice, found, nil
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeGrantChoiceFromAccessList(t *testing.T) {
+	granter := sdk.AccAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes())
+	other := ethtypes.AccessTuple{Address: common.Address{1}, StorageKeys: []common.Hash{{1}}}
+
+	testCases := []struct {
+		name       string
+		accessList ethtypes.AccessList
+		expChoice  FeeGrantChoice
+		expFound   bool
+		expError   bool
+	}{
+		{"no access list", nil, FeeGrantChoice{}, false, false},
+		{"no selector", ethtypes.AccessList{other}, FeeGrantChoice{}, false, false},
+		{
+			"allowance of the sender selected",
+			ethtypes.AccessList{other, FeeGranterAccessTuple(granter, false)},
+			FeeGrantChoice{Granter: granter},
+			true,
+			false,
+		},
+		{
+			"allowance of the contract selected",
+			ethtypes.AccessList{FeeGranterAccessTuple(granter, true)},
+			FeeGrantChoice{Granter: granter, Contract: true},
+			true,
+			false,
+		},
+		{
+			"invalid granter key",
+			ethtypes.AccessList{{Address: FeeGranterSelectorAddress, StorageKeys: []common.Hash{{1}}}},
+			FeeGrantChoice{},
+			false,
+			true,
+		},
+		{
+			"more than one fee granter selected",
+			ethtypes.AccessList{FeeGranterAccessTuple(granter, false), FeeGranterAccessTuple(granter, false)},
+			FeeGrantChoice{},
+			false,
+			true,
+		},
+		{
+			"no fee granter selected",
+			ethtypes.AccessList{{Address: FeeGranterSelectorAddress, StorageKeys: []common.Hash{FeeGrantContractKey}}},
+			FeeGrantChoice{},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			choice, found, err := FeeGrantChoiceFromAccessList(tc.accessList)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expChoice, choice)
+		})
+	}
+}
+
+func TestFeeGrantChoiceGrantee(t *testing.T) {
+	from := sdk.AccAddress(common.Address{1}.Bytes())
+	to := common.Address{2}
+
+	grantee, err := FeeGrantChoice{}.Grantee(from, &to)
+	require.NoError(t, err)
+	require.Equal(t, from, grantee)
+
+	grantee, err = FeeGrantChoice{Contract: true}.Grantee(from, &to)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(to.Bytes()), grantee)
+
+	_, err = FeeGrantChoice{Contract: true}.Grantee(from, nil)
+	require.Error(t, err)
+}
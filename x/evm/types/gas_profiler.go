@@ -0,0 +1,337 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+func init() {
+	tracers.RegisterLookup(false, func(name string, _ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+		if name != TracerGasProfile {
+			return nil, fmt.Errorf("tracer %s not found", name)
+		}
+		return NewGasProfiler(false), nil
+	})
+}
+
+// GasStats defines the gas used by a contract, function or opcode and the
+// number of times it was called or executed.
+type GasStats struct {
+	Count uint64 `json:"count"`
+	Gas   uint64 `json:"gas"`
+}
+
+func (s *GasStats) add(other GasStats) {
+	s.Count += other.Count
+	s.Gas += other.Gas
+}
+
+// ContractGasProfile defines the gas used by the code of a contract, excluding
+// the gas used by the contracts it calls, with the breakdown per function
+// selector and per opcode.
+type ContractGasProfile struct {
+	GasStats
+	Selectors map[string]*GasStats `json:"selectors,omitempty"`
+	Opcodes   map[string]*GasStats `json:"opcodes,omitempty"`
+}
+
+// GasProfile aggregates the gas used by a set of transactions per contract
+// address, function selector and opcode.
+type GasProfile struct {
+	Transactions uint64                                 `json:"transactions"`
+	GasUsed      uint64                                 `json:"gasUsed"`
+	Contracts    map[common.Address]*ContractGasProfile `json:"contracts"`
+	Opcodes      map[string]*GasStats                   `json:"opcodes"`
+}
+
+// NewGasProfile returns an empty GasProfile.
+func NewGasProfile() *GasProfile {
+	return &GasProfile{
+		Contracts: make(map[common.Address]*ContractGasProfile),
+		Opcodes:   make(map[string]*GasStats),
+	}
+}
+
+// Merge adds the stats of the other profile.
+func (p *GasProfile) Merge(other *GasProfile) {
+	p.Transactions += other.Transactions
+	p.GasUsed += other.GasUsed
+
+	for op, stats := range other.Opcodes {
+		addStats(p.Opcodes, op, *stats)
+	}
+
+	for address, contract := range other.Contracts {
+		profile := p.contract(address)
+		profile.add(contract.GasStats)
+		for selector, stats := range contract.Selectors {
+			addStats(profile.Selectors, selector, *stats)
+		}
+		for op, stats := range contract.Opcodes {
+			addStats(profile.Opcodes, op, *stats)
+		}
+	}
+}
+
+func (p *GasProfile) contract(address common.Address) *ContractGasProfile {
+	profile, ok := p.Contracts[address]
+	if !ok {
+		profile = &ContractGasProfile{
+			Selectors: make(map[string]*GasStats),
+			Opcodes:   make(map[string]*GasStats),
+		}
+		p.Contracts[address] = profile
+	}
+	return profile
+}
+
+func addStats(stats map[string]*GasStats, key string, other GasStats) {
+	s, ok := stats[key]
+	if !ok {
+		s = &GasStats{}
+		stats[key] = s
+	}
+	s.add(other)
+}
+
+var _ tracers.Tracer = &GasProfiler{}
+
+// gasProfileFrame is a call frame of the gas profiler.
+type gasProfileFrame struct {
+	contract *ContractGasProfile
+	selector string
+	// gas is the gas available to the frame
+	gas uint64
+	// childGas is the gas used by the frames called by this one
+	childGas uint64
+	// call is the pending call or create opcode of the frame, its own cost is
+	// only known once the execution of the frame resumes
+	call *pendingGasCall
+}
+
+type pendingGasCall struct {
+	op       string
+	gas      uint64
+	childGas uint64
+}
+
+// GasProfiler is a tracer that aggregates the gas used per contract, function
+// selector and opcode. The gas of a contract excludes the gas used by the
+// contracts it calls, and the gas of the call and create opcodes excludes the
+// gas used by the called code. When enabled as node tracer it reports the
+// profile of every transaction as telemetry metrics.
+type GasProfiler struct {
+	profile   *GasProfile
+	frames    []*gasProfileFrame
+	gasLimit  uint64
+	metrics   bool
+	env       *vm.EVM
+	interrupt uint32 // atomic flag to signal execution interruption
+	reason    error  // textual reason for the interruption
+}
+
+// NewGasProfiler creates a new GasProfiler. If metrics is true, the profile of
+// each transaction is emitted as telemetry metrics when it ends.
+func NewGasProfiler(metrics bool) *GasProfiler {
+	return &GasProfiler{
+		profile: NewGasProfile(),
+		metrics: metrics,
+	}
+}
+
+// Profile returns the aggregated gas profile.
+func (p *GasProfiler) Profile() *GasProfile {
+	return p.profile
+}
+
+// CaptureTxStart implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureTxStart(gasLimit uint64) {
+	p.gasLimit = gasLimit
+	if p.metrics {
+		p.profile = NewGasProfile()
+	}
+}
+
+// CaptureTxEnd implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureTxEnd(restGas uint64) {
+	p.profile.Transactions++
+	if p.gasLimit > restGas {
+		p.profile.GasUsed += p.gasLimit - restGas
+	}
+
+	if p.metrics {
+		p.emitMetrics()
+	}
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureStart(env *vm.EVM, _ common.Address, to common.Address, create bool, input []byte, gas uint64, _ *big.Int) {
+	p.env = env
+	p.enter(to, input, create, gas)
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureEnd(_ []byte, gasUsed uint64, _ time.Duration, _ error) {
+	p.exit(gasUsed)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureEnter(typ vm.OpCode, _ common.Address, to common.Address, input []byte, gas uint64, _ *big.Int) {
+	p.enter(to, input, typ == vm.CREATE || typ == vm.CREATE2, gas)
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureExit(_ []byte, gasUsed uint64, _ error) {
+	p.exit(gasUsed)
+}
+
+// CaptureState implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureState(_ uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+	if atomic.LoadUint32(&p.interrupt) > 0 {
+		p.env.Cancel()
+		return
+	}
+
+	if len(p.frames) == 0 {
+		return
+	}
+
+	frame := p.frames[len(p.frames)-1]
+	p.settleCall(frame, gas)
+
+	switch op {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL, vm.CREATE, vm.CREATE2:
+		// the reported cost includes the gas forwarded to the callee
+		frame.call = &pendingGasCall{op: op.String(), gas: gas}
+		p.addOpcode(frame, op.String(), GasStats{Count: 1})
+	default:
+		p.addOpcode(frame, op.String(), GasStats{Count: 1, Gas: cost})
+	}
+}
+
+// CaptureFault implements vm.EVMLogger interface
+func (p *GasProfiler) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+// GetResult implements tracers.Tracer interface
+func (p *GasProfiler) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(p.profile)
+	if err != nil {
+		return nil, err
+	}
+	return res, p.reason
+}
+
+// Stop implements tracers.Tracer interface
+func (p *GasProfiler) Stop(err error) {
+	p.reason = err
+	atomic.StoreUint32(&p.interrupt, 1)
+}
+
+func (p *GasProfiler) enter(to common.Address, input []byte, create bool, gas uint64) {
+	frame := &gasProfileFrame{
+		contract: p.profile.contract(to),
+		gas:      gas,
+	}
+
+	if !create && len(input) >= 4 {
+		frame.selector = hexutil.Encode(input[:4])
+	}
+
+	p.frames = append(p.frames, frame)
+}
+
+func (p *GasProfiler) exit(gasUsed uint64) {
+	if len(p.frames) == 0 {
+		return
+	}
+
+	frame := p.frames[len(p.frames)-1]
+	p.frames = p.frames[:len(p.frames)-1]
+
+	if gasUsed <= frame.gas {
+		p.settleCall(frame, frame.gas-gasUsed)
+	}
+
+	self := GasStats{Count: 1}
+	if gasUsed > frame.childGas {
+		self.Gas = gasUsed - frame.childGas
+	}
+
+	frame.contract.add(self)
+	if frame.selector != "" {
+		addStats(frame.contract.Selectors, frame.selector, self)
+	}
+
+	if len(p.frames) > 0 {
+		parent := p.frames[len(p.frames)-1]
+		parent.childGas += gasUsed
+		if parent.call != nil {
+			parent.call.childGas += gasUsed
+		}
+	}
+}
+
+// settleCall attributes the cost of the pending call or create opcode of the
+// frame, given the gas left to the frame once the callee returned.
+func (p *GasProfiler) settleCall(frame *gasProfileFrame, gas uint64) {
+	call := frame.call
+	if call == nil {
+		return
+	}
+	frame.call = nil
+
+	if call.gas >= gas+call.childGas {
+		p.addOpcode(frame, call.op, GasStats{Gas: call.gas - gas - call.childGas})
+	}
+}
+
+func (p *GasProfiler) addOpcode(frame *gasProfileFrame, op string, stats GasStats) {
+	addStats(p.profile.Opcodes, op, stats)
+	addStats(frame.contract.Opcodes, op, stats)
+}
+
+// emitMetrics reports the profile of the transaction as telemetry metrics.
+func (p *GasProfiler) emitMetrics() {
+	for address, contract := range p.profile.Contracts {
+		labels := []metrics.Label{telemetry.NewLabel("contract", address.Hex())}
+		telemetry.IncrCounterWithLabels([]string{"evm", "profile", "contract", "gas"}, float32(contract.Gas), labels)
+		telemetry.IncrCounterWithLabels([]string{"evm", "profile", "contract", "calls"}, float32(contract.Count), labels)
+
+		for selector, stats := range contract.Selectors {
+			selectorLabels := append(labels, telemetry.NewLabel("selector", selector))
+			telemetry.IncrCounterWithLabels([]string{"evm", "profile", "selector", "gas"}, float32(stats.Gas), selectorLabels)
+			telemetry.IncrCounterWithLabels([]string{"evm", "profile", "selector", "calls"}, float32(stats.Count), selectorLabels)
+		}
+	}
+
+	for op, stats := range p.profile.Opcodes {
+		labels := []metrics.Label{telemetry.NewLabel("opcode", op)}
+		telemetry.IncrCounterWithLabels([]string{"evm", "profile", "opcode", "gas"}, float32(stats.Gas), labels)
+		telemetry.IncrCounterWithLabels([]string{"evm", "profile", "opcode", "count"}, float32(stats.Count), labels)
+	}
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasProfiler(t *testing.T) {
+	caller := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	callee := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	stateDB, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+
+	// mstore(0, shl(224, 0xaabbccdd)); pop(call(gas(), callee, 0, 0, 4, 0, 0))
+	code := append(common.FromHex("0x63aabbccdd60e01b6000526000600060046000600073"), callee.Bytes()...)
+	stateDB.SetCode(caller, append(code, common.FromHex("0x5af15000")...))
+	// sstore(0, 1)
+	stateDB.SetCode(callee, common.FromHex("0x600160005500"))
+
+	profiler := NewGasProfiler(false)
+	gasLimit := uint64(1_000_000)
+
+	profiler.CaptureTxStart(gasLimit)
+	_, leftover, err := runtime.Call(caller, common.FromHex("0x12345678"), &runtime.Config{
+		GasLimit:  gasLimit,
+		State:     stateDB,
+		EVMConfig: vm.Config{Debug: true, Tracer: profiler},
+	})
+	require.NoError(t, err)
+	profiler.CaptureTxEnd(leftover)
+
+	profile := profiler.Profile()
+	require.Equal(t, uint64(1), profile.Transactions)
+	require.Equal(t, gasLimit-leftover, profile.GasUsed)
+	require.Len(t, profile.Contracts, 2)
+
+	callerProfile := profile.Contracts[caller]
+	calleeProfile := profile.Contracts[callee]
+	require.Equal(t, uint64(1), callerProfile.Count)
+	require.Equal(t, GasStats{Count: 1, Gas: callerProfile.Gas}, *callerProfile.Selectors["0x12345678"])
+	require.Equal(t, GasStats{Count: 1, Gas: calleeProfile.Gas}, *calleeProfile.Selectors["0xaabbccdd"])
+
+	// the gas of the callee is not attributed to the caller nor to its CALL
+	require.Equal(t, calleeProfile.Opcodes["SSTORE"].Gas+6, calleeProfile.Gas)
+	require.Less(t, callerProfile.Opcodes["CALL"].Gas, calleeProfile.Opcodes["SSTORE"].Gas)
+	require.Nil(t, callerProfile.Opcodes["SSTORE"])
+
+	// all the gas used by the execution is attributed once
+	var contractsGas, opcodesGas uint64
+	for _, contract := range profile.Contracts {
+		contractsGas += contract.Gas
+	}
+	for _, stats := range profile.Opcodes {
+		opcodesGas += stats.Gas
+	}
+	require.Equal(t, gasLimit-leftover, contractsGas)
+	require.Equal(t, contractsGas, opcodesGas)
+
+	res, err := profiler.GetResult()
+	require.NoError(t, err)
+
+	decoded := NewGasProfile()
+	require.NoError(t, json.Unmarshal(res, decoded))
+	require.Equal(t, profile, decoded)
+}
+
+func TestGasProfileMerge(t *testing.T) {
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	newProfile := func() *GasProfile {
+		profile := NewGasProfile()
+		profile.Transactions = 1
+		profile.GasUsed = 100
+		profile.Opcodes["ADD"] = &GasStats{Count: 2, Gas: 6}
+		contract := profile.contract(address)
+		contract.GasStats = GasStats{Count: 1, Gas: 79}
+		contract.Selectors["0x12345678"] = &GasStats{Count: 1, Gas: 79}
+		contract.Opcodes["ADD"] = &GasStats{Count: 2, Gas: 6}
+		return profile
+	}
+
+	profile := newProfile()
+	profile.Merge(newProfile())
+
+	require.Equal(t, uint64(2), profile.Transactions)
+	require.Equal(t, uint64(200), profile.GasUsed)
+	require.Equal(t, GasStats{Count: 4, Gas: 12}, *profile.Opcodes["ADD"])
+	require.Equal(t, GasStats{Count: 2, Gas: 158}, profile.Contracts[address].GasStats)
+	require.Equal(t, GasStats{Count: 2, Gas: 158}, *profile.Contracts[address].Selectors["0x12345678"])
+}
+
+func TestGasProfilerLookup(t *testing.T) {
+	tracer, err := tracers.New(TracerGasProfile, &tracers.Context{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &GasProfiler{}, tracer)
+
+	require.IsType(t, &GasProfiler{}, NewTracer(TracerGasProfile, nil, nil, 1))
+}
@@ -18,6 +18,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/evmos/evmos/v12/types"
 )
 
@@ -60,5 +62,35 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
-	return gs.Params.Validate()
+	contractABIs := make(map[common.Address]string)
+	for _, contractABI := range gs.ContractABIs {
+		if err := contractABI.Validate(); err != nil {
+			return fmt.Errorf("invalid ABI of contract %s: %w", contractABI.Address, err)
+		}
+		address := common.HexToAddress(contractABI.Address)
+		if _, ok := contractABIs[address]; ok {
+			return fmt.Errorf("duplicated ABI of contract %s", contractABI.Address)
+		}
+		contractABIs[address] = contractABI.ABI
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// the events of the event bridges must be defined in the contract ABIs
+	for _, bridge := range gs.Params.EventBridges {
+		abiJSON, ok := contractABIs[common.HexToAddress(bridge.Contract)]
+		if !ok {
+			return fmt.Errorf("no ABI of the bridged contract %s", bridge.Contract)
+		}
+		contractABI, err := ParseABI(abiJSON)
+		if err != nil {
+			return err
+		}
+		if _, err := bridge.ABIEvent(contractABI); err != nil {
+			return fmt.Errorf("invalid event bridge %s %s: %w", bridge.Contract, bridge.Event, err)
+		}
+	}
+	return nil
 }
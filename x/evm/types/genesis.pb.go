@@ -32,6 +32,8 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// contract_abis defines the ABIs and metadata registered for contracts.
+	ContractABIs []ContractABI `protobuf:"bytes,3,rep,name=contract_abis,json=contractAbis,proto3" json:"contract_abis"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +88,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetContractABIs() []ContractABI {
+	if m != nil {
+		return m.ContractABIs
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -165,26 +174,29 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0x3f, 0x4f, 0xb3, 0x40,
-	0x18, 0xe7, 0xde, 0x36, 0xe5, 0xed, 0xd5, 0xa8, 0xb9, 0x98, 0x48, 0x18, 0xae, 0xa4, 0x83, 0x61,
-	0x3a, 0x42, 0x4d, 0x9c, 0x95, 0xc5, 0xd5, 0xd0, 0xcd, 0xed, 0x0a, 0x4f, 0x28, 0x03, 0x1c, 0xe1,
-	0xae, 0x44, 0x57, 0x47, 0x27, 0x3f, 0x87, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x45, 0x0c,
-	0x07, 0x6d, 0xa2, 0x2c, 0x97, 0xe7, 0xee, 0xf7, 0xef, 0xb9, 0x1f, 0xa6, 0xa0, 0x36, 0x50, 0x66,
-	0x69, 0xae, 0x3c, 0xa8, 0x32, 0xaf, 0xf2, 0xbd, 0x04, 0x72, 0x90, 0xa9, 0x64, 0x45, 0x29, 0x94,
-	0x20, 0xe7, 0x47, 0x9c, 0x41, 0x95, 0xb1, 0xca, 0xb7, 0xed, 0x81, 0xa2, 0x05, 0x34, 0xdb, 0xbe,
-	0x48, 0x44, 0x22, 0xf4, 0xe8, 0xb5, 0x53, 0xf7, 0xba, 0x78, 0x45, 0xf8, 0xe4, 0xbe, 0x73, 0x5d,
-	0x29, 0xae, 0x80, 0x04, 0xf8, 0x3f, 0x8f, 0x22, 0xb1, 0xcd, 0x95, 0xb4, 0x90, 0x33, 0x72, 0x67,
-	0x4b, 0x87, 0xfd, 0xcd, 0x61, 0xbd, 0xe2, 0xae, 0x23, 0x06, 0xe3, 0xdd, 0xe7, 0xdc, 0x08, 0x8f,
-	0x3a, 0x72, 0x83, 0x27, 0x05, 0x2f, 0x79, 0x26, 0xad, 0x7f, 0x0e, 0x72, 0x67, 0x4b, 0x6b, 0xe8,
-	0xf0, 0xa0, 0xf1, 0x5e, 0xd9, 0xb3, 0x17, 0x2f, 0x08, 0x9f, 0xfe, 0xb6, 0x26, 0x16, 0x36, 0x79,
-	0x1c, 0x97, 0x20, 0xdb, 0x6d, 0x90, 0x3b, 0x0d, 0x0f, 0x57, 0x42, 0xf0, 0x38, 0x12, 0x31, 0xe8,
-	0x88, 0x69, 0xa8, 0x67, 0x12, 0x60, 0x53, 0x2a, 0x51, 0xf2, 0x04, 0xac, 0x91, 0xde, 0xfd, 0x72,
-	0x98, 0xac, 0xbf, 0x19, 0x9c, 0xb5, 0xc1, 0xef, 0x5f, 0x73, 0x73, 0xd5, 0xf1, 0xc3, 0x83, 0x30,
-	0xb8, 0xdd, 0xd5, 0x14, 0xed, 0x6b, 0x8a, 0xbe, 0x6b, 0x8a, 0xde, 0x1a, 0x6a, 0xec, 0x1b, 0x6a,
-	0x7c, 0x34, 0xd4, 0x78, 0xbc, 0x4a, 0x52, 0xb5, 0xd9, 0xae, 0x59, 0x24, 0xb2, 0xb6, 0x57, 0x21,
-	0xfb, 0xb3, 0xf2, 0x7d, 0xef, 0x49, 0x17, 0xae, 0x9e, 0x0b, 0x90, 0xeb, 0x89, 0xae, 0xf6, 0xfa,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xcd, 0xca, 0x38, 0xc0, 0x01, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xcf, 0x4e, 0xc2, 0x30,
+	0x1c, 0x5e, 0x85, 0x80, 0x14, 0xfc, 0x93, 0x86, 0xc4, 0x85, 0xc4, 0x42, 0x38, 0x18, 0x4e, 0x5b,
+	0xc0, 0xc4, 0xb3, 0xd4, 0x83, 0xf1, 0x66, 0xc6, 0xc5, 0x78, 0x31, 0xdd, 0x68, 0xc6, 0x0e, 0x5b,
+	0xc9, 0x5a, 0x16, 0xbd, 0xfa, 0x04, 0x3e, 0x87, 0x4f, 0xc2, 0x91, 0xa3, 0x27, 0x34, 0xc3, 0x07,
+	0x31, 0xed, 0x3a, 0xa2, 0xee, 0xb2, 0x7c, 0xfb, 0x7d, 0x7f, 0xfa, 0xb5, 0x3f, 0x88, 0x99, 0x5c,
+	0xb0, 0x34, 0x8e, 0x12, 0xe9, 0xb2, 0x2c, 0x76, 0xb3, 0xb1, 0x1b, 0xb2, 0x84, 0x89, 0x48, 0x38,
+	0xcb, 0x94, 0x4b, 0x8e, 0x4e, 0xf7, 0xbc, 0xc3, 0xb2, 0xd8, 0xc9, 0xc6, 0xbd, 0x5e, 0xc5, 0xa1,
+	0x08, 0xad, 0xee, 0x75, 0x43, 0x1e, 0x72, 0x0d, 0x5d, 0x85, 0x8a, 0xe9, 0xf0, 0x1b, 0xc0, 0xce,
+	0x6d, 0x91, 0x3a, 0x93, 0x54, 0x32, 0x44, 0xe0, 0x21, 0x0d, 0x02, 0xbe, 0x4a, 0xa4, 0xb0, 0xc1,
+	0xa0, 0x36, 0x6a, 0x4f, 0x06, 0xce, 0xff, 0x73, 0x1c, 0xe3, 0x98, 0x16, 0x42, 0x52, 0x5f, 0x6f,
+	0xfb, 0x96, 0xb7, 0xf7, 0xa1, 0x2b, 0xd8, 0x58, 0xd2, 0x94, 0xc6, 0xc2, 0x3e, 0x18, 0x80, 0x51,
+	0x7b, 0x62, 0x57, 0x13, 0xee, 0x35, 0x6f, 0x9c, 0x46, 0x8d, 0x1e, 0xe0, 0x51, 0xc0, 0x13, 0x99,
+	0xd2, 0x40, 0x3e, 0x51, 0x3f, 0x12, 0x76, 0x4d, 0x17, 0x38, 0xaf, 0xda, 0x6f, 0x8c, 0x6c, 0x4a,
+	0xee, 0x48, 0x57, 0x65, 0xe4, 0xdb, 0x7e, 0xe7, 0xd7, 0x50, 0x78, 0x9d, 0x32, 0x69, 0xea, 0x47,
+	0x62, 0xf8, 0x0a, 0xe0, 0xf1, 0xdf, 0xd2, 0xc8, 0x86, 0x4d, 0x3a, 0x9f, 0xa7, 0x4c, 0xa8, 0x7b,
+	0x82, 0x51, 0xcb, 0x2b, 0x7f, 0x11, 0x82, 0xf5, 0x80, 0xcf, 0x99, 0x2e, 0xdf, 0xf2, 0x34, 0x46,
+	0x04, 0x36, 0x85, 0xe4, 0x29, 0x0d, 0x99, 0x29, 0x75, 0x56, 0x2d, 0xa5, 0x1f, 0x90, 0x9c, 0xa8,
+	0x3a, 0xef, 0x9f, 0xfd, 0xe6, 0xac, 0xd0, 0x7b, 0xa5, 0x91, 0x5c, 0xaf, 0x73, 0x0c, 0x36, 0x39,
+	0x06, 0x5f, 0x39, 0x06, 0x6f, 0x3b, 0x6c, 0x6d, 0x76, 0xd8, 0xfa, 0xd8, 0x61, 0xeb, 0xf1, 0x22,
+	0x8c, 0xe4, 0x62, 0xe5, 0x3b, 0x01, 0x8f, 0xd5, 0xc6, 0xb8, 0x30, 0xdf, 0x6c, 0x3c, 0x71, 0x9f,
+	0x15, 0x76, 0xe5, 0xcb, 0x92, 0x09, 0xbf, 0xa1, 0x97, 0x76, 0xf9, 0x33, 0x00, 0xd4, 0xf5, 0xe0,
+	0x68, 0x1a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +219,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractABIs) > 0 {
+		for iNdEx := len(m.ContractABIs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractABIs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -311,6 +337,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ContractABIs) > 0 {
+		for _, e := range m.ContractABIs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -441,6 +473,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractABIs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractABIs = append(m.ContractABIs, ContractABI{})
+			if err := m.ContractABIs[len(m.ContractABIs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -211,6 +211,29 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "event bridge of a registered ABI",
+			genState: &GenesisState{
+				Params:       suite.eventBridgeParams(testEventSig),
+				ContractABIs: []ContractABI{{Address: suite.address, ABI: testEventABI}},
+			},
+			expPass: true,
+		},
+		{
+			name: "event bridge without registered ABI",
+			genState: &GenesisState{
+				Params: suite.eventBridgeParams(testEventSig),
+			},
+			expPass: false,
+		},
+		{
+			name: "event bridge of an event missing from the ABI",
+			genState: &GenesisState{
+				Params:       suite.eventBridgeParams("Deposit(address)"),
+				ContractABIs: []ContractABI{{Address: suite.address, ABI: testEventABI}},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -223,3 +246,9 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) eventBridgeParams(event string) Params {
+	params := DefaultParams()
+	params.EventBridges = []EventBridge{{Contract: suite.address, Event: event, EventType: "deposit"}}
+	return params
+}
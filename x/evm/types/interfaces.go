@@ -24,8 +24,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	feemarkettypes "github.com/evmos/evmos/v12/x/feemarket/types"
 )
 
@@ -69,10 +71,46 @@ type FeeMarketKeeper interface {
 
 // EvmHooks event hooks for evm tx processing
 type EvmHooks interface {
+	// Must be called before the tx message is applied, sender is the account that signed the tx. If return an
+	// error, the transaction is not executed.
+	PreTxProcessing(ctx sdk.Context, msg core.Message, sender common.Address) error
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// StatefulPrecompiledContract defines a precompiled contract implemented in Go
+// that can read and write the state of the Cosmos modules. Contracts are
+// registered on the EVM keeper and activated through the ActivePrecompiles
+// parameter.
+type StatefulPrecompiledContract interface {
+	// Address returns the address of the contract.
+	Address() common.Address
+	// RequiredGas returns the gas required to execute the contract with the given input.
+	RequiredGas(input []byte) uint64
+	// Run executes the contract with the caller, value and input of the given
+	// contract. The contract must not modify the state if readonly is true.
+	Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error)
+}
+
+// PrecompileProvider defines a source of stateful precompiled contracts whose
+// addresses are defined by the state of other modules (eg: the ERC20
+// precompiles of the x/erc20 token pairs). The provided contracts are active
+// regardless of the ActivePrecompiles parameter. They are resolved when their
+// address is called, so they're not part of the access list of the txs.
+type PrecompileProvider interface {
+	// GetPrecompile returns the precompiled contract at the given address, if any.
+	GetPrecompile(ctx sdk.Context, address common.Address) (StatefulPrecompiledContract, bool)
+}
+
+// ERC20Keeper defines the expected interface of the x/erc20 keeper, used to pay
+// the fees of the Ethereum transactions with the tokens of the token pairs.
+type ERC20Keeper interface {
+	// GetTokenPairERC20 returns the ERC-20 contract of the token pair of the given denomination.
+	GetTokenPairERC20(ctx sdk.Context, denom string) (common.Address, bool)
+	// ConvertERC20ToCoin converts the ERC-20 tokens of the sender to the given coin of the token pair.
+	ConvertERC20ToCoin(ctx sdk.Context, sender common.Address, coin sdk.Coin) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
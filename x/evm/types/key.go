@@ -41,6 +41,10 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixCodeRefCount
+	prefixContractABI
+	prefixBlockRandomness
+	prefixFeeDenomRate
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +53,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayment
 )
 
 // KVStore key prefixes
@@ -56,6 +61,12 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixCodeRefCount = []byte{prefixCodeRefCount}
+	KeyPrefixContractABI  = []byte{prefixContractABI}
+
+	KeyPrefixBlockRandomness = []byte{prefixBlockRandomness}
+	KeyPrefixFeeDenomRate    = []byte{prefixFeeDenomRate}
 )
 
 // Transient Store key prefixes
@@ -64,6 +75,8 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientFeePayment = []byte{prefixTransientFeePayment}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
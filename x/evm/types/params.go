@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -46,7 +47,19 @@ var (
 var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
 
 // NewParams creates a new Params instance
-func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
+func NewParams(
+	evmDenom string,
+	allowUnprotectedTxs,
+	enableCreate,
+	enableCall bool,
+	config ChainConfig,
+	extraEIPs []int64,
+	activePrecompiles []string,
+	permissions Permissions,
+	eventBridges []EventBridge,
+	feeDenoms []FeeDenom,
+	evmHooks []EvmHookParams,
+) Params {
 	return Params{
 		EvmDenom:            evmDenom,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
@@ -54,6 +67,11 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		ActivePrecompiles:   activePrecompiles,
+		Permissions:         permissions,
+		EventBridges:        eventBridges,
+		FeeDenoms:           feeDenoms,
+		EvmHooks:            evmHooks,
 	}
 }
 
@@ -67,6 +85,11 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		ActivePrecompiles:   nil,
+		Permissions:         Permissions{},
+		EventBridges:        nil,
+		FeeDenoms:           nil,
+		EvmHooks:            nil,
 	}
 }
 
@@ -92,9 +115,40 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	if err := p.Permissions.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateEventBridges(p.EventBridges); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenoms(p.FeeDenoms, p.EvmDenom); err != nil {
+		return err
+	}
+
+	if err := validateEvmHooks(p.EvmHooks); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
+// IsActivePrecompile returns true if the given address is an active stateful
+// precompiled contract.
+func (p Params) IsActivePrecompile(address common.Address) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) == address {
+			return true
+		}
+	}
+	return false
+}
+
 // EIPs returns the ExtraEIPS as a int slice
 func (p Params) EIPs() []int {
 	eips := make([]int, len(p.ExtraEIPs))
@@ -149,3 +203,31 @@ func validateChainConfig(i interface{}) error {
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
 }
+
+// ValidatePrecompiles checks that the addresses of the active precompiled
+// contracts are valid hex addresses, don't collide with the addresses of the
+// Ethereum precompiled contracts and are not duplicated.
+func ValidatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+
+		address := common.HexToAddress(precompile)
+		if _, found := vm.PrecompiledContractsBerlin[address]; found {
+			return fmt.Errorf("precompile address %s collides with an Ethereum precompiled contract", precompile)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate precompile address %s", precompile)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
@@ -3,8 +3,11 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,9 +21,174 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips),
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, nil, nil),
+			false,
+		},
+		{
+			"valid with active precompiles",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, []string{"0x0000000000000000000000000000000000000800"}, Permissions{}, nil, nil, nil),
+			false,
+		},
+		{
+			"invalid precompile address",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, []string{"0x800"}, Permissions{}, nil, nil, nil),
+			true,
+		},
+		{
+			"duplicate precompile address",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, []string{
+				"0x0000000000000000000000000000000000000800",
+				"0x0000000000000000000000000000000000000800",
+			}, Permissions{}, nil, nil, nil),
+			true,
+		},
+		{
+			"precompile address of an ethereum precompile",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, []string{"0x0000000000000000000000000000000000000001"}, Permissions{}, nil, nil, nil),
+			true,
+		},
+		{
+			"valid permissions",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{
+				Create: CreatePolicy{
+					Permissioned: true,
+					AllowedDeployers: []AllowedDeployer{{
+						Address:    "0x0000000000000000000000000000000000000abc",
+						CodeHashes: []string{common.Hash{1}.Hex()},
+					}},
+				},
+				Call: CallPolicy{
+					Permissioned:    true,
+					AllowedSenders:  []string{"0x0000000000000000000000000000000000000abc"},
+					PublicContracts: []string{"0x0000000000000000000000000000000000000def"},
+				},
+				DeniedSenders: []string{"0x0000000000000000000000000000000000000123"},
+			}, nil, nil, nil),
+			false,
+		},
+		{
+			"invalid allowed deployer code hash",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{
+				Create: CreatePolicy{AllowedDeployers: []AllowedDeployer{{
+					Address:    "0x0000000000000000000000000000000000000abc",
+					CodeHashes: []string{"0x1234"},
+				}}},
+			}, nil, nil, nil),
+			true,
+		},
+		{
+			"duplicated allowed deployer",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{
+				Create: CreatePolicy{AllowedDeployers: []AllowedDeployer{
+					{Address: "0x0000000000000000000000000000000000000abc"},
+					{Address: "0x0000000000000000000000000000000000000ABC"},
+				}},
+			}, nil, nil, nil),
+			true,
+		},
+		{
+			"invalid public contract",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{
+				Call: CallPolicy{PublicContracts: []string{"0xabc"}},
+			}, nil, nil, nil),
+			true,
+		},
+		{
+			"zero denied sender",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{
+				DeniedSenders: []string{common.Address{}.Hex()},
+			}, nil, nil, nil),
+			true,
+		},
+		{
+			"valid event bridges",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, []EventBridge{
+				{Contract: "0x0000000000000000000000000000000000000abc", Event: testEventSig, EventType: "deposit"},
+			}, nil, nil),
+			false,
+		},
+		{
+			"event bridge without event signature",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, []EventBridge{
+				{Contract: "0x0000000000000000000000000000000000000abc", EventType: "deposit"},
+			}, nil, nil),
+			true,
+		},
+		{
+			"duplicated event bridge",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, []EventBridge{
+				{Contract: "0x0000000000000000000000000000000000000abc", Event: testEventSig, EventType: "deposit"},
+				{Contract: "0x0000000000000000000000000000000000000ABC", Event: testEventSig, EventType: "deposit_2"},
+			}, nil, nil),
+			true,
+		},
+		{
+			"event bridge without event type",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, []EventBridge{
+				{Contract: "0x0000000000000000000000000000000000000abc", Event: testEventSig},
+			}, nil, nil),
+			true,
+		},
+		{
+			"valid fee denoms",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, []FeeDenom{
+				{Denom: "uusdc", Rate: sdk.NewDecWithPrec(25, 2)},
+				{Denom: "uatom", Rate: sdk.ZeroDec(), PriceOracle: "0x0000000000000000000000000000000000000abc"},
+			}, nil),
 			false,
 		},
+		{
+			"fee denom without rate",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, []FeeDenom{
+				{Denom: "uusdc", Rate: sdk.ZeroDec()},
+			}, nil),
+			true,
+		},
+		{
+			"fee denom with both rate and price oracle",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, []FeeDenom{
+				{Denom: "uusdc", Rate: sdk.OneDec(), PriceOracle: "0x0000000000000000000000000000000000000abc"},
+			}, nil),
+			true,
+		},
+		{
+			"fee denom is the evm denom",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, []FeeDenom{
+				{Denom: "ara", Rate: sdk.OneDec()},
+			}, nil),
+			true,
+		},
+		{
+			"duplicated fee denom",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, []FeeDenom{
+				{Denom: "uusdc", Rate: sdk.OneDec()},
+				{Denom: "uusdc", Rate: sdk.NewDec(2)},
+			}, nil),
+			true,
+		},
+		{
+			"valid EVM hooks",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, nil, []EvmHookParams{
+				{Name: "erc20", RevertOnError: true},
+				{Name: "revenue", GasLimit: 300_000},
+			}),
+			false,
+		},
+		{
+			"EVM hook without name",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, nil, []EvmHookParams{
+				{GasLimit: 300_000},
+			}),
+			true,
+		},
+		{
+			"duplicated EVM hook",
+			NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, nil, []EvmHookParams{
+				{Name: "revenue", GasLimit: 300_000},
+				{Name: "revenue"},
+			}),
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -54,9 +222,39 @@ func TestParamsValidate(t *testing.T) {
 	}
 }
 
+func TestPermissionsPolicies(t *testing.T) {
+	deployer := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	sender := common.HexToAddress("0x0000000000000000000000000000000000000123")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000def")
+	codeHash := common.Hash{1}
+
+	require.True(t, CreatePolicy{}.CanCreate(sender, codeHash))
+	create := CreatePolicy{
+		Permissioned: true,
+		AllowedDeployers: []AllowedDeployer{
+			{Address: deployer.Hex(), CodeHashes: []string{codeHash.Hex()}},
+			{Address: sender.Hex()},
+		},
+	}
+	require.True(t, create.CanCreate(deployer, codeHash))
+	require.False(t, create.CanCreate(deployer, common.Hash{2}))
+	require.True(t, create.CanCreate(sender, common.Hash{2}))
+	require.False(t, create.CanCreate(contract, codeHash))
+
+	require.True(t, CallPolicy{}.CanCall(sender, contract))
+	call := CallPolicy{Permissioned: true, AllowedSenders: []string{sender.Hex()}, PublicContracts: []string{contract.Hex()}}
+	require.True(t, call.CanCall(sender, deployer))
+	require.True(t, call.CanCall(deployer, contract))
+	require.False(t, call.CanCall(deployer, sender))
+
+	permissions := Permissions{DeniedSenders: []string{sender.Hex()}}
+	require.True(t, permissions.IsDeniedSender(sender))
+	require.False(t, permissions.IsDeniedSender(deployer))
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
-	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips)
+	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, nil, Permissions{}, nil, nil, nil)
 	actual := params.EIPs()
 
 	require.Equal(t, []int{2929, 1884, 1344}, actual)
@@ -69,6 +267,16 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.Error(t, ValidatePrecompiles(""))
+	require.NoError(t, ValidatePrecompiles([]string{}))
+}
+
+func TestIsActivePrecompile(t *testing.T) {
+	params := DefaultParams()
+	params.ActivePrecompiles = []string{"0x0000000000000000000000000000000000000800"}
+
+	require.True(t, params.IsActivePrecompile(common.HexToAddress("0x0000000000000000000000000000000000000800")))
+	require.False(t, params.IsActivePrecompile(common.HexToAddress("0x0000000000000000000000000000000000000801")))
 }
 
 func TestValidateChainConfig(t *testing.T) {
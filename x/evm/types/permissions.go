@@ -0,0 +1,155 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmostypes "github.com/evmos/evmos/v12/types"
+)
+
+// Validate performs a stateless validation of the permissions.
+func (p Permissions) Validate() error {
+	if err := p.Create.Validate(); err != nil {
+		return err
+	}
+	if err := p.Call.Validate(); err != nil {
+		return err
+	}
+	if err := validateAddresses(p.DeniedSenders); err != nil {
+		return fmt.Errorf("invalid denied senders: %w", err)
+	}
+	return nil
+}
+
+// IsDeniedSender returns true if the given address can't send EVM transactions.
+func (p Permissions) IsDeniedSender(sender common.Address) bool {
+	return containsAddress(p.DeniedSenders, sender)
+}
+
+// Validate performs a stateless validation of the create policy.
+func (p CreatePolicy) Validate() error {
+	seen := make(map[common.Address]bool, len(p.AllowedDeployers))
+	for _, deployer := range p.AllowedDeployers {
+		if err := evmostypes.ValidateNonZeroAddress(deployer.Address); err != nil {
+			return fmt.Errorf("invalid allowed deployer: %w", err)
+		}
+
+		address := common.HexToAddress(deployer.Address)
+		if seen[address] {
+			return fmt.Errorf("duplicated allowed deployer %s", address)
+		}
+		seen[address] = true
+
+		for _, codeHash := range deployer.CodeHashes {
+			bz, err := hexutil.Decode(codeHash)
+			if err != nil || len(bz) != common.HashLength {
+				return fmt.Errorf("invalid code hash %s of allowed deployer %s", codeHash, address)
+			}
+		}
+	}
+	return nil
+}
+
+// CanCreate returns true if the deployer can deploy a contract with the given
+// init code hash.
+func (p CreatePolicy) CanCreate(deployer common.Address, codeHash common.Hash) bool {
+	if !p.Permissioned {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDeployers {
+		if common.HexToAddress(allowed.Address) != deployer {
+			continue
+		}
+		if len(allowed.CodeHashes) == 0 {
+			return true
+		}
+		for _, allowedHash := range allowed.CodeHashes {
+			if common.HexToHash(allowedHash) == codeHash {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// CanCreateNested returns true if the given contract can deploy other contracts
+// through CREATE and CREATE2. The init code of these deployments isn't checked,
+// so the contract must be allowed to deploy any code.
+func (p CreatePolicy) CanCreateNested(deployer common.Address) bool {
+	if !p.Permissioned {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDeployers {
+		if common.HexToAddress(allowed.Address) == deployer {
+			return len(allowed.CodeHashes) == 0
+		}
+	}
+	return false
+}
+
+// Validate performs a stateless validation of the call policy.
+func (p CallPolicy) Validate() error {
+	if err := validateAddresses(p.AllowedSenders); err != nil {
+		return fmt.Errorf("invalid allowed senders: %w", err)
+	}
+	if err := validateAddresses(p.PublicContracts); err != nil {
+		return fmt.Errorf("invalid public contracts: %w", err)
+	}
+	return nil
+}
+
+// CanCall returns true if the sender can call the given contract.
+func (p CallPolicy) CanCall(sender, contract common.Address) bool {
+	if !p.Permissioned {
+		return true
+	}
+	return containsAddress(p.PublicContracts, contract) || containsAddress(p.AllowedSenders, sender)
+}
+
+// validateAddresses checks that the given hex addresses are valid, non-zero
+// and not duplicated.
+func validateAddresses(addresses []string) error {
+	seen := make(map[common.Address]bool, len(addresses))
+	for _, hexAddr := range addresses {
+		if err := evmostypes.ValidateNonZeroAddress(hexAddr); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(hexAddr)
+		if seen[address] {
+			return fmt.Errorf("duplicated address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
+
+// containsAddress returns true if the given hex addresses contain the address.
+func containsAddress(addresses []string, address common.Address) bool {
+	for _, hexAddr := range addresses {
+		if common.HexToAddress(hexAddr) == address {
+			return true
+		}
+	}
+	return false
+}
@@ -14,9 +14,9 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
-	grpc1 "github.com/gogo/protobuf/grpc"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	proto "github.com/gogo/protobuf/proto"
-	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -670,6 +670,210 @@ func (m *QueryCodeResponse) GetCode() []byte {
 	return nil
 }
 
+// QueryCodeHashUsageRequest is the request type for the Query/CodeHashUsage RPC
+// method.
+type QueryCodeHashUsageRequest struct {
+	// code_hash is the hex hash of the contract code to query the usage for.
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *QueryCodeHashUsageRequest) Reset()         { *m = QueryCodeHashUsageRequest{} }
+func (m *QueryCodeHashUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeHashUsageRequest) ProtoMessage()    {}
+func (*QueryCodeHashUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{12}
+}
+
+func (m *QueryCodeHashUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryCodeHashUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeHashUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryCodeHashUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeHashUsageRequest.Merge(m, src)
+}
+
+func (m *QueryCodeHashUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryCodeHashUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeHashUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeHashUsageRequest proto.InternalMessageInfo
+
+// QueryCodeHashUsageResponse is the response type for the Query/CodeHashUsage
+// RPC method.
+type QueryCodeHashUsageResponse struct {
+	// ref_count is the number of accounts that reference the code hash.
+	RefCount uint64 `protobuf:"varint,1,opt,name=ref_count,json=refCount,proto3" json:"ref_count,omitempty"`
+	// code_size is the size in bytes of the stored code, zero if the code has been
+	// removed from the store.
+	CodeSize uint64 `protobuf:"varint,2,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+}
+
+func (m *QueryCodeHashUsageResponse) Reset()         { *m = QueryCodeHashUsageResponse{} }
+func (m *QueryCodeHashUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeHashUsageResponse) ProtoMessage()    {}
+func (*QueryCodeHashUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{13}
+}
+
+func (m *QueryCodeHashUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryCodeHashUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeHashUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryCodeHashUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeHashUsageResponse.Merge(m, src)
+}
+
+func (m *QueryCodeHashUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryCodeHashUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeHashUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeHashUsageResponse proto.InternalMessageInfo
+
+func (m *QueryCodeHashUsageResponse) GetRefCount() uint64 {
+	if m != nil {
+		return m.RefCount
+	}
+	return 0
+}
+
+func (m *QueryCodeHashUsageResponse) GetCodeSize() uint64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
+// QueryContractABIRequest is the request type for the Query/ContractABI RPC
+// method.
+type QueryContractABIRequest struct {
+	// address is the hex address of the contract to query the ABI for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryContractABIRequest) Reset()         { *m = QueryContractABIRequest{} }
+func (m *QueryContractABIRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractABIRequest) ProtoMessage()    {}
+func (*QueryContractABIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{14}
+}
+
+func (m *QueryContractABIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryContractABIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractABIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryContractABIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractABIRequest.Merge(m, src)
+}
+
+func (m *QueryContractABIRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryContractABIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractABIRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractABIRequest proto.InternalMessageInfo
+
+// QueryContractABIResponse is the response type for the Query/ContractABI RPC
+// method.
+type QueryContractABIResponse struct {
+	// contract_abi is the ABI and metadata registered for the contract.
+	ContractABI ContractABI `protobuf:"bytes,1,opt,name=contract_abi,json=contractAbi,proto3" json:"contract_abi"`
+}
+
+func (m *QueryContractABIResponse) Reset()         { *m = QueryContractABIResponse{} }
+func (m *QueryContractABIResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractABIResponse) ProtoMessage()    {}
+func (*QueryContractABIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{15}
+}
+
+func (m *QueryContractABIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryContractABIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractABIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryContractABIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractABIResponse.Merge(m, src)
+}
+
+func (m *QueryContractABIResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryContractABIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractABIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractABIResponse proto.InternalMessageInfo
+
+func (m *QueryContractABIResponse) GetContractABI() ContractABI {
+	if m != nil {
+		return m.ContractABI
+	}
+	return ContractABI{}
+}
+
 // QueryTxLogsRequest is the request type for the Query/TxLogs RPC method.
 type QueryTxLogsRequest struct {
 	// hash is the ethereum transaction hex hash to query the logs for.
@@ -682,7 +886,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{12}
+	return fileDescriptor_e15a877459347994, []int{16}
 }
 
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
@@ -728,7 +932,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{13}
+	return fileDescriptor_e15a877459347994, []int{17}
 }
 
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
@@ -783,7 +987,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{14}
+	return fileDescriptor_e15a877459347994, []int{18}
 }
 
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
@@ -827,7 +1031,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{15}
+	return fileDescriptor_e15a877459347994, []int{19}
 }
 
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
@@ -884,7 +1088,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{16}
+	return fileDescriptor_e15a877459347994, []int{20}
 }
 
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
@@ -956,7 +1160,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{17}
+	return fileDescriptor_e15a877459347994, []int{21}
 }
 
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
@@ -1022,7 +1226,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
@@ -1122,7 +1326,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
@@ -1185,7 +1389,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
@@ -1278,7 +1482,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
@@ -1327,7 +1531,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
@@ -1371,7 +1575,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
@@ -1418,6 +1622,10 @@ func init() {
 	proto.RegisterType((*QueryStorageResponse)(nil), "ethermint.evm.v1.QueryStorageResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "ethermint.evm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "ethermint.evm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryCodeHashUsageRequest)(nil), "ethermint.evm.v1.QueryCodeHashUsageRequest")
+	proto.RegisterType((*QueryCodeHashUsageResponse)(nil), "ethermint.evm.v1.QueryCodeHashUsageResponse")
+	proto.RegisterType((*QueryContractABIRequest)(nil), "ethermint.evm.v1.QueryContractABIRequest")
+	proto.RegisterType((*QueryContractABIResponse)(nil), "ethermint.evm.v1.QueryContractABIResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "ethermint.evm.v1.QueryTxLogsRequest")
 	proto.RegisterType((*QueryTxLogsResponse)(nil), "ethermint.evm.v1.QueryTxLogsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.evm.v1.QueryParamsRequest")
@@ -1435,97 +1643,108 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x03, 0xf8, 0x3b, 0x04, 0x70, 0x96, 0xc4, 0x0e, 0xfb, 0x6d,
-	0x62, 0x43, 0x61, 0x17, 0xa7, 0x12, 0x52, 0x7b, 0x29, 0xd8, 0x0a, 0x94, 0x02, 0x15, 0x75, 0xa3,
-	0x1e, 0x2a, 0x21, 0x6b, 0xbc, 0x1e, 0xd6, 0x56, 0xec, 0x1d, 0xb3, 0x33, 0xb6, 0x1c, 0x10, 0x87,
-	0xa2, 0xaa, 0x3f, 0x54, 0xa9, 0x42, 0xea, 0xad, 0x27, 0xee, 0xfd, 0x03, 0x7a, 0xec, 0x95, 0x23,
-	0x52, 0x2f, 0x55, 0x0f, 0x14, 0x41, 0x0f, 0xfd, 0x1b, 0x7a, 0xaa, 0xe6, 0x97, 0xed, 0xb5, 0xbd,
-	0x71, 0xa8, 0xe8, 0xa9, 0xa7, 0xdd, 0x99, 0x79, 0xf3, 0x3e, 0x9f, 0x79, 0xef, 0xcd, 0xbc, 0x0f,
-	0x58, 0xc3, 0xac, 0x81, 0x83, 0x76, 0xd3, 0x67, 0x0e, 0xee, 0xb5, 0x9d, 0x5e, 0xd1, 0xb9, 0xd7,
-	0xc5, 0xc1, 0xbe, 0xdd, 0x09, 0x08, 0x23, 0x30, 0x3d, 0x58, 0xb5, 0x71, 0xaf, 0x6d, 0xf7, 0x8a,
-	0xe6, 0x39, 0x97, 0xd0, 0x36, 0xa1, 0x4e, 0x0d, 0x51, 0x2c, 0x4d, 0x9d, 0x5e, 0xb1, 0x86, 0x19,
-	0x2a, 0x3a, 0x1d, 0xe4, 0x35, 0x7d, 0xc4, 0x9a, 0xc4, 0x97, 0xbb, 0x4d, 0x73, 0xc2, 0x37, 0x77,
-	0x22, 0xd7, 0x56, 0x27, 0xd6, 0x58, 0x5f, 0x2d, 0xad, 0x78, 0xc4, 0x23, 0xe2, 0xd7, 0xe1, 0x7f,
-	0x6a, 0x76, 0xcd, 0x23, 0xc4, 0x6b, 0x61, 0x07, 0x75, 0x9a, 0x0e, 0xf2, 0x7d, 0xc2, 0x04, 0x12,
-	0x55, 0xab, 0x39, 0xb5, 0x2a, 0x46, 0xb5, 0xee, 0x5d, 0x87, 0x35, 0xdb, 0x98, 0x32, 0xd4, 0xee,
-	0x48, 0x03, 0xeb, 0x5d, 0x70, 0xfc, 0x63, 0xce, 0xf6, 0x8a, 0xeb, 0x92, 0xae, 0xcf, 0x2a, 0xf8,
-	0x5e, 0x17, 0x53, 0x06, 0x33, 0x20, 0x81, 0xea, 0xf5, 0x00, 0x53, 0x9a, 0x31, 0x36, 0x8c, 0xc2,
-	0x52, 0x45, 0x0f, 0xdf, 0x4b, 0x7e, 0xfd, 0x24, 0x37, 0xf7, 0xe7, 0x93, 0xdc, 0x9c, 0xe5, 0x82,
-	0x95, 0xf0, 0x56, 0xda, 0x21, 0x3e, 0xc5, 0x7c, 0x6f, 0x0d, 0xb5, 0x90, 0xef, 0x62, 0xbd, 0x57,
-	0x0d, 0xe1, 0x69, 0xb0, 0xe4, 0x92, 0x3a, 0xae, 0x36, 0x10, 0x6d, 0x64, 0xe6, 0xc5, 0x5a, 0x92,
-	0x4f, 0x7c, 0x80, 0x68, 0x03, 0xae, 0x80, 0x05, 0x9f, 0xf0, 0x4d, 0xb1, 0x0d, 0xa3, 0x10, 0xaf,
-	0xc8, 0x81, 0xf5, 0x3e, 0x58, 0x15, 0x20, 0x65, 0x11, 0xde, 0x7f, 0xc0, 0xf2, 0x4b, 0x03, 0x98,
-	0xd3, 0x3c, 0x28, 0xb2, 0x9b, 0xe0, 0xa8, 0xcc, 0x5c, 0x35, 0xec, 0xe9, 0x88, 0x9c, 0xbd, 0x22,
-	0x27, 0xa1, 0x09, 0x92, 0x94, 0x83, 0x72, 0x7e, 0xf3, 0x82, 0xdf, 0x60, 0xcc, 0x5d, 0x20, 0xe9,
-	0xb5, 0xea, 0x77, 0xdb, 0x35, 0x1c, 0xa8, 0x13, 0x1c, 0x51, 0xb3, 0x1f, 0x89, 0x49, 0xeb, 0x06,
-	0x58, 0x13, 0x3c, 0x3e, 0x45, 0xad, 0x66, 0x1d, 0x31, 0x12, 0x8c, 0x1d, 0xe6, 0x0c, 0x58, 0x76,
-	0x89, 0x3f, 0xce, 0x23, 0xc5, 0xe7, 0xae, 0x4c, 0x9c, 0xea, 0x5b, 0x03, 0xac, 0x47, 0x78, 0x53,
-	0x07, 0xcb, 0x83, 0x63, 0x9a, 0x55, 0xd8, 0xa3, 0x26, 0xfb, 0x06, 0x8f, 0xa6, 0x8b, 0xa8, 0x24,
-	0xf3, 0xfc, 0x3a, 0xe9, 0xb9, 0xa8, 0x8a, 0x68, 0xb0, 0x75, 0x56, 0x11, 0x59, 0x37, 0x14, 0xd8,
-	0x27, 0x8c, 0x04, 0xc8, 0x9b, 0x0d, 0x06, 0xd3, 0x20, 0xb6, 0x87, 0xf7, 0x55, 0xbd, 0xf1, 0xdf,
-	0x11, 0xf8, 0xf3, 0x0a, 0x7e, 0xe0, 0x4c, 0xc1, 0xaf, 0x80, 0x85, 0x1e, 0x6a, 0x75, 0x35, 0xb8,
-	0x1c, 0x58, 0x97, 0x40, 0x5a, 0x95, 0x52, 0xfd, 0xb5, 0x0e, 0x99, 0x07, 0xff, 0x1b, 0xd9, 0xa7,
-	0x20, 0x20, 0x88, 0xf3, 0xda, 0x17, 0xbb, 0x96, 0x2b, 0xe2, 0xdf, 0xba, 0x0f, 0xa0, 0x30, 0xdc,
-	0xed, 0xdf, 0x24, 0x1e, 0xd5, 0x10, 0x10, 0xc4, 0xc5, 0x8d, 0x91, 0xfe, 0xc5, 0x3f, 0xbc, 0x0a,
-	0xc0, 0xf0, 0x5d, 0x11, 0x67, 0x4b, 0x6d, 0x6f, 0xd9, 0xb2, 0x68, 0x6d, 0xfe, 0x08, 0xd9, 0xf2,
-	0xbd, 0x52, 0x8f, 0x90, 0x7d, 0x7b, 0x18, 0xaa, 0xca, 0xc8, 0xce, 0x11, 0x92, 0xdf, 0x18, 0x2a,
-	0xb0, 0x1a, 0x5c, 0xf1, 0x3c, 0x0b, 0xe2, 0x2d, 0xe2, 0xf1, 0xd3, 0xc5, 0x0a, 0xa9, 0xed, 0x13,
-	0xf6, 0xf8, 0xd3, 0x67, 0xdf, 0x24, 0x5e, 0x45, 0x98, 0xc0, 0x6b, 0x53, 0x48, 0xe5, 0x67, 0x92,
-	0x92, 0x38, 0xa3, 0xac, 0xac, 0x15, 0x15, 0x87, 0xdb, 0x28, 0x40, 0x6d, 0x1d, 0x07, 0xeb, 0x96,
-	0x22, 0xa8, 0x67, 0x15, 0xc1, 0x4b, 0x60, 0xb1, 0x23, 0x66, 0x44, 0x80, 0x52, 0xdb, 0x99, 0x49,
-	0x8a, 0x72, 0x47, 0x29, 0xfe, 0xf4, 0x79, 0x6e, 0xae, 0xa2, 0xac, 0xad, 0x9f, 0x0c, 0x70, 0x74,
-	0x87, 0x35, 0xca, 0xa8, 0xd5, 0x1a, 0x89, 0x34, 0x0a, 0x3c, 0xaa, 0x73, 0xc2, 0xff, 0xe1, 0x29,
-	0x90, 0xf0, 0x10, 0xad, 0xba, 0xa8, 0xa3, 0xae, 0xc7, 0xa2, 0x87, 0x68, 0x19, 0x75, 0xe0, 0x1d,
-	0x90, 0xee, 0x04, 0xa4, 0x43, 0x28, 0x0e, 0x06, 0x57, 0x8c, 0x5f, 0x8f, 0xe5, 0xd2, 0xf6, 0x5f,
-	0xcf, 0x73, 0xb6, 0xd7, 0x64, 0x8d, 0x6e, 0xcd, 0x76, 0x49, 0xdb, 0x51, 0xbd, 0x41, 0x7e, 0x2e,
-	0xd0, 0xfa, 0x9e, 0xc3, 0xf6, 0x3b, 0x98, 0xda, 0xe5, 0xe1, 0xdd, 0xae, 0x1c, 0xd3, 0xbe, 0xf4,
-	0xbd, 0x5c, 0x05, 0x49, 0xb7, 0x81, 0x9a, 0x7e, 0xb5, 0x59, 0xcf, 0xc4, 0x37, 0x8c, 0x42, 0xac,
-	0x92, 0x10, 0xe3, 0xeb, 0x75, 0x2b, 0x0f, 0x8e, 0xef, 0x50, 0xd6, 0x6c, 0x23, 0x86, 0xaf, 0xa1,
-	0x61, 0x20, 0xd2, 0x20, 0xe6, 0x21, 0x49, 0x3e, 0x5e, 0xe1, 0xbf, 0xd6, 0x8b, 0x98, 0xce, 0x69,
-	0x80, 0x5c, 0xbc, 0xdb, 0xd7, 0xe7, 0x2c, 0x82, 0x58, 0x9b, 0x7a, 0x2a, 0x5e, 0xb9, 0xc9, 0x78,
-	0xdd, 0xa2, 0xde, 0x0e, 0x9f, 0xc3, 0xdd, 0xf6, 0x6e, 0xbf, 0xc2, 0x6d, 0xe1, 0x65, 0xb0, 0xcc,
-	0xb8, 0x93, 0xaa, 0x4b, 0xfc, 0xbb, 0x4d, 0x4f, 0x9c, 0x34, 0xb5, 0xbd, 0x3e, 0xb9, 0x57, 0x40,
-	0x95, 0x85, 0x51, 0x25, 0xc5, 0x86, 0x03, 0x58, 0x06, 0xcb, 0x9d, 0x00, 0xd7, 0xb1, 0x8b, 0x29,
-	0x25, 0x01, 0xcd, 0xc4, 0x45, 0x41, 0xcd, 0x44, 0x0f, 0x6d, 0xe2, 0xaf, 0x64, 0xad, 0x45, 0xdc,
-	0x3d, 0xfd, 0x1e, 0x2d, 0x88, 0xc8, 0xa4, 0xc4, 0x9c, 0x7c, 0x8d, 0xe0, 0x3a, 0x00, 0xd2, 0x44,
-	0x5c, 0x9a, 0x45, 0x71, 0x69, 0x96, 0xc4, 0x8c, 0xe8, 0x33, 0x65, 0xbd, 0xcc, 0x5b, 0x61, 0x26,
-	0x21, 0x8e, 0x61, 0xda, 0xb2, 0x4f, 0xda, 0xba, 0x4f, 0xda, 0xbb, 0xba, 0x4f, 0x96, 0x92, 0xbc,
-	0x68, 0x1e, 0xff, 0x9e, 0x33, 0x94, 0x13, 0xbe, 0x32, 0x35, 0xf7, 0xc9, 0x7f, 0x27, 0xf7, 0x4b,
-	0xa1, 0xdc, 0x7f, 0x18, 0x4f, 0xce, 0xa7, 0x63, 0x95, 0x24, 0xeb, 0x57, 0x9b, 0x7e, 0x1d, 0xf7,
-	0xad, 0x73, 0xea, 0x05, 0x1b, 0x64, 0x78, 0xf8, 0xbc, 0xd4, 0x11, 0x43, 0xba, 0x94, 0xf9, 0xbf,
-	0xf5, 0x5d, 0x0c, 0x9c, 0x1c, 0x1a, 0x97, 0xf8, 0x69, 0x46, 0x2a, 0x82, 0xf5, 0xf5, 0x25, 0x9f,
-	0x5d, 0x11, 0xac, 0x4f, 0xdf, 0x40, 0x45, 0xfc, 0xd7, 0x93, 0x69, 0x5d, 0x00, 0xa7, 0x26, 0xf2,
-	0x71, 0x40, 0xfe, 0x4e, 0x0c, 0xfa, 0x2c, 0xc5, 0x57, 0xb1, 0x7e, 0xcf, 0xad, 0x3b, 0x83, 0x1e,
-	0xaa, 0xa6, 0x95, 0x8b, 0x1d, 0x90, 0xe4, 0x8f, 0x6e, 0xf5, 0x2e, 0x56, 0x7d, 0xac, 0x74, 0xee,
-	0xb7, 0xe7, 0xb9, 0xad, 0x43, 0x9c, 0xe7, 0xba, 0xcf, 0x78, 0xc3, 0x15, 0xee, 0xb6, 0x7f, 0x5e,
-	0x06, 0x0b, 0xc2, 0x3f, 0xfc, 0xdc, 0x00, 0x09, 0xa5, 0x33, 0xe0, 0xe6, 0x64, 0x9e, 0xa7, 0x08,
-	0x49, 0x73, 0x6b, 0x96, 0x99, 0xe4, 0x6a, 0xe5, 0x1f, 0xfd, 0xf2, 0xc7, 0xf7, 0xf3, 0x67, 0x60,
-	0x8e, 0xcb, 0x5e, 0x42, 0xb5, 0xf8, 0x55, 0x3a, 0xc3, 0x79, 0xa0, 0xf2, 0xf2, 0x10, 0xfe, 0x60,
-	0x80, 0x23, 0x21, 0x29, 0x07, 0xdf, 0x8e, 0x80, 0x98, 0x26, 0x19, 0xcd, 0xf3, 0x87, 0x33, 0x56,
-	0xac, 0x6c, 0xc1, 0xaa, 0x00, 0xb7, 0xc2, 0xac, 0xb4, 0x62, 0x9c, 0x20, 0xf7, 0xa3, 0x01, 0xd2,
-	0xe3, 0x8a, 0x0c, 0xda, 0x11, 0x90, 0x11, 0x42, 0xd0, 0x74, 0x0e, 0x6d, 0xaf, 0x58, 0x5e, 0x12,
-	0x2c, 0x2f, 0x42, 0x3b, 0xcc, 0xb2, 0xa7, 0xed, 0x87, 0x44, 0x47, 0x05, 0xe6, 0x43, 0xf8, 0xc8,
-	0x00, 0x09, 0xa5, 0xbb, 0x22, 0xd3, 0x19, 0x96, 0x74, 0x91, 0xe9, 0x1c, 0x93, 0x6f, 0x56, 0x41,
-	0x50, 0xb2, 0xe0, 0x46, 0x98, 0x92, 0xd2, 0x70, 0x74, 0x24, 0x64, 0x5f, 0x19, 0x20, 0xa1, 0xd4,
-	0x57, 0x24, 0x89, 0xb0, 0xd4, 0x8b, 0x24, 0x31, 0x26, 0xe2, 0xac, 0x0b, 0x82, 0x44, 0x1e, 0x6e,
-	0x86, 0x49, 0x50, 0x69, 0x36, 0xe4, 0xe0, 0x3c, 0xd8, 0xc3, 0xfb, 0x0f, 0x61, 0x0f, 0xc4, 0xb9,
-	0x40, 0x83, 0x56, 0x64, 0x89, 0x0c, 0x54, 0x9f, 0xf9, 0xff, 0x03, 0x6d, 0x14, 0xfe, 0xa6, 0xc0,
-	0xcf, 0xc1, 0xf5, 0xf1, 0xea, 0xa9, 0x87, 0x22, 0x40, 0xc1, 0xa2, 0xd4, 0x27, 0xf0, 0xad, 0x08,
-	0xaf, 0x21, 0x19, 0x64, 0x6e, 0xce, 0xb0, 0x52, 0xe8, 0x6b, 0x02, 0xfd, 0x24, 0x5c, 0x09, 0xa3,
-	0x4b, 0xf1, 0x03, 0x19, 0x48, 0x28, 0xed, 0x03, 0x37, 0x26, 0xfd, 0x85, 0x65, 0x91, 0x99, 0x9f,
-	0xd5, 0x0f, 0x34, 0x66, 0x56, 0x60, 0x66, 0xe0, 0xc9, 0x30, 0x26, 0x66, 0x8d, 0xaa, 0xcb, 0xa1,
-	0xee, 0x83, 0xd4, 0x88, 0x70, 0x39, 0x04, 0xf2, 0x94, 0xb3, 0x4e, 0x51, 0x3e, 0x96, 0x25, 0x70,
-	0xd7, 0xa0, 0x39, 0x86, 0xab, 0x4c, 0xab, 0x1e, 0xa2, 0xb0, 0x0f, 0x12, 0xaa, 0x47, 0x46, 0xd6,
-	0x59, 0x58, 0x25, 0x45, 0xd6, 0xd9, 0x58, 0xab, 0x8d, 0x3a, 0xb5, 0x6c, 0x8e, 0xac, 0x0f, 0xbf,
-	0x30, 0x00, 0x18, 0xbe, 0xf0, 0xb0, 0x70, 0x90, 0xdb, 0xd1, 0xa6, 0x6c, 0x9e, 0x3d, 0x84, 0xa5,
-	0xe2, 0x70, 0x46, 0x70, 0x38, 0x0d, 0x57, 0xa7, 0x71, 0x10, 0xad, 0x8e, 0x07, 0x40, 0x75, 0x88,
-	0x03, 0x6e, 0xfb, 0x68, 0x63, 0x39, 0xe0, 0xb6, 0x87, 0x1a, 0x4d, 0x54, 0x00, 0x74, 0xf3, 0x29,
-	0x5d, 0x7e, 0xfa, 0x32, 0x6b, 0x3c, 0x7b, 0x99, 0x35, 0x5e, 0xbc, 0xcc, 0x1a, 0x8f, 0x5f, 0x65,
-	0xe7, 0x9e, 0xbd, 0xca, 0xce, 0xfd, 0xfa, 0x2a, 0x3b, 0xf7, 0xd9, 0x68, 0x33, 0x1a, 0xec, 0x25,
-	0xd4, 0xe9, 0x15, 0x8b, 0x4e, 0x5f, 0xf8, 0x11, 0x0d, 0xa9, 0xb6, 0x28, 0x7a, 0xf9, 0x3b, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x63, 0x92, 0x44, 0x58, 0x97, 0x11, 0x00, 0x00,
+	// 1603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0x2d, 0xd9, 0x92, 0x8f, 0x9c, 0xc4, 0x77, 0xac, 0x24, 0x32, 0x63, 0x5b, 0x0e, 0x6f,
+	0x2c, 0x3f, 0xe2, 0x90, 0xb1, 0x2f, 0x10, 0xe0, 0x16, 0x28, 0x1a, 0x4b, 0x70, 0xd2, 0x34, 0x49,
+	0x91, 0x2a, 0x4e, 0x16, 0x05, 0x02, 0x61, 0x44, 0x8d, 0x29, 0xc2, 0x12, 0xa9, 0x70, 0x28, 0x41,
+	0x76, 0xe0, 0x45, 0x83, 0xa2, 0x0f, 0x14, 0x28, 0x82, 0x76, 0x57, 0xa0, 0x40, 0xf6, 0xfd, 0x01,
+	0xfd, 0x0b, 0x59, 0x06, 0xe8, 0xa6, 0xe8, 0xc2, 0x0d, 0x9c, 0x2e, 0xfa, 0x03, 0xba, 0xea, 0xaa,
+	0x98, 0xe1, 0x50, 0x22, 0xf5, 0x4e, 0x91, 0xae, 0xba, 0x12, 0xe7, 0xcc, 0x99, 0x73, 0xbe, 0xf3,
+	0x98, 0x33, 0x9f, 0x60, 0x9e, 0xb8, 0x65, 0xe2, 0x54, 0x4d, 0xcb, 0xd5, 0x48, 0xa3, 0xaa, 0x35,
+	0x36, 0xb5, 0xc7, 0x75, 0xe2, 0x1c, 0xa8, 0x35, 0xc7, 0x76, 0x6d, 0x34, 0xd3, 0xda, 0x55, 0x49,
+	0xa3, 0xaa, 0x36, 0x36, 0xe5, 0x75, 0xdd, 0xa6, 0x55, 0x9b, 0x6a, 0x45, 0x4c, 0x89, 0xa7, 0xaa,
+	0x35, 0x36, 0x8b, 0xc4, 0xc5, 0x9b, 0x5a, 0x0d, 0x1b, 0xa6, 0x85, 0x5d, 0xd3, 0xb6, 0xbc, 0xd3,
+	0xb2, 0xdc, 0x65, 0x9b, 0x19, 0xf1, 0xf6, 0xe6, 0xba, 0xf6, 0xdc, 0xa6, 0xd8, 0x4a, 0x1a, 0xb6,
+	0x61, 0xf3, 0x4f, 0x8d, 0x7d, 0x09, 0xe9, 0xbc, 0x61, 0xdb, 0x46, 0x85, 0x68, 0xb8, 0x66, 0x6a,
+	0xd8, 0xb2, 0x6c, 0x97, 0x7b, 0xa2, 0x62, 0x37, 0x2d, 0x76, 0xf9, 0xaa, 0x58, 0xdf, 0xd3, 0x5c,
+	0xb3, 0x4a, 0xa8, 0x8b, 0xab, 0x35, 0x4f, 0x41, 0xf9, 0x3f, 0xcc, 0x7e, 0xc4, 0xd0, 0x6e, 0xeb,
+	0xba, 0x5d, 0xb7, 0xdc, 0x3c, 0x79, 0x5c, 0x27, 0xd4, 0x45, 0x29, 0x88, 0xe1, 0x52, 0xc9, 0x21,
+	0x94, 0xa6, 0xa4, 0x25, 0x69, 0x75, 0x2a, 0xef, 0x2f, 0xdf, 0x89, 0x7f, 0xf1, 0x3c, 0x3d, 0xf6,
+	0xfb, 0xf3, 0xf4, 0x98, 0xa2, 0x43, 0x32, 0x7c, 0x94, 0xd6, 0x6c, 0x8b, 0x12, 0x76, 0xb6, 0x88,
+	0x2b, 0xd8, 0xd2, 0x89, 0x7f, 0x56, 0x2c, 0xd1, 0x05, 0x98, 0xd2, 0xed, 0x12, 0x29, 0x94, 0x31,
+	0x2d, 0xa7, 0xc6, 0xf9, 0x5e, 0x9c, 0x09, 0xde, 0xc7, 0xb4, 0x8c, 0x92, 0x30, 0x61, 0xd9, 0xec,
+	0x50, 0x64, 0x49, 0x5a, 0x8d, 0xe6, 0xbd, 0x85, 0xf2, 0x1e, 0xcc, 0x71, 0x27, 0x39, 0x9e, 0xde,
+	0xbf, 0x81, 0xf2, 0x33, 0x09, 0xe4, 0x5e, 0x16, 0x04, 0xd8, 0x65, 0x38, 0xed, 0x55, 0xae, 0x10,
+	0xb6, 0x74, 0xca, 0x93, 0x6e, 0x7b, 0x42, 0x24, 0x43, 0x9c, 0x32, 0xa7, 0x0c, 0xdf, 0x38, 0xc7,
+	0xd7, 0x5a, 0x33, 0x13, 0xd8, 0xb3, 0x5a, 0xb0, 0xea, 0xd5, 0x22, 0x71, 0x44, 0x04, 0xa7, 0x84,
+	0xf4, 0x43, 0x2e, 0x54, 0x6e, 0xc3, 0x3c, 0xc7, 0xf1, 0x10, 0x57, 0xcc, 0x12, 0x76, 0x6d, 0xa7,
+	0x23, 0x98, 0x8b, 0x30, 0xad, 0xdb, 0x56, 0x27, 0x8e, 0x04, 0x93, 0x6d, 0x77, 0x45, 0xf5, 0x95,
+	0x04, 0x0b, 0x7d, 0xac, 0x89, 0xc0, 0x56, 0xe0, 0x8c, 0x8f, 0x2a, 0x6c, 0xd1, 0x07, 0xfb, 0x16,
+	0x43, 0xf3, 0x9b, 0x28, 0xeb, 0xd5, 0xf9, 0x4d, 0xca, 0x73, 0x15, 0x92, 0xe1, 0xa3, 0xc3, 0x9a,
+	0x48, 0xb9, 0x2d, 0x9c, 0xdd, 0x77, 0x6d, 0x07, 0x1b, 0xc3, 0x9d, 0xa1, 0x19, 0x88, 0xec, 0x93,
+	0x03, 0xd1, 0x6f, 0xec, 0x33, 0xe0, 0x7e, 0x03, 0x92, 0x61, 0x63, 0xc2, 0x7d, 0x12, 0x26, 0x1a,
+	0xb8, 0x52, 0xf7, 0x9d, 0x7b, 0x0b, 0xe5, 0x1a, 0xcc, 0x88, 0x56, 0x2a, 0xbd, 0x51, 0x90, 0x2b,
+	0xf0, 0x9f, 0xc0, 0x39, 0xe1, 0x02, 0x41, 0x94, 0xf5, 0x3e, 0x3f, 0x35, 0x9d, 0xe7, 0xdf, 0x4a,
+	0xb6, 0xd5, 0xed, 0xde, 0xa5, 0x78, 0x40, 0x03, 0x11, 0x86, 0x6e, 0x8f, 0x14, 0xbe, 0x3d, 0x01,
+	0x67, 0x0f, 0x5b, 0xfd, 0x1e, 0xb2, 0x21, 0xbc, 0x5e, 0x80, 0x29, 0x87, 0xec, 0x15, 0x78, 0xf5,
+	0xb8, 0x91, 0x68, 0x3e, 0xee, 0x90, 0xbd, 0x1c, 0x5b, 0xb7, 0x3c, 0x50, 0xf3, 0xb0, 0xd5, 0x0b,
+	0x4c, 0x70, 0xdf, 0x3c, 0x24, 0xca, 0xbb, 0x70, 0x5e, 0xd8, 0xb5, 0x5c, 0x07, 0xeb, 0xee, 0x76,
+	0xf6, 0xd6, 0x9b, 0xe4, 0xe0, 0x31, 0xa4, 0xba, 0x8f, 0x0b, 0x50, 0x0f, 0x78, 0xeb, 0x73, 0x71,
+	0x01, 0x17, 0x4d, 0x6e, 0x24, 0xb1, 0xb5, 0xa0, 0x76, 0x4e, 0x59, 0x35, 0x70, 0x38, 0x3b, 0xfb,
+	0xe2, 0x38, 0x3d, 0x76, 0x72, 0x9c, 0x4e, 0x04, 0x2d, 0x26, 0x7c, 0x3b, 0xdb, 0x45, 0x53, 0x39,
+	0x04, 0xc4, 0x5d, 0xee, 0x36, 0xef, 0xd8, 0x06, 0xf5, 0xc1, 0x22, 0x88, 0x06, 0x32, 0xc8, 0xbf,
+	0xd1, 0x0d, 0x80, 0xf6, 0x94, 0xe6, 0x91, 0x27, 0xb6, 0x32, 0xaa, 0x37, 0x02, 0x54, 0x36, 0xd2,
+	0x55, 0x6f, 0xfa, 0x8b, 0x91, 0xae, 0xde, 0x6b, 0x97, 0x25, 0x1f, 0x38, 0x19, 0x08, 0xf7, 0x4b,
+	0x09, 0x66, 0x43, 0xce, 0x45, 0xa8, 0x6b, 0x10, 0xad, 0xd8, 0x06, 0xcb, 0x53, 0x64, 0x35, 0xb1,
+	0x75, 0xb6, 0x3b, 0xc4, 0x3b, 0xb6, 0x91, 0xe7, 0x2a, 0xe8, 0x66, 0x0f, 0x50, 0x2b, 0x43, 0x41,
+	0x79, 0x7e, 0x82, 0xa8, 0x94, 0xa4, 0xc8, 0xc3, 0x3d, 0xec, 0xe0, 0xaa, 0x9f, 0x07, 0xe5, 0x2e,
+	0xcc, 0x86, 0xa4, 0x02, 0xe0, 0x35, 0x98, 0xac, 0x71, 0x89, 0xa8, 0x42, 0xaa, 0x1b, 0xa2, 0x77,
+	0x22, 0x1b, 0x65, 0x05, 0xc8, 0x0b, 0x6d, 0xe5, 0x47, 0x09, 0x4e, 0xef, 0xb8, 0xe5, 0x1c, 0xae,
+	0x54, 0x02, 0x99, 0xc6, 0x8e, 0x41, 0xfd, 0x0e, 0x67, 0xdf, 0xe8, 0x3c, 0xc4, 0x0c, 0x4c, 0x0b,
+	0x3a, 0xae, 0x89, 0x06, 0x9b, 0x34, 0x30, 0xcd, 0xe1, 0x1a, 0x7a, 0x04, 0x33, 0x35, 0xc7, 0xae,
+	0xd9, 0x94, 0x38, 0xad, 0x81, 0xc5, 0x86, 0xcd, 0x74, 0x76, 0xeb, 0xcf, 0xe3, 0xb4, 0x6a, 0x98,
+	0x6e, 0xb9, 0x5e, 0x54, 0x75, 0xbb, 0xaa, 0x89, 0x97, 0xd6, 0xfb, 0xb9, 0x42, 0x4b, 0xfb, 0x9a,
+	0x7b, 0x50, 0x23, 0x54, 0xcd, 0xb5, 0x27, 0x65, 0xfe, 0x8c, 0x6f, 0x4b, 0x08, 0xd0, 0x1c, 0xc4,
+	0xf5, 0x32, 0x36, 0xad, 0x82, 0x59, 0x4a, 0x45, 0x97, 0xa4, 0xd5, 0x48, 0x3e, 0xc6, 0xd7, 0xb7,
+	0x4a, 0xca, 0x0a, 0xcc, 0xee, 0x50, 0xd7, 0xac, 0x62, 0x97, 0xdc, 0xc4, 0xed, 0x44, 0xcc, 0x40,
+	0xc4, 0xc0, 0x54, 0xdc, 0x11, 0xf6, 0xa9, 0xbc, 0x8a, 0xf8, 0x35, 0x75, 0xb0, 0x4e, 0x76, 0x9b,
+	0x7e, 0x9c, 0x9b, 0x10, 0xa9, 0x52, 0x43, 0xe4, 0x2b, 0xdd, 0x9d, 0xaf, 0xbb, 0xd4, 0xd8, 0x61,
+	0x32, 0x52, 0xaf, 0xee, 0x36, 0xf3, 0x4c, 0x17, 0x5d, 0x87, 0x69, 0xd6, 0xa6, 0xa4, 0xa0, 0xdb,
+	0xd6, 0x9e, 0x69, 0xa4, 0x22, 0xfd, 0x3a, 0x9e, 0xbb, 0xca, 0x71, 0xa5, 0x7c, 0xc2, 0x6d, 0x2f,
+	0x50, 0x0e, 0xa6, 0x6b, 0x0e, 0x29, 0x11, 0x9d, 0x50, 0x6a, 0x3b, 0x34, 0x15, 0x5d, 0x8a, 0x8c,
+	0xe2, 0x3d, 0x74, 0x88, 0xbd, 0x39, 0xc5, 0x8a, 0xad, 0xef, 0xfb, 0xd3, 0x7d, 0x82, 0x67, 0x26,
+	0xc1, 0x65, 0xde, 0x6c, 0x47, 0x0b, 0x00, 0x9e, 0x0a, 0xbf, 0x34, 0x93, 0xfc, 0xd2, 0x4c, 0x71,
+	0x09, 0x7f, 0xb5, 0x73, 0xfe, 0x36, 0x23, 0x16, 0xa9, 0x18, 0x0f, 0x43, 0x56, 0x3d, 0xd6, 0xa1,
+	0xfa, 0xac, 0x43, 0xdd, 0xf5, 0x59, 0x47, 0x36, 0xce, 0x9a, 0xe6, 0xd9, 0xaf, 0x69, 0x49, 0x18,
+	0x61, 0x3b, 0x3d, 0x6b, 0x1f, 0xff, 0x67, 0x6a, 0x3f, 0x15, 0xaa, 0xfd, 0x07, 0xd1, 0xf8, 0xf8,
+	0x4c, 0x24, 0x1f, 0x77, 0x9b, 0x05, 0xd3, 0x2a, 0x91, 0xa6, 0xb2, 0x2e, 0xde, 0x83, 0x56, 0x85,
+	0xdb, 0xc3, 0xba, 0x84, 0x5d, 0xec, 0xb7, 0x32, 0xfb, 0x56, 0xbe, 0x8e, 0xc0, 0xb9, 0xb6, 0x72,
+	0x96, 0x45, 0x13, 0xe8, 0x08, 0xb7, 0xe9, 0x5f, 0xf2, 0xe1, 0x1d, 0xe1, 0x36, 0xe9, 0x5b, 0xe8,
+	0x88, 0x7f, 0x7b, 0x31, 0x95, 0x2b, 0xe2, 0x85, 0x0a, 0xd6, 0x63, 0x40, 0xfd, 0xce, 0xb6, 0x58,
+	0x0b, 0x25, 0x37, 0x88, 0x3f, 0xcf, 0x95, 0x47, 0x90, 0x0c, 0x8b, 0x85, 0x89, 0x1d, 0x88, 0xb3,
+	0xa1, 0x5b, 0xd8, 0x23, 0x82, 0x15, 0x64, 0xd7, 0x7f, 0x39, 0x4e, 0x67, 0x46, 0x88, 0xe7, 0x96,
+	0xe5, 0x32, 0xfa, 0xc2, 0xcd, 0x6d, 0xfd, 0x71, 0x1a, 0x26, 0xb8, 0x7d, 0xf4, 0x89, 0x04, 0x31,
+	0xc1, 0xda, 0xd0, 0x72, 0x77, 0x9d, 0x7b, 0xd0, 0x72, 0x39, 0x33, 0x4c, 0xcd, 0xc3, 0xaa, 0xac,
+	0x3c, 0xfd, 0xe9, 0xb7, 0x6f, 0xc7, 0x2f, 0xa2, 0x34, 0xfb, 0x13, 0x61, 0x53, 0xff, 0xaf, 0x84,
+	0x60, 0x6d, 0xda, 0x13, 0x51, 0x97, 0x23, 0xf4, 0x9d, 0x04, 0xa7, 0x42, 0xc4, 0x18, 0x5d, 0xee,
+	0xe3, 0xa2, 0x17, 0x01, 0x97, 0x37, 0x46, 0x53, 0x16, 0xa8, 0x54, 0x8e, 0x6a, 0x15, 0x65, 0xc2,
+	0xa8, 0x7c, 0xfe, 0xdd, 0x05, 0xee, 0x07, 0x09, 0x66, 0x3a, 0xf9, 0x2d, 0x52, 0xfb, 0xb8, 0xec,
+	0x43, 0xab, 0x65, 0x6d, 0x64, 0x7d, 0x81, 0xf2, 0x1a, 0x47, 0x79, 0x15, 0xa9, 0x61, 0x94, 0x0d,
+	0x5f, 0xbf, 0x0d, 0x34, 0x48, 0xd7, 0x8f, 0xd0, 0x53, 0x09, 0x62, 0x82, 0xc5, 0xf6, 0x2d, 0x67,
+	0x98, 0x20, 0xcb, 0x99, 0x61, 0x6a, 0x02, 0xd2, 0x2a, 0x87, 0xa4, 0xa0, 0xa5, 0x30, 0x24, 0xc1,
+	0x88, 0x69, 0x20, 0x65, 0x9f, 0x4b, 0x10, 0x13, 0x5c, 0xb6, 0x2f, 0x88, 0x30, 0x71, 0x96, 0x33,
+	0xc3, 0xd4, 0x04, 0x88, 0x2b, 0x1c, 0xc4, 0x0a, 0x5a, 0x0e, 0x83, 0xa0, 0x9e, 0x5a, 0x1b, 0x83,
+	0xf6, 0x64, 0x9f, 0x1c, 0x1c, 0xa1, 0x06, 0x44, 0x19, 0x03, 0x45, 0x4a, 0xdf, 0x16, 0x69, 0x71,
+	0x68, 0xf9, 0xbf, 0x03, 0x75, 0x84, 0xff, 0x65, 0xee, 0x3f, 0x8d, 0x16, 0x3a, 0xbb, 0xa7, 0x14,
+	0xca, 0xc0, 0xf7, 0xbc, 0xa3, 0x03, 0xd4, 0x77, 0x40, 0x47, 0x77, 0x93, 0x6c, 0x79, 0x63, 0x34,
+	0x65, 0x81, 0x69, 0x93, 0x63, 0xba, 0x8c, 0xd6, 0xba, 0x31, 0xf1, 0x11, 0x5b, 0xa8, 0x53, 0x9e,
+	0x9b, 0x96, 0xe0, 0x08, 0x7d, 0x23, 0x41, 0x90, 0xb1, 0xa2, 0xb5, 0xbe, 0x0e, 0x3b, 0x69, 0xb6,
+	0xbc, 0x3e, 0x8a, 0xaa, 0x40, 0xb6, 0xc1, 0x91, 0x65, 0xd0, 0xa5, 0x4e, 0x64, 0x6d, 0x9a, 0x1d,
+	0x48, 0x1a, 0x85, 0x49, 0x8f, 0xd4, 0xa1, 0x4b, 0x7d, 0x7c, 0x84, 0xb8, 0xa3, 0xbc, 0x3c, 0x44,
+	0x4b, 0x80, 0x98, 0xe7, 0x20, 0xce, 0xa1, 0x64, 0x18, 0x84, 0xc7, 0x18, 0x91, 0x0b, 0x31, 0x41,
+	0x18, 0xd1, 0x52, 0xb7, 0xbd, 0x30, 0x97, 0x94, 0x57, 0x86, 0x3d, 0xa2, 0xbe, 0xcf, 0x45, 0xee,
+	0x33, 0x85, 0xce, 0x85, 0x7d, 0x12, 0xb7, 0x5c, 0xd0, 0x99, 0xab, 0x43, 0x48, 0x04, 0xd8, 0xde,
+	0x08, 0x9e, 0x7b, 0xc4, 0xda, 0x83, 0x2e, 0x2a, 0x0a, 0xf7, 0x3b, 0x8f, 0xe4, 0x0e, 0xbf, 0x42,
+	0xb5, 0x60, 0x60, 0x8a, 0x9a, 0x10, 0x13, 0xc4, 0xa2, 0xef, 0xe5, 0x0c, 0x53, 0x4b, 0x39, 0x33,
+	0x4c, 0x6d, 0x70, 0xd4, 0x1e, 0xa3, 0x70, 0x9b, 0xe8, 0x53, 0x09, 0xa0, 0xfd, 0x2c, 0xa2, 0xd5,
+	0x41, 0x66, 0x83, 0x4c, 0x46, 0x5e, 0x1b, 0x41, 0x53, 0x60, 0xb8, 0xc8, 0x31, 0x5c, 0x40, 0x73,
+	0xbd, 0x30, 0x70, 0x7e, 0xc0, 0x12, 0x20, 0x9e, 0xd5, 0x01, 0x23, 0x32, 0xf8, 0x1a, 0xcb, 0x99,
+	0x61, 0x6a, 0x83, 0x13, 0xe0, 0xbf, 0xd8, 0xd9, 0xeb, 0x2f, 0x4e, 0x16, 0xa5, 0x97, 0x27, 0x8b,
+	0xd2, 0xab, 0x93, 0x45, 0xe9, 0xd9, 0xeb, 0xc5, 0xb1, 0x97, 0xaf, 0x17, 0xc7, 0x7e, 0x7e, 0xbd,
+	0x38, 0xf6, 0x71, 0xf0, 0x05, 0x6f, 0x9d, 0xb5, 0xa9, 0xd6, 0xd8, 0xdc, 0xd2, 0x9a, 0xdc, 0x0e,
+	0x7f, 0xc5, 0x8b, 0x93, 0x9c, 0x00, 0xfd, 0xef, 0xaf, 0x01, 0x00, 0xc9, 0x92, 0xf7, 0x57, 0x1a,
+	0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1556,6 +1775,11 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// CodeHashUsage queries the number of accounts that reference a contract code
+	// hash and the size of the stored code.
+	CodeHashUsage(ctx context.Context, in *QueryCodeHashUsageRequest, opts ...grpc.CallOption) (*QueryCodeHashUsageResponse, error)
+	// ContractABI queries the ABI and metadata registered for a contract.
+	ContractABI(ctx context.Context, in *QueryContractABIRequest, opts ...grpc.CallOption) (*QueryContractABIResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
@@ -1633,6 +1857,24 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) CodeHashUsage(ctx context.Context, in *QueryCodeHashUsageRequest, opts ...grpc.CallOption) (*QueryCodeHashUsageResponse, error) {
+	out := new(QueryCodeHashUsageResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CodeHashUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractABI(ctx context.Context, in *QueryContractABIRequest, opts ...grpc.CallOption) (*QueryContractABIResponse, error) {
+	out := new(QueryContractABIResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/ContractABI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Params", in, out, opts...)
@@ -1703,6 +1945,11 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// CodeHashUsage queries the number of accounts that reference a contract code
+	// hash and the size of the stored code.
+	CodeHashUsage(context.Context, *QueryCodeHashUsageRequest) (*QueryCodeHashUsageResponse, error)
+	// ContractABI queries the ABI and metadata registered for a contract.
+	ContractABI(context.Context, *QueryContractABIRequest) (*QueryContractABIResponse, error)
 	// Params queries the parameters of x/evm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
@@ -1745,6 +1992,14 @@ func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
 
+func (*UnimplementedQueryServer) CodeHashUsage(ctx context.Context, req *QueryCodeHashUsageRequest) (*QueryCodeHashUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeHashUsage not implemented")
+}
+
+func (*UnimplementedQueryServer) ContractABI(ctx context.Context, req *QueryContractABIRequest) (*QueryContractABIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractABI not implemented")
+}
+
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1881,6 +2136,42 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CodeHashUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeHashUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeHashUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CodeHashUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeHashUsage(ctx, req.(*QueryCodeHashUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractABIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/ContractABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractABI(ctx, req.(*QueryContractABIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2017,6 +2308,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "CodeHashUsage",
+			Handler:    _Query_CodeHashUsage_Handler,
+		},
+		{
+			MethodName: "ContractABI",
+			Handler:    _Query_ContractABI_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2445,7 +2744,7 @@ func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCodeHashUsageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2455,39 +2754,27 @@ func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCodeHashUsageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCodeHashUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCodeHashUsageResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2497,46 +2784,30 @@ func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCodeHashUsageResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCodeHashUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.CodeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeSize))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.RefCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RefCount))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractABIRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2546,20 +2817,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractABIRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractABIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractABIResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2569,12 +2847,159 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractABIResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractABIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractABI.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2699,12 +3124,12 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BlockHash) > 0 {
@@ -2822,12 +3247,12 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x42
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BlockHash) > 0 {
@@ -3150,6 +3575,58 @@ func (m *QueryCodeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCodeHashUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeHashUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefCount != 0 {
+		n += 1 + sovQuery(uint64(m.RefCount))
+	}
+	if m.CodeSize != 0 {
+		n += 1 + sovQuery(uint64(m.CodeSize))
+	}
+	return n
+}
+
+func (m *QueryContractABIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractABIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractABI.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryTxLogsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3268,7 +3745,7 @@ func (m *QueryTraceTxRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
 	n += 1 + l + sovQuery(uint64(l))
 	l = len(m.ProposerAddress)
 	if l > 0 {
@@ -3316,7 +3793,7 @@ func (m *QueryTraceBlockRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
 	n += 1 + l + sovQuery(uint64(l))
 	l = len(m.ProposerAddress)
 	if l > 0 {
@@ -4528,6 +5005,345 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *QueryCodeHashUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeHashUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeHashUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryCodeHashUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeHashUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeHashUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefCount", wireType)
+			}
+			m.RefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
+			}
+			m.CodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractABIRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractABIRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractABIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractABIResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractABIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractABIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractABI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractABI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5345,7 +6161,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5688,7 +6504,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33,6 +33,7 @@ const (
 	TracerJSON       = "json"
 	TracerStruct     = "struct"
 	TracerMarkdown   = "markdown"
+	TracerGasProfile = "gas_profile"
 )
 
 // NewTracer creates a new Logger tracer to collect execution traces from an
@@ -45,7 +46,7 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 	switch tracer {
 	case TracerAccessList:
-		preCompiles := vm.ActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil))
+		preCompiles := vm.DefaultActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil))
 		return logger.NewAccessListTracer(msg.AccessList(), msg.From(), *msg.To(), preCompiles)
 	case TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stderr)
@@ -53,6 +54,8 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 		return logger.NewMarkdownLogger(logCfg, os.Stdout) // TODO: Stderr ?
 	case TracerStruct:
 		return logger.NewStructLogger(logCfg)
+	case TracerGasProfile:
+		return NewGasProfiler(true)
 	default:
 		return NewNoOpTracer()
 	}
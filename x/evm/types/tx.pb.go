@@ -16,7 +16,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
-	grpc1 "github.com/gogo/protobuf/grpc"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -481,6 +481,139 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterContractABI defines a Msg for registering the ABI and metadata of a
+// contract. The sender must be the account that created the contract with
+// CREATE, which is proved by the nonce of the contract creation. The ABIs of
+// the contracts created by other contracts (e.g. with CREATE2) can only be
+// registered by the governance account, which doesn't need a proof.
+type MsgRegisterContractABI struct {
+	// sender is the bech32 address of the deployer or of the governance account
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// abi is the JSON ABI of the contract
+	ABI string `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	// metadata is an arbitrary description of the contract
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// nonce is the nonce of the sender when the contract was created with CREATE
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgRegisterContractABI) Reset()         { *m = MsgRegisterContractABI{} }
+func (m *MsgRegisterContractABI) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterContractABI) ProtoMessage()    {}
+func (*MsgRegisterContractABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+
+func (m *MsgRegisterContractABI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRegisterContractABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterContractABI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRegisterContractABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterContractABI.Merge(m, src)
+}
+
+func (m *MsgRegisterContractABI) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRegisterContractABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterContractABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterContractABI proto.InternalMessageInfo
+
+func (m *MsgRegisterContractABI) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterContractABI) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgRegisterContractABI) GetABI() string {
+	if m != nil {
+		return m.ABI
+	}
+	return ""
+}
+
+func (m *MsgRegisterContractABI) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgRegisterContractABI) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// MsgRegisterContractABIResponse defines the response structure for executing a
+// MsgRegisterContractABI message.
+type MsgRegisterContractABIResponse struct{}
+
+func (m *MsgRegisterContractABIResponse) Reset()         { *m = MsgRegisterContractABIResponse{} }
+func (m *MsgRegisterContractABIResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterContractABIResponse) ProtoMessage()    {}
+func (*MsgRegisterContractABIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+
+func (m *MsgRegisterContractABIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgRegisterContractABIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterContractABIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgRegisterContractABIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterContractABIResponse.Merge(m, src)
+}
+
+func (m *MsgRegisterContractABIResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgRegisterContractABIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterContractABIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterContractABIResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -490,73 +623,82 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterContractABI)(nil), "ethermint.evm.v1.MsgRegisterContractABI")
+	proto.RegisterType((*MsgRegisterContractABIResponse)(nil), "ethermint.evm.v1.MsgRegisterContractABIResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x24, 0x2c, 0xd5, 0x68, 0xab, 0x3a, 0x01, 0xe2, 0x34, 0x07, 0x48,
-	0x2b, 0xc5, 0xd6, 0x2e, 0xa8, 0x87, 0x3d, 0x75, 0xd3, 0xdd, 0x56, 0xad, 0x76, 0x45, 0x65, 0xd2,
-	0x0b, 0x45, 0x8a, 0x66, 0x9d, 0xd9, 0x89, 0x45, 0xec, 0xb1, 0x3c, 0x13, 0x2b, 0xe1, 0xd8, 0x13,
-	0x37, 0x40, 0x7c, 0x01, 0x0e, 0x9c, 0x38, 0x21, 0xd1, 0x0f, 0xc0, 0xb1, 0xe2, 0x54, 0xc1, 0x05,
-	0x71, 0x30, 0x28, 0x8b, 0x84, 0xb4, 0x37, 0xf8, 0x04, 0x68, 0x66, 0x9c, 0x64, 0xd3, 0xb0, 0x2d,
-	0x94, 0x45, 0x9c, 0x3c, 0xcf, 0xef, 0xcd, 0x7b, 0x6f, 0x7e, 0xbf, 0xdf, 0xfc, 0x01, 0x35, 0xcc,
-	0x87, 0x38, 0xf2, 0xbd, 0x80, 0xdb, 0x38, 0xf6, 0xed, 0x78, 0xcb, 0xe6, 0x13, 0x2b, 0x8c, 0x28,
-	0xa7, 0xf0, 0xd2, 0xc2, 0x65, 0xe1, 0xd8, 0xb7, 0xe2, 0xad, 0xfa, 0x15, 0x97, 0x32, 0x9f, 0x32,
-	0xdb, 0x67, 0x44, 0x44, 0xfa, 0x8c, 0xa8, 0xd0, 0x7a, 0x4d, 0x39, 0xfa, 0xd2, 0xb2, 0x95, 0x91,
-	0xba, 0xea, 0x6b, 0x05, 0x44, 0x32, 0xe5, 0xdb, 0x24, 0x94, 0x50, 0x35, 0x47, 0x8c, 0xd2, 0xbf,
-	0xaf, 0x13, 0x4a, 0xc9, 0x08, 0xdb, 0x28, 0xf4, 0x6c, 0x14, 0x04, 0x94, 0x23, 0xee, 0xd1, 0x60,
-	0x9e, 0xaf, 0x96, 0x7a, 0xa5, 0x75, 0x34, 0x3e, 0xb6, 0x51, 0x30, 0x55, 0xae, 0xd6, 0x27, 0x1a,
-	0x78, 0xe5, 0x90, 0x91, 0x7d, 0x51, 0x10, 0x8f, 0xfd, 0xde, 0x04, 0xb6, 0x81, 0x3e, 0x40, 0x1c,
-	0x19, 0x5a, 0x53, 0x6b, 0x57, 0xb6, 0x37, 0x2d, 0x35, 0xd7, 0x9a, 0xcf, 0xb5, 0x76, 0x83, 0xa9,
-	0x23, 0x23, 0x60, 0x0d, 0xe8, 0xcc, 0xfb, 0x08, 0x1b, 0xd9, 0xa6, 0xd6, 0xd6, 0xba, 0xf9, 0xd3,
-	0xc4, 0xd4, 0x3a, 0x8e, 0xfc, 0x05, 0x4d, 0xa0, 0x0f, 0x11, 0x1b, 0x1a, 0xb9, 0xa6, 0xd6, 0x2e,
-	0x77, 0x2b, 0x7f, 0x24, 0x66, 0x31, 0x1a, 0x85, 0x3b, 0xad, 0x4e, 0xcb, 0x91, 0x0e, 0x08, 0x81,
-	0x7e, 0x1c, 0x51, 0xdf, 0xd0, 0x45, 0x80, 0x23, 0xc7, 0x3b, 0xfa, 0xc7, 0x5f, 0x98, 0x99, 0xd6,
-	0x37, 0x59, 0x50, 0x3a, 0xc0, 0x04, 0xb9, 0xd3, 0xde, 0x04, 0x6e, 0x82, 0x7c, 0x40, 0x03, 0x17,
-	0xcb, 0x6e, 0x74, 0x47, 0x19, 0xf0, 0x0e, 0x28, 0x13, 0x24, 0x90, 0xf3, 0x5c, 0x55, 0xbd, 0xdc,
-	0xbd, 0xfe, 0x53, 0x62, 0xbe, 0x49, 0x3c, 0x3e, 0x1c, 0x1f, 0x59, 0x2e, 0xf5, 0x53, 0x3c, 0xd3,
-	0x4f, 0x87, 0x0d, 0x3e, 0xb4, 0xf9, 0x34, 0xc4, 0xcc, 0xba, 0x1b, 0x70, 0xa7, 0x44, 0x10, 0xbb,
-	0x2f, 0xe6, 0xc2, 0x06, 0xc8, 0x11, 0xc4, 0x64, 0x97, 0x7a, 0xb7, 0x3a, 0x4b, 0xcc, 0xd2, 0x1d,
-	0xc4, 0x0e, 0x3c, 0xdf, 0xe3, 0x8e, 0x70, 0xc0, 0x0d, 0x90, 0xe5, 0x34, 0xed, 0x31, 0xcb, 0x29,
-	0xbc, 0x07, 0xf2, 0x31, 0x1a, 0x8d, 0xb1, 0x91, 0x97, 0x45, 0xdf, 0xf9, 0xfb, 0x45, 0x67, 0x89,
-	0x59, 0xd8, 0xf5, 0xe9, 0x38, 0xe0, 0x8e, 0x4a, 0x21, 0x10, 0x90, 0x38, 0x17, 0x9a, 0x5a, 0xbb,
-	0x9a, 0x22, 0x5a, 0x05, 0x5a, 0x6c, 0x14, 0xe5, 0x0f, 0x2d, 0x16, 0x56, 0x64, 0x94, 0x94, 0x15,
-	0x09, 0x8b, 0x19, 0x65, 0x65, 0xb1, 0x9d, 0x0d, 0x81, 0xd5, 0x77, 0x8f, 0x3b, 0x85, 0xde, 0x64,
-	0x0f, 0x71, 0xd4, 0xfa, 0x3d, 0x07, 0xaa, 0xbb, 0xae, 0x8b, 0x19, 0x3b, 0xf0, 0x18, 0xef, 0x4d,
-	0xe0, 0x43, 0x50, 0x72, 0x87, 0xc8, 0x0b, 0xfa, 0xde, 0x40, 0x82, 0x57, 0xee, 0xde, 0xfc, 0x47,
-	0xdd, 0x16, 0x6f, 0x89, 0xd9, 0x77, 0xf7, 0x4e, 0x13, 0xb3, 0xe8, 0xaa, 0xa1, 0x93, 0x0e, 0x06,
-	0x4b, 0x5a, 0xb2, 0xe7, 0xd2, 0x92, 0xfb, 0xf7, 0xb4, 0xe8, 0xcf, 0xa7, 0x25, 0xbf, 0x4e, 0x4b,
-	0xe1, 0xe2, 0x68, 0x29, 0x9e, 0xa1, 0xe5, 0x21, 0x28, 0x21, 0x89, 0x2d, 0x66, 0x46, 0xa9, 0x99,
-	0x6b, 0x57, 0xb6, 0xdf, 0xb0, 0x9e, 0xdd, 0xe8, 0x96, 0x42, 0xbf, 0x37, 0x0e, 0x47, 0xb8, 0xdb,
-	0x7c, 0x92, 0x98, 0x99, 0xd3, 0xc4, 0x04, 0x68, 0x41, 0xc9, 0x57, 0x3f, 0x9b, 0x60, 0x49, 0x90,
-	0xb3, 0x48, 0xa8, 0x38, 0x2f, 0xaf, 0x70, 0x0e, 0x56, 0x38, 0xaf, 0x9c, 0xc7, 0xf9, 0xb7, 0x3a,
-	0xa8, 0xee, 0x4d, 0x03, 0xe4, 0x7b, 0xee, 0x6d, 0x8c, 0xff, 0x1f, 0xce, 0xef, 0x81, 0x8a, 0xe0,
-	0x9c, 0x7b, 0x61, 0xdf, 0x45, 0xe1, 0x4b, 0xb0, 0x2e, 0x24, 0xd3, 0xf3, 0xc2, 0x5b, 0x28, 0x9c,
-	0xe7, 0x3a, 0xc6, 0x58, 0xe6, 0xd2, 0x5f, 0x2a, 0xd7, 0x6d, 0x8c, 0x45, 0xae, 0x54, 0x42, 0xf9,
-	0xe7, 0x4b, 0xa8, 0xb0, 0x2e, 0xa1, 0xe2, 0xc5, 0x49, 0xa8, 0x74, 0x8e, 0x84, 0xca, 0xff, 0x89,
-	0x84, 0xc0, 0x8a, 0x84, 0x2a, 0x2b, 0x12, 0xaa, 0x9e, 0x27, 0xa1, 0x16, 0xa8, 0xef, 0x4f, 0x38,
-	0x0e, 0x98, 0x47, 0x83, 0x77, 0x43, 0x79, 0x67, 0x2c, 0xaf, 0x82, 0xf4, 0x40, 0xfe, 0x52, 0x03,
-	0x97, 0x57, 0xae, 0x08, 0x07, 0xb3, 0x90, 0x06, 0x4c, 0x2e, 0x54, 0x9e, 0xf2, 0x9a, 0x3a, 0xc4,
-	0xe5, 0xc1, 0x7e, 0x0d, 0xe8, 0x23, 0x4a, 0x98, 0x91, 0x95, 0x8b, 0xbc, 0xbc, 0xbe, 0xc8, 0x03,
-	0x4a, 0x1c, 0x19, 0x02, 0x2f, 0x81, 0x5c, 0x84, 0xb9, 0xd4, 0x4c, 0xd5, 0x11, 0x43, 0x58, 0x03,
-	0xa5, 0xd8, 0xef, 0xe3, 0x28, 0xa2, 0x51, 0x7a, 0xea, 0x16, 0x63, 0x7f, 0x5f, 0x98, 0xc2, 0x25,
-	0xc4, 0x31, 0x66, 0x78, 0xa0, 0x58, 0x75, 0x8a, 0x04, 0xb1, 0x07, 0x0c, 0x0f, 0xd2, 0x36, 0x3f,
-	0xd3, 0xc0, 0xab, 0x87, 0x8c, 0x3c, 0x08, 0x07, 0x88, 0xe3, 0xfb, 0x28, 0x42, 0x3e, 0x83, 0x37,
-	0x40, 0x19, 0x8d, 0xf9, 0x90, 0x46, 0x1e, 0x9f, 0xa6, 0x3b, 0xc2, 0xf8, 0xfe, 0x71, 0x67, 0x33,
-	0xbd, 0x6d, 0x77, 0x07, 0x83, 0x08, 0x33, 0xf6, 0x1e, 0x8f, 0xbc, 0x80, 0x38, 0xcb, 0x50, 0x78,
-	0x03, 0x14, 0x42, 0x99, 0x41, 0x8a, 0xbd, 0xb2, 0x6d, 0xac, 0x2f, 0x43, 0x55, 0xe8, 0xea, 0x82,
-	0x26, 0x27, 0x8d, 0xde, 0xd9, 0x78, 0xf4, 0xdb, 0xd7, 0xd7, 0x97, 0x79, 0x5a, 0x35, 0x70, 0xe5,
-	0x99, 0x96, 0xe6, 0xd8, 0x6d, 0x27, 0x1a, 0xc8, 0x1d, 0x32, 0x02, 0xa7, 0x00, 0x9c, 0xb9, 0x7c,
-	0xcd, 0xf5, 0x42, 0x2b, 0xd0, 0xd7, 0xdf, 0x7a, 0x41, 0xc0, 0x3c, 0x7f, 0xeb, 0xea, 0xa3, 0x1f,
-	0x7e, 0xfd, 0x3c, 0xfb, 0x5a, 0xab, 0x26, 0xde, 0x0e, 0x94, 0x2d, 0x1e, 0x12, 0x69, 0x64, 0x9f,
-	0x4f, 0xe0, 0x07, 0xa0, 0xba, 0x82, 0xd6, 0xd5, 0xbf, 0xcc, 0x7d, 0x36, 0xa4, 0x7e, 0xed, 0x85,
-	0x21, 0xf3, 0x06, 0xba, 0x37, 0x9f, 0xcc, 0x1a, 0xda, 0xd3, 0x59, 0x43, 0xfb, 0x65, 0xd6, 0xd0,
-	0x3e, 0x3d, 0x69, 0x64, 0x9e, 0x9e, 0x34, 0x32, 0x3f, 0x9e, 0x34, 0x32, 0xef, 0x9f, 0xdd, 0x58,
-	0x8b, 0xe6, 0x28, 0xb3, 0xe3, 0xad, 0x2d, 0x7b, 0x22, 0x1b, 0x95, 0x9b, 0xeb, 0xa8, 0x20, 0xdf,
-	0x1c, 0x6f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x22, 0x0d, 0xaa, 0x38, 0x70, 0x09, 0x00, 0x00,
+	// 1083 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xeb, 0x7f, 0xcf, 0x26, 0x54, 0x43, 0x4a, 0xd7, 0x06, 0xbc, 0xae, 0x0f, 0xe0,
+	0x56, 0x8a, 0xb7, 0x09, 0xa8, 0x87, 0x9c, 0x6a, 0x27, 0x69, 0x95, 0x2a, 0x11, 0xd5, 0xe2, 0x5e,
+	0x28, 0x52, 0x34, 0x59, 0x4f, 0xd6, 0x2b, 0xb2, 0x3b, 0xcb, 0xce, 0xd8, 0xb2, 0x39, 0xf6, 0xc4,
+	0x0d, 0x10, 0x5f, 0x80, 0x03, 0x27, 0x4e, 0x48, 0xf4, 0x03, 0xf4, 0x58, 0x71, 0x2a, 0x70, 0x41,
+	0x1c, 0x0c, 0x72, 0x90, 0x90, 0x72, 0x83, 0x4f, 0x80, 0x66, 0x76, 0x6c, 0xc7, 0xb5, 0xd3, 0x94,
+	0x52, 0xd4, 0xd3, 0xce, 0xdb, 0xf7, 0xe6, 0xbd, 0x79, 0xbf, 0xdf, 0x6f, 0xfe, 0x40, 0x91, 0xf0,
+	0x0e, 0x89, 0x7c, 0x2f, 0xe0, 0x16, 0xe9, 0xf9, 0x56, 0x6f, 0xcd, 0xe2, 0xfd, 0x7a, 0x18, 0x51,
+	0x4e, 0xd1, 0x85, 0x89, 0xab, 0x4e, 0x7a, 0x7e, 0xbd, 0xb7, 0x56, 0xba, 0xe4, 0x50, 0xe6, 0x53,
+	0x66, 0xf9, 0xcc, 0x15, 0x91, 0x3e, 0x73, 0xe3, 0xd0, 0x52, 0x31, 0x76, 0xec, 0x4b, 0xcb, 0x8a,
+	0x0d, 0xe5, 0x2a, 0xcd, 0x15, 0x10, 0xc9, 0x62, 0xdf, 0x8a, 0x4b, 0x5d, 0x1a, 0xcf, 0x11, 0x23,
+	0xf5, 0xf7, 0x4d, 0x97, 0x52, 0xf7, 0x88, 0x58, 0x38, 0xf4, 0x2c, 0x1c, 0x04, 0x94, 0x63, 0xee,
+	0xd1, 0x60, 0x9c, 0xaf, 0xa8, 0xbc, 0xd2, 0x3a, 0xe8, 0x1e, 0x5a, 0x38, 0x18, 0xc4, 0xae, 0xea,
+	0xe7, 0x1a, 0xbc, 0xb2, 0xc7, 0xdc, 0x6d, 0x51, 0x90, 0x74, 0xfd, 0x56, 0x1f, 0xd5, 0x40, 0x6f,
+	0x63, 0x8e, 0x0d, 0xad, 0xa2, 0xd5, 0xf2, 0xeb, 0x2b, 0xf5, 0x78, 0x6e, 0x7d, 0x3c, 0xb7, 0xde,
+	0x08, 0x06, 0xb6, 0x8c, 0x40, 0x45, 0xd0, 0x99, 0xf7, 0x29, 0x31, 0x12, 0x15, 0xad, 0xa6, 0x35,
+	0x53, 0x27, 0x43, 0x53, 0x5b, 0xb5, 0xe5, 0x2f, 0x64, 0x82, 0xde, 0xc1, 0xac, 0x63, 0x24, 0x2b,
+	0x5a, 0x2d, 0xd7, 0xcc, 0xff, 0x3d, 0x34, 0x33, 0xd1, 0x51, 0xb8, 0x51, 0x5d, 0xad, 0xda, 0xd2,
+	0x81, 0x10, 0xe8, 0x87, 0x11, 0xf5, 0x0d, 0x5d, 0x04, 0xd8, 0x72, 0xbc, 0xa1, 0x7f, 0xf6, 0xb5,
+	0xb9, 0x54, 0xfd, 0x3e, 0x01, 0xd9, 0x5d, 0xe2, 0x62, 0x67, 0xd0, 0xea, 0xa3, 0x15, 0x48, 0x05,
+	0x34, 0x70, 0x88, 0x5c, 0x8d, 0x6e, 0xc7, 0x06, 0xba, 0x05, 0x39, 0x17, 0x0b, 0xe4, 0x3c, 0x27,
+	0xae, 0x9e, 0x6b, 0x5e, 0xfd, 0x75, 0x68, 0xbe, 0xed, 0x7a, 0xbc, 0xd3, 0x3d, 0xa8, 0x3b, 0xd4,
+	0x57, 0x78, 0xaa, 0xcf, 0x2a, 0x6b, 0x7f, 0x6c, 0xf1, 0x41, 0x48, 0x58, 0x7d, 0x27, 0xe0, 0x76,
+	0xd6, 0xc5, 0xec, 0x8e, 0x98, 0x8b, 0xca, 0x90, 0x74, 0x31, 0x93, 0xab, 0xd4, 0x9b, 0x85, 0xd1,
+	0xd0, 0xcc, 0xde, 0xc2, 0x6c, 0xd7, 0xf3, 0x3d, 0x6e, 0x0b, 0x07, 0x5a, 0x86, 0x04, 0xa7, 0x6a,
+	0x8d, 0x09, 0x4e, 0xd1, 0x6d, 0x48, 0xf5, 0xf0, 0x51, 0x97, 0x18, 0x29, 0x59, 0xf4, 0xbd, 0x67,
+	0x2f, 0x3a, 0x1a, 0x9a, 0xe9, 0x86, 0x4f, 0xbb, 0x01, 0xb7, 0xe3, 0x14, 0x02, 0x01, 0x89, 0x73,
+	0xba, 0xa2, 0xd5, 0x0a, 0x0a, 0xd1, 0x02, 0x68, 0x3d, 0x23, 0x23, 0x7f, 0x68, 0x3d, 0x61, 0x45,
+	0x46, 0x36, 0xb6, 0x22, 0x61, 0x31, 0x23, 0x17, 0x5b, 0x6c, 0x63, 0x59, 0x60, 0xf5, 0xc3, 0x83,
+	0xd5, 0x74, 0xab, 0xbf, 0x85, 0x39, 0xae, 0xfe, 0x95, 0x84, 0x42, 0xc3, 0x71, 0x08, 0x63, 0xbb,
+	0x1e, 0xe3, 0xad, 0x3e, 0xba, 0x07, 0x59, 0xa7, 0x83, 0xbd, 0x60, 0xdf, 0x6b, 0x4b, 0xf0, 0x72,
+	0xcd, 0x1b, 0xff, 0x6a, 0xb5, 0x99, 0x4d, 0x31, 0x7b, 0x67, 0xeb, 0x64, 0x68, 0x66, 0x9c, 0x78,
+	0x68, 0xab, 0x41, 0x7b, 0x4a, 0x4b, 0xe2, 0x4c, 0x5a, 0x92, 0xff, 0x9d, 0x16, 0xfd, 0xe9, 0xb4,
+	0xa4, 0xe6, 0x69, 0x49, 0xbf, 0x38, 0x5a, 0x32, 0xa7, 0x68, 0xb9, 0x07, 0x59, 0x2c, 0xb1, 0x25,
+	0xcc, 0xc8, 0x56, 0x92, 0xb5, 0xfc, 0xfa, 0x5b, 0xf5, 0x27, 0x37, 0x7a, 0x3d, 0x46, 0xbf, 0xd5,
+	0x0d, 0x8f, 0x48, 0xb3, 0xf2, 0x68, 0x68, 0x2e, 0x9d, 0x0c, 0x4d, 0xc0, 0x13, 0x4a, 0xbe, 0xfd,
+	0xcd, 0x84, 0x29, 0x41, 0xf6, 0x24, 0x61, 0xcc, 0x79, 0x6e, 0x86, 0x73, 0x98, 0xe1, 0x3c, 0x7f,
+	0x16, 0xe7, 0x0f, 0x75, 0x28, 0x6c, 0x0d, 0x02, 0xec, 0x7b, 0xce, 0x4d, 0x42, 0x5e, 0x0e, 0xe7,
+	0xb7, 0x21, 0x2f, 0x38, 0xe7, 0x5e, 0xb8, 0xef, 0xe0, 0xf0, 0x39, 0x58, 0x17, 0x92, 0x69, 0x79,
+	0xe1, 0x26, 0x0e, 0xc7, 0xb9, 0x0e, 0x09, 0x91, 0xb9, 0xf4, 0xe7, 0xca, 0x75, 0x93, 0x10, 0x91,
+	0x4b, 0x49, 0x28, 0xf5, 0x74, 0x09, 0xa5, 0xe7, 0x25, 0x94, 0x79, 0x71, 0x12, 0xca, 0x9e, 0x21,
+	0xa1, 0xdc, 0xff, 0x22, 0x21, 0x98, 0x91, 0x50, 0x7e, 0x46, 0x42, 0x85, 0xb3, 0x24, 0x54, 0x85,
+	0xd2, 0x76, 0x9f, 0x93, 0x80, 0x79, 0x34, 0x78, 0x3f, 0x94, 0x77, 0xc6, 0xf4, 0x2a, 0x50, 0x07,
+	0xf2, 0x37, 0x1a, 0x5c, 0x9c, 0xb9, 0x22, 0x6c, 0xc2, 0x42, 0x1a, 0x30, 0xd9, 0xa8, 0x3c, 0xe5,
+	0xb5, 0xf8, 0x10, 0x17, 0x63, 0x74, 0x05, 0xf4, 0x23, 0xea, 0x32, 0x23, 0x21, 0x9b, 0xbc, 0x38,
+	0xdf, 0xe4, 0x2e, 0x75, 0x6d, 0x19, 0x82, 0x2e, 0x40, 0x32, 0x22, 0x5c, 0x6a, 0xa6, 0x60, 0x8b,
+	0x21, 0x2a, 0x42, 0xb6, 0xe7, 0xef, 0x93, 0x28, 0xa2, 0x91, 0x3a, 0x75, 0x33, 0x3d, 0x7f, 0x5b,
+	0x98, 0xc2, 0x25, 0xc4, 0xd1, 0x65, 0xa4, 0x1d, 0xb3, 0x6a, 0x67, 0x5c, 0xcc, 0xee, 0x32, 0xd2,
+	0x56, 0xcb, 0xfc, 0x52, 0x83, 0x57, 0xf7, 0x98, 0x7b, 0x37, 0x6c, 0x63, 0x4e, 0xee, 0xe0, 0x08,
+	0xfb, 0x0c, 0x5d, 0x87, 0x1c, 0xee, 0xf2, 0x0e, 0x8d, 0x3c, 0x3e, 0x50, 0x3b, 0xc2, 0xf8, 0xe9,
+	0xc1, 0xea, 0x8a, 0xba, 0x6d, 0x1b, 0xed, 0x76, 0x44, 0x18, 0xfb, 0x80, 0x47, 0x5e, 0xe0, 0xda,
+	0xd3, 0x50, 0x74, 0x1d, 0xd2, 0xa1, 0xcc, 0x20, 0xc5, 0x9e, 0x5f, 0x37, 0xe6, 0xdb, 0x88, 0x2b,
+	0x34, 0x75, 0x41, 0x93, 0xad, 0xa2, 0x37, 0x96, 0xef, 0xff, 0xf9, 0xdd, 0xd5, 0x69, 0x9e, 0x6a,
+	0x11, 0x2e, 0x3d, 0xb1, 0xa4, 0x31, 0x76, 0xd5, 0x87, 0x1a, 0xbc, 0xbe, 0xc7, 0x5c, 0x9b, 0xb8,
+	0x1e, 0xe3, 0x24, 0xda, 0xa4, 0x01, 0x8f, 0xb0, 0xc3, 0x1b, 0xcd, 0x1d, 0x74, 0x0d, 0xd2, 0x8c,
+	0x04, 0x6d, 0x12, 0x9d, 0xbb, 0x64, 0x15, 0x87, 0x4a, 0x90, 0x75, 0x54, 0x82, 0xf8, 0x3e, 0xb4,
+	0x27, 0x36, 0x2a, 0x42, 0x12, 0x1f, 0x78, 0x6a, 0x67, 0x66, 0x46, 0x43, 0x33, 0xd9, 0x68, 0xee,
+	0xd8, 0xe2, 0x9f, 0x98, 0xe6, 0x13, 0x8e, 0xa5, 0x58, 0x63, 0xb8, 0x27, 0xf6, 0x74, 0xbb, 0xa7,
+	0x4e, 0x6d, 0xf7, 0x8d, 0xbc, 0x68, 0x50, 0x55, 0xad, 0x56, 0xa0, 0xbc, 0xb8, 0x83, 0x71, 0x93,
+	0xeb, 0x3f, 0x26, 0x20, 0xb9, 0xc7, 0x5c, 0x34, 0x00, 0x38, 0xf5, 0xc2, 0x30, 0xe7, 0xd1, 0x9c,
+	0xd1, 0x57, 0xe9, 0x9d, 0x73, 0x02, 0x26, 0x20, 0x5e, 0xbe, 0xff, 0xf3, 0x1f, 0x5f, 0x25, 0xde,
+	0xa8, 0x16, 0xc5, 0x03, 0x89, 0xb2, 0xc9, 0x6b, 0x49, 0x45, 0xee, 0xf3, 0x3e, 0xfa, 0x08, 0x0a,
+	0x33, 0x92, 0xb8, 0xbc, 0x30, 0xf7, 0xe9, 0x90, 0xd2, 0x95, 0x73, 0x43, 0x26, 0x3b, 0xe0, 0x13,
+	0x78, 0x6d, 0x11, 0x83, 0xb5, 0x85, 0x19, 0x16, 0x44, 0x96, 0xae, 0x3d, 0x6b, 0xe4, 0xb8, 0x64,
+	0xf3, 0xc6, 0xa3, 0x51, 0x59, 0x7b, 0x3c, 0x2a, 0x6b, 0xbf, 0x8f, 0xca, 0xda, 0x17, 0xc7, 0xe5,
+	0xa5, 0xc7, 0xc7, 0xe5, 0xa5, 0x5f, 0x8e, 0xcb, 0x4b, 0x1f, 0x9e, 0x3e, 0xb0, 0x26, 0x78, 0x50,
+	0x66, 0xf5, 0xd6, 0xd6, 0xad, 0xbe, 0xc4, 0x46, 0x1e, 0x5a, 0x07, 0x69, 0xf9, 0x96, 0x7b, 0xf7,
+	0x9f, 0x01, 0x00, 0x95, 0xb9, 0x12, 0x66, 0xc8, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -578,6 +720,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterContractABI registers the ABI and metadata of a contract. It can
+	// only be performed by the deployer of the contract or the governance account.
+	RegisterContractABI(ctx context.Context, in *MsgRegisterContractABI, opts ...grpc.CallOption) (*MsgRegisterContractABIResponse, error)
 }
 
 type msgClient struct {
@@ -606,6 +751,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterContractABI(ctx context.Context, in *MsgRegisterContractABI, opts ...grpc.CallOption) (*MsgRegisterContractABIResponse, error) {
+	out := new(MsgRegisterContractABIResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/RegisterContractABI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -613,6 +767,9 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterContractABI registers the ABI and metadata of a contract. It can
+	// only be performed by the deployer of the contract or the governance account.
+	RegisterContractABI(context.Context, *MsgRegisterContractABI) (*MsgRegisterContractABIResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -626,6 +783,10 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
+func (*UnimplementedMsgServer) RegisterContractABI(ctx context.Context, req *MsgRegisterContractABI) (*MsgRegisterContractABIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContractABI not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -666,6 +827,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterContractABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterContractABI)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterContractABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/RegisterContractABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterContractABI(ctx, req.(*MsgRegisterContractABI))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -678,6 +857,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterContractABI",
+			Handler:    _Msg_RegisterContractABI_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1227,6 +1410,85 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRegisterContractABI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterContractABI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterContractABI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ABI) > 0 {
+		i -= len(m.ABI)
+		copy(dAtA[i:], m.ABI)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ABI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterContractABIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterContractABIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterContractABIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1479,6 +1741,43 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterContractABI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ABI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRegisterContractABIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3232,6 +3531,255 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *MsgRegisterContractABI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterContractABI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterContractABI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ABI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ABI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgRegisterContractABIResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterContractABIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterContractABIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 
@@ -36,6 +37,12 @@ var DefaultPriorityReduction = sdk.DefaultPowerReduction
 
 var EmptyCodeHash = crypto.Keccak256(nil)
 
+// IsContractCodeHash returns true if the code hash of an account references a
+// contract code, ie. it's neither empty nor the hash of an empty code.
+func IsContractCodeHash(codeHash common.Hash) bool {
+	return codeHash != (common.Hash{}) && !bytes.Equal(codeHash.Bytes(), EmptyCodeHash)
+}
+
 // DecodeTxResponse decodes an protobuf-encoded byte slice into TxResponse
 func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	var txMsgData sdk.TxMsgData
@@ -116,3 +123,16 @@ func BinSearch(lo, hi uint64, executable func(uint64) (bool, *MsgEthereumTxRespo
 func EffectiveGasPrice(baseFee, feeCap, tipCap *big.Int) *big.Int {
 	return math.BigMin(new(big.Int).Add(tipCap, baseFee), feeCap)
 }
+
+// randomnessDomain separates the block randomness from the header hash it's derived from.
+var randomnessDomain = []byte("prevrandao")
+
+// BlockRandomness returns the randomness of the block with the given Tendermint
+// header hash, which is the value of the PREVRANDAO opcode and the mixHash of
+// the block. The header hash commits to the consensus data of the block (eg:
+// the previous block ID, the app hash and the proposer), so the randomness is
+// unknown before the block is proposed, but the proposer can influence it and
+// it must not be used when the proposer has a stake in the outcome.
+func BlockRandomness(headerHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(randomnessDomain, headerHash.Bytes())
+}
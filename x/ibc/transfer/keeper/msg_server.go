@@ -73,6 +73,12 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 	}
 
+	if pair.IsPrecompile() {
+		// no-op: the ERC20 precompile balances are the coin balances so we can
+		// proceed with regular transfer
+		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	}
+
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
 	senderAcc := k.accountKeeper.GetAccount(ctx, sender)
 
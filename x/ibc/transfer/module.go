@@ -19,12 +19,14 @@ package transfer
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	ibctransfer "github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	"github.com/evmos/evmos/v12/x/ibc/transfer/keeper"
+	transfertypes "github.com/evmos/evmos/v12/x/ibc/transfer/types"
 )
 
 var (
@@ -37,6 +39,13 @@ type AppModuleBasic struct {
 	*ibctransfer.AppModuleBasic
 }
 
+// RegisterInterfaces registers the interfaces of the IBC Transfer module and
+// the TransferAuthorization authz implementation.
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	b.AppModuleBasic.RegisterInterfaces(registry)
+	transfertypes.RegisterInterfaces(registry)
+}
+
 // AppModule represents the AppModule for this module
 type AppModule struct {
 	*ibctransfer.AppModule
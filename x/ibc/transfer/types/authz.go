@@ -0,0 +1,145 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+var _ authz.Authorization = &TransferAuthorization{}
+
+// NewTransferAuthorization creates a new TransferAuthorization object.
+func NewTransferAuthorization(allocations ...Allocation) *TransferAuthorization {
+	return &TransferAuthorization{
+		Allocations: allocations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+}
+
+// Accept implements Authorization.Accept. The transfer must be sent through
+// an allocated port and channel, to an allowed receiver and within the spend
+// limit of the allocation, which is reduced by the transferred amount. The
+// authorization is deleted once all the spend limits are exhausted.
+func (a TransferAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgTransfer, ok := msg.(*transfertypes.MsgTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(errortypes.ErrInvalidType, "type mismatch")
+	}
+
+	for i, allocation := range a.Allocations {
+		if allocation.SourcePort != msgTransfer.SourcePort || allocation.SourceChannel != msgTransfer.SourceChannel {
+			continue
+		}
+
+		if !allocation.IsAllowedReceiver(msgTransfer.Receiver) {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "receiver %s is not allowed", msgTransfer.Receiver)
+		}
+
+		limitLeft, isNegative := allocation.SpendLimit.SafeSub(msgTransfer.Token)
+		if isNegative {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "requested amount is more than spend limit")
+		}
+
+		allocations := make([]Allocation, 0, len(a.Allocations))
+		allocations = append(allocations, a.Allocations[:i]...)
+		if !limitLeft.IsZero() {
+			allocation.SpendLimit = limitLeft
+			allocations = append(allocations, allocation)
+		}
+		allocations = append(allocations, a.Allocations[i+1:]...)
+
+		if len(allocations) == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		return authz.AcceptResponse{Accept: true, Updated: NewTransferAuthorization(allocations...)}, nil
+	}
+
+	return authz.AcceptResponse{}, errorsmod.Wrapf(
+		errortypes.ErrNotFound,
+		"no allocation for port %s and channel %s", msgTransfer.SourcePort, msgTransfer.SourceChannel,
+	)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferAuthorization) ValidateBasic() error {
+	if len(a.Allocations) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allocations cannot be empty")
+	}
+
+	channels := make(map[string]bool, len(a.Allocations))
+	for _, allocation := range a.Allocations {
+		if err := allocation.Validate(); err != nil {
+			return err
+		}
+
+		channelID := fmt.Sprintf("%s/%s", allocation.SourcePort, allocation.SourceChannel)
+		if channels[channelID] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate allocation for %s", channelID)
+		}
+		channels[channelID] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the allocation.
+func (a Allocation) Validate() error {
+	if err := host.PortIdentifierValidator(a.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(a.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+	if a.SpendLimit.Empty() {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, "spend limit cannot be empty")
+	}
+	if !a.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid spend limit %s", a.SpendLimit)
+	}
+
+	receivers := make(map[string]bool, len(a.AllowList))
+	for _, receiver := range a.AllowList {
+		if receivers[receiver] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "duplicate receiver %s", receiver)
+		}
+		receivers[receiver] = true
+	}
+	return nil
+}
+
+// IsAllowedReceiver returns true if the receiver is part of the allow list or
+// if the allow list is empty.
+func (a Allocation) IsAllowedReceiver(receiver string) bool {
+	if len(a.AllowList) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowList {
+		if allowed == receiver {
+			return true
+		}
+	}
+	return false
+}
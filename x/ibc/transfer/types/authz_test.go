@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v12/x/ibc/transfer/types"
+)
+
+func TestTransferAuthorizationValidateBasic(t *testing.T) {
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+
+	testCases := []struct {
+		name        string
+		allocations []types.Allocation
+		expPass     bool
+	}{
+		{"valid", []types.Allocation{{"transfer", "channel-0", spendLimit, nil}}, true},
+		{"valid with allow list", []types.Allocation{{"transfer", "channel-0", spendLimit, []string{"cosmos1"}}}, true},
+		{"empty allocations", nil, false},
+		{"invalid port", []types.Allocation{{"", "channel-0", spendLimit, nil}}, false},
+		{"invalid channel", []types.Allocation{{"transfer", "c", spendLimit, nil}}, false},
+		{"empty spend limit", []types.Allocation{{"transfer", "channel-0", nil, nil}}, false},
+		{"duplicated receiver", []types.Allocation{{"transfer", "channel-0", spendLimit, []string{"cosmos1", "cosmos1"}}}, false},
+		{
+			"duplicated channel",
+			[]types.Allocation{{"transfer", "channel-0", spendLimit, nil}, {"transfer", "channel-0", spendLimit, nil}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.NewTransferAuthorization(tc.allocations...).ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTransferAuthorizationAccept(t *testing.T) {
+	authorization := types.NewTransferAuthorization(
+		types.Allocation{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			SpendLimit:    sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+			AllowList:     []string{"cosmos1receiver"},
+		},
+		types.Allocation{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-1",
+			SpendLimit:    sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+		},
+	)
+
+	newMsg := func(channel string, amount int64, receiver string) *ibctransfertypes.MsgTransfer {
+		return ibctransfertypes.NewMsgTransfer(
+			"transfer", channel, sdk.NewInt64Coin("aevmos", amount),
+			"evmos1sender", receiver, clienttypes.NewHeight(0, 100), 0, "",
+		)
+	}
+
+	// partial spend updates the allocation
+	res, err := authorization.Accept(sdk.Context{}, newMsg("channel-0", 40, "cosmos1receiver"))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	updated := res.Updated.(*types.TransferAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 60)), updated.Allocations[0].SpendLimit)
+	require.Equal(t, authorization.Allocations[1], updated.Allocations[1])
+
+	// receiver not allowed
+	_, err = authorization.Accept(sdk.Context{}, newMsg("channel-0", 40, "cosmos1other"))
+	require.Error(t, err)
+
+	// spend limit exceeded
+	_, err = authorization.Accept(sdk.Context{}, newMsg("channel-1", 101, "cosmos1other"))
+	require.Error(t, err)
+
+	// channel not allocated
+	_, err = authorization.Accept(sdk.Context{}, newMsg("channel-2", 1, "cosmos1other"))
+	require.Error(t, err)
+
+	// invalid message type
+	_, err = authorization.Accept(sdk.Context{}, &banktypes.MsgSend{})
+	require.Error(t, err)
+
+	// spending the full limit removes the allocation
+	res, err = authorization.Accept(sdk.Context{}, newMsg("channel-1", 100, "cosmos1other"))
+	require.NoError(t, err)
+	updated = res.Updated.(*types.TransferAuthorization)
+	require.Len(t, updated.Allocations, 1)
+	require.Equal(t, "channel-0", updated.Allocations[0].SourceChannel)
+
+	// spending the last allocation deletes the authorization
+	res, err = updated.Accept(sdk.Context{}, newMsg("channel-0", 100, "cosmos1receiver"))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+}
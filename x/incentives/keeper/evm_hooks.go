@@ -32,6 +32,12 @@ import (
 
 var _ evmtypes.EvmHooks = Hooks{}
 
+// PreTxProcessing implements EvmHooks.PreTxProcessing. The module doesn't
+// process transactions before their execution.
+func (h Hooks) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 // PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
 // the module keeper
 func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
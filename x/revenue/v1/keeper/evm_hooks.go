@@ -20,6 +20,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -40,6 +41,12 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// PreTxProcessing implements EvmHooks.PreTxProcessing. The module doesn't
+// process transactions before their execution.
+func (h Hooks) PreTxProcessing(_ sdk.Context, _ core.Message, _ common.Address) error {
+	return nil
+}
+
 // PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
 // the module keeper
 func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {